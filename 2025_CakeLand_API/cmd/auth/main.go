@@ -6,14 +6,23 @@ import (
 	"2025_CakeLand_API/internal/pkg/auth/repo"
 	"2025_CakeLand_API/internal/pkg/auth/usecase"
 	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/mailer"
+	"2025_CakeLand_API/internal/pkg/sms"
 	"2025_CakeLand_API/internal/pkg/utils"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"2025_CakeLand_API/internal/pkg/utils/jwt"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
 	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"2025_CakeLand_API/internal/pkg/utils/oauth"
+	"2025_CakeLand_API/internal/pkg/utils/password"
+	"2025_CakeLand_API/internal/pkg/utils/totp"
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
@@ -40,26 +49,114 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	// Создаём отправку писем
+	mail, err := mailer.NewMailer(&conf.Mailer)
+	if err != nil {
+		return err
+	}
+
+	// Создаём отправку SMS
+	smsSender, err := sms.NewSender(&conf.PhoneAuth.SMS, l)
+	if err != nil {
+		return err
+	}
+
+	// Создаём политику паролей и хэширование
+	passwordPolicy, err := password.NewPolicy(&conf.Password)
+	if err != nil {
+		return err
+	}
+	hasher, err := password.NewHasher(&conf.Password.Hash)
+	if err != nil {
+		return err
+	}
+
+	// Создаём проверку TOTP для 2FA
+	totpAuthenticator, err := totp.NewAuthenticator(&conf.TwoFactor)
+	if err != nil {
+		return err
+	}
+
+	// Создаём счётчики неудачных входов
+	loginAttempts, err := repo.NewLoginAttemptStore(db, &conf.LoginLimits)
+	if err != nil {
+		return err
+	}
 
 	// Создаём grpc сервис
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", conf.GRPC.AuthPort))
 	if err != nil {
 		return err
 	}
+	tokenator, err := jwt.NewIssuingTokenator(&conf.JWT)
+	if err != nil {
+		return err
+	}
+	denylist := authz.NewAccessDenylist(db)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logger.LoggingUnaryInterceptor(l)),
+		grpc.ChainUnaryInterceptor(
+			logger.LoggingUnaryInterceptor(l),
+			authz.AuthUnaryInterceptor(l, tokenator, denylist, auth.MethodPolicies),
+		),
 	)
 
 	rep := repo.NewAuthRepository(db)
-	validator := utils.NewValidator()
-	tokenator := jwt.NewTokenator()
-	mdProvider := md.NewMetadataProvider()
-	authUsecase := usecase.NewAuthUsecase(tokenator, rep)
+	validator := utils.NewValidator(passwordPolicy)
+	mdProvider, err := md.NewMetadataProviderWithProxies(conf.GRPC.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	oauthVerifier := oauth.NewVerifier(&conf.OAuth)
+	authUsecase := usecase.NewAuthUsecase(usecase.AuthDeps{
+		Tokenator:     tokenator,
+		Repo:          rep,
+		Mailer:        mail,
+		Hasher:        hasher,
+		LoginAttempts: loginAttempts,
+		LoginLimits:   conf.LoginLimits,
+		OAuth:         oauthVerifier,
+		SMS:           smsSender,
+		PhoneAuth:     conf.PhoneAuth,
+		TOTP:          totpAuthenticator,
+	})
 	grpcAuthHandler := auth.NewGrpcAuthHandler(l, validator, authUsecase, mdProvider)
 
 	generated.RegisterAuthServer(grpcServer, grpcAuthHandler)
+
+	// JWKS по HTTP для клиентов без gRPC
+	if conf.JWT.JWKSHTTPPort != 0 {
+		mux := http.NewServeMux()
+		mux.Handle("/.well-known/jwks.json", tokenator.JWKSHandler())
+		go func() {
+			addr := fmt.Sprintf(":%d", conf.JWT.JWKSHTTPPort)
+			l.Info("Starting JWKS http server", slog.String("port", addr))
+			if err := http.ListenAndServe(addr, mux); err != nil {
+				l.Error("JWKS http server stopped", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	// Устаревшие счётчики входа и журнал входов удаляются в фоне
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	defer stopCleanup()
+	go purgeLoginHistory(cleanupCtx, l, authUsecase, conf.LoginLimits.CleanupInterval)
+
 	l.Info("Starting auth gRPC server",
 		slog.String("port", fmt.Sprintf(":%d", conf.GRPC.AuthPort)),
 	)
 	return grpcServer.Serve(listener)
 }
+
+func purgeLoginHistory(ctx context.Context, log *slog.Logger, uc *usecase.AuthUseсase, interval time.Duration) {
+	for {
+		if err := uc.PurgeLoginHistory(ctx); err != nil {
+			log.Warn("failed to purge login history", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
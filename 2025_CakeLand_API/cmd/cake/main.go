@@ -7,17 +7,21 @@ import (
 	"2025_CakeLand_API/internal/pkg/cake/usecase"
 	"2025_CakeLand_API/internal/pkg/config"
 	"2025_CakeLand_API/internal/pkg/minio"
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/profile/loader"
 	"2025_CakeLand_API/internal/pkg/utils"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 // go run cmd/cake/main.go --config=./config/config.yaml
@@ -50,23 +54,63 @@ func run() error {
 		return err
 	}
 
+	// Клиент сервиса пользователя
+	conn, err := grpc.Dial(
+		fmt.Sprintf("localhost:%d", conf.GRPC.ProfilePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	profileClient := profileGen.NewProfileServiceClient(conn)
+
 	// Создаём grpc сервис
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", conf.GRPC.CakePort))
 	if err != nil {
 		return err
 	}
 
+	tokenator, stopJWKS, err := authz.NewVerifyingTokenator(l, conf)
+	if err != nil {
+		return err
+	}
+	defer stopJWKS()
+	denylist := authz.NewAccessDenylist(db)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logger.LoggingUnaryInterceptor(l)),
+		grpc.ChainUnaryInterceptor(
+			logger.LoggingUnaryInterceptor(l),
+			authz.AuthUnaryInterceptor(l, tokenator, denylist, cake.MethodPolicies),
+			authz.RolesUnaryInterceptor(l, cake.MethodRoles),
+			loader.UnaryServerInterceptor(profileClient),
+		),
 		grpc.MaxRecvMsgSize(200*1024*1024), // 200MB для входящих сообщений
 		grpc.MaxSendMsgSize(200*1024*1024), // 200MB для исходящих сообщений
 	)
 	repository := repo.NewCakeRepository(db)
-	tokenator := jwt.NewTokenator()
-	useCase := usecase.NewCakeUsecase(tokenator, repository, minioProvider, conf.MinIO.Bucket)
-	mdProvider := md.NewMetadataProvider()
-	handler := cake.NewCakeHandler(l, useCase, mdProvider)
+	useCase := usecase.NewCakeUsecase(repository, minioProvider, conf.MinIO.Bucket, profileClient)
+	handler := cake.NewCakeHandler(l, useCase)
 	generated.RegisterCakeServiceServer(grpcServer, handler)
+
+	// Цены тортов с начавшейся или закончившейся скидкой пересчитываются в фоне
+	refreshCtx, stopRefresh := context.WithCancel(context.Background())
+	defer stopRefresh()
+	go refreshEffectivePrices(refreshCtx, l, useCase, conf.Catalog.PriceRefreshInterval)
+
 	l.Info("Starting cake gRPC service", slog.String("port", fmt.Sprintf(":%d", conf.GRPC.CakePort)))
 	return grpcServer.Serve(listener)
 }
+
+func refreshEffectivePrices(ctx context.Context, log *slog.Logger, uc *usecase.CakeUseсase, interval time.Duration) {
+	for {
+		if err := uc.RefreshEffectivePrices(ctx); err != nil {
+			log.Warn("failed to refresh cake prices", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
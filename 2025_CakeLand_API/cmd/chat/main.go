@@ -5,13 +5,15 @@ import (
 	"2025_CakeLand_API/internal/pkg/chat/delivery/grpc/generated"
 	chatRepo "2025_CakeLand_API/internal/pkg/chat/repo"
 	"2025_CakeLand_API/internal/pkg/config"
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/profile/loader"
 	"2025_CakeLand_API/internal/pkg/utils"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
 	"fmt"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"log"
 	"log/slog"
 	"net"
@@ -38,6 +40,17 @@ func run() error {
 		return err
 	}
 
+	// Клиент сервиса пользователя
+	conn, err := grpc.Dial(
+		fmt.Sprintf("localhost:%d", conf.GRPC.ProfilePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	profileClient := profileGen.NewProfileServiceClient(conn)
+
 	chatPort := fmt.Sprintf(":%d", conf.GRPC.ChatPort)
 	lis, err := net.Listen("tcp", chatPort)
 	if err != nil {
@@ -47,11 +60,22 @@ func run() error {
 	// Создаём Logger
 	l := logger.NewLogger(conf.Env)
 
-	mdProvider := md.NewMetadataProvider()
-	tokenator := jwt.NewTokenator()
-	grpcServer := grpc.NewServer()
+	// Все методы чата требуют авторизации
+	tokenator, stopJWKS, err := authz.NewVerifyingTokenator(l, conf)
+	if err != nil {
+		return err
+	}
+	defer stopJWKS()
+	denylist := authz.NewAccessDenylist(db)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			authz.AuthUnaryInterceptor(l, tokenator, denylist, nil),
+			loader.UnaryServerInterceptor(profileClient),
+		),
+		grpc.StreamInterceptor(authz.AuthStreamInterceptor(l, tokenator, denylist, nil)),
+	)
 	repo := chatRepo.NewChatRepository(db)
-	chatProvider := chat.NewChatProvider(l, mdProvider, tokenator, repo)
+	chatProvider := chat.NewChatProvider(l, repo, profileClient)
 	generated.RegisterChatServiceServer(grpcServer, chatProvider)
 
 	l.Info("Starting chat gRPC service", slog.String("port", chatPort))
@@ -7,9 +7,8 @@ import (
 	"2025_CakeLand_API/internal/pkg/order/repo"
 	"2025_CakeLand_API/internal/pkg/order/usecase"
 	"2025_CakeLand_API/internal/pkg/utils"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
 	"fmt"
 	"google.golang.org/grpc"
 	"log/slog"
@@ -46,16 +45,24 @@ func run() error {
 		return err
 	}
 
+	tokenator, stopJWKS, err := authz.NewVerifyingTokenator(l, conf)
+	if err != nil {
+		return err
+	}
+	defer stopJWKS()
+	denylist := authz.NewAccessDenylist(db)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logger.LoggingUnaryInterceptor(l)),
+		grpc.ChainUnaryInterceptor(
+			logger.LoggingUnaryInterceptor(l),
+			authz.AuthUnaryInterceptor(l, tokenator, denylist, handler.MethodPolicies),
+			authz.RolesUnaryInterceptor(l, handler.MethodRoles),
+		),
 		grpc.MaxRecvMsgSize(20*1024*1024), // 200MB для входящих сообщений
 		grpc.MaxSendMsgSize(20*1024*1024), // 200MB для исходящих сообщений
 	)
 	repository := repo.NewOrderRepo(db)
-	tokenator := jwt.NewTokenator()
-	uc := usecase.NewOrderUsecase(tokenator, repository)
-	mdProvider := md.NewMetadataProvider()
-	h := handler.NewOrderHandler(l, uc, mdProvider)
+	uc := usecase.NewOrderUsecase(repository)
+	h := handler.NewOrderHandler(l, uc)
 	generated.RegisterOrderServiceServer(grpcServer, h)
 	l.Info("Starting order gRPC service", slog.String("port", fmt.Sprintf(":%d", conf.GRPC.OrderPort)))
 	return grpcServer.Serve(listener)
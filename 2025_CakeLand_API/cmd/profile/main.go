@@ -8,9 +8,8 @@ import (
 	"2025_CakeLand_API/internal/pkg/profile/repo"
 	"2025_CakeLand_API/internal/pkg/profile/usecase"
 	"2025_CakeLand_API/internal/pkg/utils"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
 	"fmt"
 	"log/slog"
 	"net"
@@ -56,16 +55,24 @@ func run() error {
 		return err
 	}
 
+	tokenator, stopJWKS, err := authz.NewVerifyingTokenator(l, conf)
+	if err != nil {
+		return err
+	}
+	defer stopJWKS()
+	denylist := authz.NewAccessDenylist(db)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logger.LoggingUnaryInterceptor(l)),
+		grpc.ChainUnaryInterceptor(
+			logger.LoggingUnaryInterceptor(l),
+			authz.AuthUnaryInterceptor(l, tokenator, denylist, handler.MethodPolicies),
+			authz.RolesUnaryInterceptor(l, handler.MethodRoles),
+		),
 		grpc.MaxRecvMsgSize(200*1024*1024), // 200MB для входящих сообщений
 		grpc.MaxSendMsgSize(200*1024*1024), // 200MB для исходящих сообщений
 	)
 	repository := repo.NewProfileRepository(db)
-	tokenator := jwt.NewTokenator()
-	usecase := usecase.NewProfileUsecase(tokenator, repository, minioProvider)
-	mdProvider := md.NewMetadataProvider()
-	handler := handler.NewProfileHandler(l, usecase, mdProvider)
+	usecase := usecase.NewProfileUsecase(repository, minioProvider)
+	handler := handler.NewProfileHandler(l, usecase)
 	generated.RegisterProfileServiceServer(grpcServer, handler)
 	l.Info("Starting profile gRPC service", slog.String("port", fmt.Sprintf(":%d", conf.GRPC.ProfilePort)))
 	return grpcServer.Serve(listener)
@@ -3,14 +3,14 @@ package main
 import (
 	"2025_CakeLand_API/internal/pkg/config"
 	"2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/profile/loader"
 	handler "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc"
 	gen "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc/generated"
 	"2025_CakeLand_API/internal/pkg/reviews/repo"
 	"2025_CakeLand_API/internal/pkg/reviews/usecase"
 	"2025_CakeLand_API/internal/pkg/utils"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
 	"fmt"
 	"google.golang.org/grpc/credentials/insecure"
 	"log/slog"
@@ -62,15 +62,24 @@ func run() error {
 		return err
 	}
 
+	tokenator, stopJWKS, err := authz.NewVerifyingTokenator(l, conf)
+	if err != nil {
+		return err
+	}
+	defer stopJWKS()
+	denylist := authz.NewAccessDenylist(db)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logger.LoggingUnaryInterceptor(l)),
+		grpc.ChainUnaryInterceptor(
+			logger.LoggingUnaryInterceptor(l),
+			authz.AuthUnaryInterceptor(l, tokenator, denylist, handler.MethodPolicies),
+			authz.RolesUnaryInterceptor(l, handler.MethodRoles),
+			loader.UnaryServerInterceptor(userClient),
+		),
 	)
 
 	repository := repo.NewReviewsRepository(db)
-	tokenator := jwt.NewTokenator()
-	mdProvider := md.NewMetadataProvider()
 	usecase := usecase.NewReviewsUsecase(userClient, repository)
-	handler := handler.NewReviewsHandler(l, usecase, mdProvider, tokenator)
+	handler := handler.NewReviewsHandler(l, usecase)
 	gen.RegisterReviewServiceServer(grpcServer, handler)
 	l.Info("Starting reviews gRPC service", slog.String("port", fmt.Sprintf(":%d", conf.GRPC.ReviewsPort)))
 	return grpcServer.Serve(listener)
@@ -1,20 +1,16 @@
 package domains
 
-type JWTClaimsKeys string
 type MetadataKey string
 
 const (
-	KeyUserIDClaim JWTClaimsKeys = "userID"
-	KeyExpClaim    JWTClaimsKeys = "exp"
-
 	KeyFingerprint   MetadataKey = "fingerprint"
 	KeyAuthorization MetadataKey = "authorization"
+	KeyDeviceName    MetadataKey = "device-name"
+	KeyRealIP        MetadataKey = "x-real-ip"
+	KeyForwardedFor  MetadataKey = "x-forwarded-for"
+	KeyRetryAfter    MetadataKey = "retry-after" // Через сколько секунд повторить запрос (в заголовке ответа)
 )
 
-func (c JWTClaimsKeys) String() string {
-	return string(c)
-}
-
 func (k MetadataKey) String() string {
 	return string(k)
 }
@@ -0,0 +1,9 @@
+package models
+
+// CodePurpose Для чего выдан одноразовый код из письма
+type CodePurpose string
+
+const (
+	CodePurposeEmailVerification CodePurpose = "email_verification"
+	CodePurposePasswordReset     CodePurpose = "password_reset"
+)
@@ -10,23 +10,26 @@ import (
 
 // Cake Модель торта
 type Cake struct {
-	ID              uuid.UUID   // Код
-	Name            string      // Название
-	PreviewImageURL string      // Картинка товара
-	KgPrice         float64     // Цена за кг
-	ReviewsCount    int32       // Количество отзывов
-	StarsSum        int32       // Сумма звёзд
-	Description     string      // Описание
-	Mass            float64     // Масса торта
-	IsOpenForSale   bool        // Флаг возможности продажи торта
-	DateCreation    time.Time   // Дата создания торта
-	DiscountKgPrice null.Float  // Скидочная цена за кг
-	DiscountEndTime null.Time   // Дата окончания скидки
-	Owner           User        // Владелец
-	Fillings        []Filling   // Слои торта
-	Categories      []Category  // Категории торта
-	Images          []CakeImage // Фотографии торта
-	CakeColor       []CakeColor // Цвета торта
+	ID              uuid.UUID    // Код
+	Name            string       // Название
+	PreviewImageURL string       // Картинка товара
+	KgPrice         float64      // Цена за кг
+	ReviewsCount    int32        // Количество отзывов
+	StarsSum        int32        // Сумма звёзд
+	Rating          float64      // Средний рейтинг (stars_sum / reviews_count)
+	Description     string       // Описание
+	Mass            float64      // Масса торта
+	IsOpenForSale   bool         // Флаг возможности продажи торта
+	DateCreation    time.Time    // Дата создания торта
+	DiscountKgPrice null.Float   // Скидочная цена за кг
+	DiscountEndTime null.Time    // Дата окончания скидки
+	Owner           User         // Владелец
+	Fillings        []Filling    // Слои торта
+	Categories      []Category   // Категории торта
+	Images          []CakeImage  // Фотографии торта
+	CakeColor       []CakeColor  // Цвета торта
+	FavoritesCount  int32        // Сколько пользователей добавили торт в избранное
+	Options         []CakeOption // Опции торта (ярусы, надпись, декор)
 }
 
 type CakeColor struct {
@@ -74,10 +77,9 @@ func (c *Cake) ConvertToCakeGRPC() *gen.Cake {
 		cakeImages[i] = it.ConvertToCakeImageGRPC()
 	}
 
-	// рейтинг = сумма / кол-во
-	var rating int32 = 0
-	if c.ReviewsCount != 0 {
-		rating = c.StarsSum / c.ReviewsCount
+	cakeOptions := make([]*gen.CakeOption, len(c.Options))
+	for i, it := range c.Options {
+		cakeOptions[i] = it.ConvertToGrpcModel()
 	}
 
 	return &gen.Cake{
@@ -85,7 +87,7 @@ func (c *Cake) ConvertToCakeGRPC() *gen.Cake {
 		Name:            c.Name,
 		ImageUrl:        c.PreviewImageURL,
 		KgPrice:         c.KgPrice,
-		Rating:          rating,
+		Rating:          c.Rating,
 		Description:     c.Description,
 		Mass:            c.Mass,
 		IsOpenForSale:   c.IsOpenForSale,
@@ -97,5 +99,7 @@ func (c *Cake) ConvertToCakeGRPC() *gen.Cake {
 		DateCreation:    timestamppb.New(c.DateCreation),
 		Images:          cakeImages,
 		ReviewsCount:    c.ReviewsCount,
+		FavoritesCount:  c.FavoritesCount,
+		Options:         cakeOptions,
 	}
 }
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxCakeOptions           = 20
+	MaxCakeOptionNameLength  = 100
+	MaxCakeOptionValues      = 50
+	DefaultOptionTextLength  = 100
+	MaxCakeOptionTextLength  = 1000
+	MaxCakeOptionPriceChange = 1_000_000
+)
+
+type CakeOptionKind string
+
+const (
+	CakeOptionKindSingle   CakeOptionKind = "single"
+	CakeOptionKindMultiple CakeOptionKind = "multiple"
+	CakeOptionKindText     CakeOptionKind = "text"
+)
+
+func ConvertToCakeOptionKindFromGrpc(kind gen.CakeOptionKind) (CakeOptionKind, error) {
+	switch kind {
+	case gen.CakeOptionKind_CAKE_OPTION_KIND_SINGLE:
+		return CakeOptionKindSingle, nil
+	case gen.CakeOptionKind_CAKE_OPTION_KIND_MULTIPLE:
+		return CakeOptionKindMultiple, nil
+	case gen.CakeOptionKind_CAKE_OPTION_KIND_TEXT:
+		return CakeOptionKindText, nil
+	default:
+		return "", errs.ErrInvalidInput
+	}
+}
+
+func (k CakeOptionKind) ConvertToGrpc() gen.CakeOptionKind {
+	switch k {
+	case CakeOptionKindMultiple:
+		return gen.CakeOptionKind_CAKE_OPTION_KIND_MULTIPLE
+	case CakeOptionKindText:
+		return gen.CakeOptionKind_CAKE_OPTION_KIND_TEXT
+	default:
+		return gen.CakeOptionKind_CAKE_OPTION_KIND_SINGLE
+	}
+}
+
+// CakeOption Группа опций торта (ярусы, надпись, свечи, фигурки)
+type CakeOption struct {
+	ID            uuid.UUID
+	CakeID        uuid.UUID
+	Name          string
+	Kind          CakeOptionKind
+	IsRequired    bool
+	PriceModifier float64  // Наценка за текст (только для текстовых опций)
+	MaxTextLength null.Int // Максимальная длина текста (только для текстовых опций)
+	Values        []CakeOptionValue
+}
+
+// CakeOptionValue Значение группы опций
+type CakeOptionValue struct {
+	ID            uuid.UUID
+	Name          string
+	PriceModifier float64 // Наценка к стоимости заказа
+}
+
+func NewCakeOption(in *gen.CakeOptionInput) (CakeOption, error) {
+	if in == nil {
+		return CakeOption{}, errs.ErrInvalidInput
+	}
+
+	kind, err := ConvertToCakeOptionKindFromGrpc(in.Kind)
+	if err != nil {
+		return CakeOption{}, err
+	}
+
+	option := CakeOption{
+		Name:          strings.TrimSpace(in.Name),
+		Kind:          kind,
+		IsRequired:    in.IsRequired,
+		PriceModifier: in.PriceModifier,
+		Values:        make([]CakeOptionValue, len(in.Values)),
+	}
+	if in.MaxTextLength != nil {
+		option.MaxTextLength = null.IntFrom(int64(in.GetMaxTextLength()))
+	}
+
+	for i, value := range in.Values {
+		// ID нового значения назначается при сохранении
+		var valueID uuid.UUID
+		if value.Id != nil {
+			if valueID, err = uuid.Parse(value.GetId()); err != nil {
+				return CakeOption{}, errs.ErrInvalidInput
+			}
+		}
+
+		option.Values[i] = CakeOptionValue{
+			ID:            valueID,
+			Name:          strings.TrimSpace(value.Name),
+			PriceModifier: value.PriceModifier,
+		}
+	}
+
+	return option, nil
+}
+
+// Validate Проверяет группу опций: у текстовой опции нет значений, у остальных — нет наценки за текст
+func (o *CakeOption) Validate() error {
+	if !validOptionName(o.Name) || !validPriceModifier(o.PriceModifier) {
+		return errs.ErrInvalidInput
+	}
+
+	if o.Kind == CakeOptionKindText {
+		if len(o.Values) != 0 ||
+			(o.MaxTextLength.Valid && (o.MaxTextLength.Int64 <= 0 || o.MaxTextLength.Int64 > MaxCakeOptionTextLength)) {
+			return errs.ErrInvalidInput
+		}
+		return nil
+	}
+
+	if len(o.Values) == 0 || len(o.Values) > MaxCakeOptionValues || o.PriceModifier != 0 || o.MaxTextLength.Valid {
+		return errs.ErrInvalidInput
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(o.Values))
+	for _, value := range o.Values {
+		if !validOptionName(value.Name) || !validPriceModifier(value.PriceModifier) {
+			return errs.ErrInvalidInput
+		}
+		if value.ID == uuid.Nil {
+			continue
+		}
+		if _, ok := seen[value.ID]; ok {
+			return errs.ErrInvalidInput
+		}
+		seen[value.ID] = struct{}{}
+	}
+
+	return nil
+}
+
+// TextLimit Максимальная длина текста текстовой опции
+func (o *CakeOption) TextLimit() int {
+	if o.MaxTextLength.Valid {
+		return int(o.MaxTextLength.Int64)
+	}
+
+	return DefaultOptionTextLength
+}
+
+func (o *CakeOption) ConvertToGrpcModel() *gen.CakeOption {
+	var maxTextLength *int32
+	if o.MaxTextLength.Valid {
+		value := int32(o.MaxTextLength.Int64)
+		maxTextLength = &value
+	}
+
+	values := make([]*gen.CakeOptionValue, len(o.Values))
+	for i, value := range o.Values {
+		values[i] = &gen.CakeOptionValue{
+			Id:            value.ID.String(),
+			Name:          value.Name,
+			PriceModifier: value.PriceModifier,
+		}
+	}
+
+	return &gen.CakeOption{
+		Id:            o.ID.String(),
+		Name:          o.Name,
+		Kind:          o.Kind.ConvertToGrpc(),
+		IsRequired:    o.IsRequired,
+		PriceModifier: o.PriceModifier,
+		MaxTextLength: maxTextLength,
+		Values:        values,
+	}
+}
+
+func validOptionName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= MaxCakeOptionNameLength
+}
+
+func validPriceModifier(price float64) bool {
+	return price >= 0 && price <= MaxCakeOptionPriceChange
+}
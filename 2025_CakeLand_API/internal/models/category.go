@@ -42,6 +42,35 @@ func ConvertToCategoryGenderFromGrpc(categoryGen generated.CategoryGender) (Cate
 	}
 }
 
+// ConvertToCategoryGendersFromGrpc Переводит теги категории из запроса, убирая повторы
+func ConvertToCategoryGendersFromGrpc(tags []generated.CategoryGender) ([]CategoryGender, error) {
+	genders := make([]CategoryGender, 0, len(tags))
+	seen := make(map[CategoryGender]struct{}, len(tags))
+	for _, tag := range tags {
+		gender, err := ConvertToCategoryGenderFromGrpc(tag)
+		if err != nil {
+			return nil, errs.ErrInvalidInput
+		}
+		if _, ok := seen[gender]; ok {
+			continue
+		}
+		seen[gender] = struct{}{}
+		genders = append(genders, gender)
+	}
+
+	return genders, nil
+}
+
+// GenderTagsToStrings Теги категории в виде, в котором они хранятся в бд
+func GenderTagsToStrings(genders []CategoryGender) []string {
+	tags := make([]string, len(genders))
+	for i, gender := range genders {
+		tags[i] = string(gender)
+	}
+
+	return tags
+}
+
 func ParseGenderTags(tags pq.StringArray) []CategoryGender {
 	genders := make([]CategoryGender, len(tags))
 	for i, tag := range tags {
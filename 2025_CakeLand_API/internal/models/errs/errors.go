@@ -1,12 +1,19 @@
 package errs
 
 import (
+	"2025_CakeLand_API/internal/domains"
 	"context"
 	"errors"
 	"fmt"
+	"github.com/lib/pq"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"log/slog"
+	"math"
+	"strconv"
+	"time"
 )
 
 var (
@@ -19,8 +26,13 @@ var (
 	ErrInvalidPassword        = errors.New("invalid password")
 	ErrNoToken                = errors.New("no token")
 	ErrInvalidRefreshToken    = errors.New("invalid refresh token")
+	ErrRefreshTokenReused     = errors.New("refresh token reuse detected")
 	ErrNoMetadata             = errors.New("no metadata")
 	ErrTokenIsExpired         = errors.New("token is expired")
+	ErrTokenRevoked           = errors.New("token is revoked")
+	ErrInvalidIDToken         = errors.New("invalid provider id token")
+	ErrProviderNotSupported   = errors.New("oauth provider is not supported")
+	ErrLastLoginMethod        = errors.New("last login method cannot be removed")
 	ErrClaimIsMissing         = errors.New("claim is missing")
 	ErrPreviewImageNotFound   = errors.New("preview image not found")
 	ErrDB                     = errors.New("database error")
@@ -29,8 +41,33 @@ var (
 	ErrTotalPriceIncorrect    = errors.New("total price incorrect")
 	ErrMassNotExists          = errors.New("non-existent mass")
 	ErrNicknameIsRequired     = errors.New("nickname is required")
+	ErrPermissionDenied       = errors.New("permission denied")
+	ErrCakeIsNotForSale       = errors.New("cake is not for sale")
+	ErrPromoCodeNotApplicable = errors.New("promo code is not applicable")
+	ErrInvalidCode            = errors.New("invalid or expired code")
+	ErrEmailNotVerified       = errors.New("email is not verified")
+	ErrTooManyAttempts        = errors.New("too many attempts")
 )
 
+// TooManyAttemptsError Попытки временно заблокированы. Сравнивается с ErrTooManyAttempts через errors.Is
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func NewTooManyAttemptsError(retryAfter time.Duration) error {
+	return &TooManyAttemptsError{
+		RetryAfter: retryAfter,
+	}
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("%v: retry after %s", ErrTooManyAttempts, e.RetryAfter.Round(time.Second))
+}
+
+func (e *TooManyAttemptsError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
 func ConvertToGrpcError(ctx context.Context, log *slog.Logger, err error, description string) error {
 	if err == nil {
 		return nil
@@ -38,7 +75,14 @@ func ConvertToGrpcError(ctx context.Context, log *slog.Logger, err error, descri
 
 	logGRPCError(ctx, log, err, description)
 
+	var tooManyAttempts *TooManyAttemptsError
 	switch {
+	case errors.As(err, &tooManyAttempts):
+		// Клиент узнаёт, когда повторить, из заголовка ответа
+		retryAfter := int(math.Ceil(tooManyAttempts.RetryAfter.Seconds()))
+		_ = grpc.SetHeader(ctx, metadata.Pairs(domains.KeyRetryAfter.String(), strconv.Itoa(retryAfter)))
+		return status.Error(codes.ResourceExhausted, fmt.Sprintf("%v: %s", err, description))
+
 	case errors.Is(err, ErrDB):
 		return status.Error(codes.Internal, "internal server error")
 
@@ -48,7 +92,9 @@ func ConvertToGrpcError(ctx context.Context, log *slog.Logger, err error, descri
 	case errors.Is(err, ErrPreviewImageNotFound):
 		return status.Error(codes.Internal, fmt.Sprintf("%v: %s", err, description))
 
-	case errors.Is(err, ErrTokenIsExpired):
+	case errors.Is(err, ErrTokenIsExpired),
+		errors.Is(err, ErrTokenRevoked),
+		errors.Is(err, ErrInvalidIDToken):
 		return status.Error(codes.Unauthenticated, fmt.Sprintf("%v: %s", err, description))
 
 	case errors.Is(err, ErrClaimIsMissing):
@@ -60,9 +106,21 @@ func ConvertToGrpcError(ctx context.Context, log *slog.Logger, err error, descri
 	case errors.Is(err, ErrAlreadyExists):
 		return status.Error(codes.AlreadyExists, fmt.Sprintf("%v: %s", err, description))
 
+	case errors.Is(err, ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("%v: %s", err, description))
+
+	case errors.Is(err, ErrCakeIsNotForSale),
+		errors.Is(err, ErrPromoCodeNotApplicable),
+		errors.Is(err, ErrEmailNotVerified),
+		errors.Is(err, ErrLastLoginMethod):
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("%v: %s", err, description))
+
 	case errors.Is(err, ErrNoToken):
 		return status.Error(codes.Unauthenticated, "missing token")
 
+	case errors.Is(err, ErrRefreshTokenReused):
+		return status.Error(codes.Unauthenticated, fmt.Sprintf("%v: %s", err, description))
+
 	case errors.Is(err, ErrInvalidPassword):
 		return status.Error(codes.InvalidArgument, "invalid email or password")
 
@@ -74,7 +132,9 @@ func ConvertToGrpcError(ctx context.Context, log *slog.Logger, err error, descri
 		errors.Is(err, ErrTotalPriceIncorrect),
 		errors.Is(err, ErrMassNotExists),
 		errors.Is(err, ErrNicknameIsRequired),
-		errors.Is(err, ErrInvalidRefreshToken):
+		errors.Is(err, ErrInvalidRefreshToken),
+		errors.Is(err, ErrInvalidCode),
+		errors.Is(err, ErrProviderNotSupported):
 		return status.Error(codes.InvalidArgument, fmt.Sprintf("%v: %s", err, description))
 
 	case errors.Is(err, ErrUnexpectedSignInMethod),
@@ -105,3 +165,12 @@ func logGRPCError(ctx context.Context, log *slog.Logger, err error, description
 func WrapDBError(method string, err error) error {
 	return fmt.Errorf("%w: %s: %w", ErrDB, method, err)
 }
+
+// uniqueViolation Код ошибки postgres при нарушении уникальности
+const uniqueViolation = "23505"
+
+// IsUniqueViolation Нарушено ли ограничение уникальности
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
@@ -7,12 +7,29 @@ import (
 	"time"
 )
 
+type FeedbackSort string
+
+const (
+	FeedbackSortNewest      FeedbackSort = "newest"
+	FeedbackSortMostHelpful FeedbackSort = "most_helpful"
+)
+
+func ConvertToFeedbackSortFromGrpc(sort gen.FeedbackSort) FeedbackSort {
+	switch sort {
+	case gen.FeedbackSort_MOST_HELPFUL:
+		return FeedbackSortMostHelpful
+	default:
+		return FeedbackSortNewest
+	}
+}
+
 type Feedback struct {
 	ID           uuid.UUID
 	Text         string
 	DateCreation time.Time
 	Rating       int
 	CakeID       uuid.UUID
+	HelpfulCount int
 	Author       UserInfo
 }
 
@@ -23,6 +40,32 @@ type FeedbackDB struct {
 	Rating       int
 	CakeID       uuid.UUID
 	AuthorID     uuid.UUID
+	HelpfulCount int
+	IsHidden     bool
+}
+
+// FeedbackReport Жалоба на отзыв
+type FeedbackReport struct {
+	ID         uuid.UUID // Код жалобы
+	FeedbackID uuid.UUID // Код отзыва
+	ReporterID uuid.UUID // Код пожаловавшегося пользователя
+	Reason     string    // Причина жалобы
+}
+
+// ReportedFeedbackDB Отзыв из очереди модерации
+type ReportedFeedbackDB struct {
+	Feedback       FeedbackDB
+	ReportsCount   int
+	Reasons        []string
+	LastReportDate time.Time
+}
+
+type ReportedFeedback struct {
+	Feedback       Feedback
+	ReportsCount   int
+	Reasons        []string
+	LastReportDate time.Time
+	IsHidden       bool
 }
 
 func (f *Feedback) ConvertToGRPC() *gen.Feedback {
@@ -35,6 +78,7 @@ func (f *Feedback) ConvertToGRPC() *gen.Feedback {
 		Rating:       int32(f.Rating),
 		CakeId:       f.CakeID.String(),
 		Author:       author,
+		HelpfulCount: int32(f.HelpfulCount),
 	}
 }
 
@@ -45,6 +89,27 @@ func (f *FeedbackDB) ConvertToFeedback(author UserInfo) Feedback {
 		DateCreation: f.DateCreation,
 		Rating:       f.Rating,
 		CakeID:       f.CakeID,
+		HelpfulCount: f.HelpfulCount,
 		Author:       author,
 	}
 }
+
+func (f *ReportedFeedbackDB) ConvertToReportedFeedback(author UserInfo) ReportedFeedback {
+	return ReportedFeedback{
+		Feedback:       f.Feedback.ConvertToFeedback(author),
+		ReportsCount:   f.ReportsCount,
+		Reasons:        f.Reasons,
+		LastReportDate: f.LastReportDate,
+		IsHidden:       f.Feedback.IsHidden,
+	}
+}
+
+func (f *ReportedFeedback) ConvertToGRPC() *gen.ReportedFeedback {
+	return &gen.ReportedFeedback{
+		Feedback:       f.Feedback.ConvertToGRPC(),
+		ReportsCount:   int32(f.ReportsCount),
+		Reasons:        f.Reasons,
+		LastReportDate: timestamppb.New(f.LastReportDate),
+		IsHidden:       f.IsHidden,
+	}
+}
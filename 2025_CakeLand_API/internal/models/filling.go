@@ -7,12 +7,18 @@ import (
 
 // Filling Модель начинки
 type Filling struct {
-	ID          uuid.UUID // Код
-	Name        string    // Название
-	ImageURL    string    // Картинка
-	Content     string    // Содержимое начинки
-	KgPrice     float64   // Цена за кг
-	Description string    // Описание
+	ID          uuid.UUID     // Код
+	Name        string        // Название
+	ImageURL    string        // Картинка
+	Content     string        // Содержимое начинки
+	KgPrice     float64       // Цена за кг
+	Description string        // Описание
+	OwnerID     uuid.NullUUID // Владелец (не задан — общая начинка платформы)
+}
+
+// IsPlatform Общая начинка платформы, ей управляют администраторы
+func (f *Filling) IsPlatform() bool {
+	return !f.OwnerID.Valid
 }
 
 func (f *Filling) ConvertToFillingGRPC() *generated.Filling {
@@ -23,5 +29,6 @@ func (f *Filling) ConvertToFillingGRPC() *generated.Filling {
 		Content:     f.Content,
 		KgPrice:     f.KgPrice,
 		Description: f.Description,
+		OwnerId:     nullUUIDToString(f.OwnerID),
 	}
 }
@@ -1,9 +1,59 @@
 package models
 
-import "time"
+import (
+	gen "2025_CakeLand_API/internal/pkg/auth/delivery/grpc/generated"
+	"time"
+)
 
 type JWTTokenPayload struct {
 	UserUID   string
 	Token     string
+	TokenID   string // jti
 	ExpiresIn time.Time
 }
+
+// AccessTokenClaims Проверенное содержимое access токена
+type AccessTokenClaims struct {
+	UserID    string
+	Roles     Roles
+	TokenID   string // jti, по нему токен отзывается до истечения
+	ExpiresAt time.Time
+}
+
+// JWK Публичный ключ проверки подписи access токенов (RFC 7517): RSA (n, e) или Ed25519 (crv, x)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+func (k *JWK) ConvertToGrpcModel() *gen.JWK {
+	return &gen.JWK{
+		Kty: k.Kty,
+		Kid: k.Kid,
+		Alg: k.Alg,
+		Use: k.Use,
+		N:   k.N,
+		E:   k.E,
+		Crv: k.Crv,
+		X:   k.X,
+	}
+}
+
+func NewJWK(in *gen.JWK) JWK {
+	return JWK{
+		Kty: in.Kty,
+		Kid: in.Kid,
+		Alg: in.Alg,
+		Use: in.Use,
+		N:   in.N,
+		E:   in.E,
+		Crv: in.Crv,
+		X:   in.X,
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// LoginAttempts Неудачные входы по ключу (аккаунт или IP) в текущем окне
+type LoginAttempts struct {
+	Failures     int
+	BlockedUntil time.Time // Нулевое время — вход не заблокирован
+}
+
+// LoginFailureReason Почему вход не удался. Пишется в журнал входов
+type LoginFailureReason string
+
+const (
+	LoginFailureUnknownEmail     LoginFailureReason = "unknown_email"
+	LoginFailureInvalidPassword  LoginFailureReason = "invalid_password"
+	LoginFailureEmailNotVerified LoginFailureReason = "email_not_verified"
+	LoginFailureThrottled        LoginFailureReason = "throttled"
+	LoginFailureInvalidCode      LoginFailureReason = "invalid_code"
+)
@@ -0,0 +1,35 @@
+package models
+
+import gen "2025_CakeLand_API/internal/pkg/auth/delivery/grpc/generated"
+
+// OAuthProvider Внешний провайдер входа
+type OAuthProvider string
+
+const (
+	OAuthProviderGoogle OAuthProvider = "google"
+	OAuthProviderApple  OAuthProvider = "apple"
+	OAuthProviderVK     OAuthProvider = "vk"
+)
+
+// OAuthIdentity Пользователь провайдера из проверенного ID токена. Subject неизменен, почта может меняться
+type OAuthIdentity struct {
+	Provider      OAuthProvider
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// NewOAuthProvider Провайдер из gRPC. false для OAUTH_PROVIDER_UNSPECIFIED и неизвестных значений
+func NewOAuthProvider(provider gen.OAuthProvider) (OAuthProvider, bool) {
+	switch provider {
+	case gen.OAuthProvider_OAUTH_PROVIDER_GOOGLE:
+		return OAuthProviderGoogle, true
+	case gen.OAuthProvider_OAUTH_PROVIDER_APPLE:
+		return OAuthProviderApple, true
+	case gen.OAuthProvider_OAUTH_PROVIDER_VK:
+		return OAuthProviderVK, true
+	}
+
+	return "", false
+}
@@ -1,10 +1,14 @@
 package models
 
 import (
+	"2025_CakeLand_API/internal/models/errs"
 	gen "2025_CakeLand_API/internal/pkg/order/delivery/grpc/generated"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 type PaymentMethod string
@@ -25,6 +29,191 @@ type OrderDB struct {
 	CakeID            uuid.UUID
 	DeliveryAddressID uuid.UUID
 	DeliveryDate      time.Time
+	PromoCode         string           // Промокод продавца (пусто — без промокода)
+	SelectedOptions   []SelectedOption // Выбранные покупателем опции торта
+	Options           []OrderOption    // Опции с наценками, сохраняемые вместе с заказом
+}
+
+// SelectedOption Опция торта, выбранная покупателем
+type SelectedOption struct {
+	OptionID uuid.UUID
+	ValueIDs []uuid.UUID
+	Text     string
+}
+
+// OrderOption Копия выбранной опции в заказе: название и наценка не меняются при редактировании опций торта
+type OrderOption struct {
+	ID            uuid.UUID
+	OptionID      uuid.UUID
+	ValueID       uuid.NullUUID
+	OptionName    string
+	ValueName     null.String
+	Text          null.String
+	PriceModifier float64
+}
+
+// NewOrderOptions Проверяет выбор покупателя по опциям торта и возвращает строки заказа с наценками.
+// Обязательные опции должны быть выбраны, single — ровно одно значение, multiple — одно или несколько, text — непустой текст
+func NewOrderOptions(options []CakeOption, selected []SelectedOption) ([]OrderOption, error) {
+	byID := make(map[uuid.UUID]*CakeOption, len(options))
+	for i := range options {
+		byID[options[i].ID] = &options[i]
+	}
+
+	chosen := make(map[uuid.UUID]struct{}, len(selected))
+	res := make([]OrderOption, 0, len(selected))
+	for _, choice := range selected {
+		option, ok := byID[choice.OptionID]
+		if !ok {
+			return nil, errs.ErrInvalidInput
+		}
+		if _, ok = chosen[option.ID]; ok {
+			return nil, errs.ErrInvalidInput
+		}
+		chosen[option.ID] = struct{}{}
+
+		if option.Kind == CakeOptionKindText {
+			text := strings.TrimSpace(choice.Text)
+			if len(choice.ValueIDs) != 0 || text == "" || utf8.RuneCountInString(text) > option.TextLimit() {
+				return nil, errs.ErrInvalidInput
+			}
+
+			res = append(res, OrderOption{
+				ID:            uuid.New(),
+				OptionID:      option.ID,
+				OptionName:    option.Name,
+				Text:          null.StringFrom(text),
+				PriceModifier: option.PriceModifier,
+			})
+			continue
+		}
+
+		if choice.Text != "" || len(choice.ValueIDs) == 0 ||
+			(option.Kind == CakeOptionKindSingle && len(choice.ValueIDs) != 1) {
+			return nil, errs.ErrInvalidInput
+		}
+
+		values := make(map[uuid.UUID]CakeOptionValue, len(option.Values))
+		for _, value := range option.Values {
+			values[value.ID] = value
+		}
+		for _, valueID := range choice.ValueIDs {
+			value, ok := values[valueID]
+			if !ok {
+				return nil, errs.ErrInvalidInput
+			}
+			// Повторно одно значение выбрать нельзя
+			delete(values, valueID)
+
+			res = append(res, OrderOption{
+				ID:            uuid.New(),
+				OptionID:      option.ID,
+				ValueID:       uuid.NullUUID{UUID: value.ID, Valid: true},
+				OptionName:    option.Name,
+				ValueName:     null.StringFrom(value.Name),
+				PriceModifier: value.PriceModifier,
+			})
+		}
+	}
+
+	for _, option := range options {
+		if _, ok := chosen[option.ID]; option.IsRequired && !ok {
+			return nil, errs.ErrInvalidInput
+		}
+	}
+
+	return res, nil
+}
+
+// OptionsPrice Сумма наценок за опции
+func OptionsPrice(options []OrderOption) float64 {
+	var price float64
+	for _, option := range options {
+		price += option.PriceModifier
+	}
+
+	return price
+}
+
+// NewSelectedOptions Разбирает выбранные опции из запроса
+func NewSelectedOptions(in []*gen.SelectedOption) ([]SelectedOption, error) {
+	res := make([]SelectedOption, len(in))
+	for i, option := range in {
+		optionID, err := uuid.Parse(option.OptionID)
+		if err != nil {
+			return nil, err
+		}
+
+		valueIDs := make([]uuid.UUID, len(option.ValueIDs))
+		for j, valueID := range option.ValueIDs {
+			if valueIDs[j], err = uuid.Parse(valueID); err != nil {
+				return nil, err
+			}
+		}
+
+		res[i] = SelectedOption{
+			OptionID: optionID,
+			ValueIDs: valueIDs,
+			Text:     option.GetText(),
+		}
+	}
+
+	return res, nil
+}
+
+// QuoteOrderReq Параметры расчёта стоимости заказа
+type QuoteOrderReq struct {
+	CakeID          uuid.UUID
+	Mass            float64
+	PromoCode       string // Нормализованный промокод (пусто — без промокода)
+	SelectedOptions []SelectedOption
+}
+
+func NewQuoteOrderReq(from *gen.QuoteOrderReq) (QuoteOrderReq, error) {
+	cakeID, err := uuid.Parse(from.CakeID)
+	if err != nil {
+		return QuoteOrderReq{}, err
+	}
+
+	selectedOptions, err := NewSelectedOptions(from.Options)
+	if err != nil {
+		return QuoteOrderReq{}, err
+	}
+
+	return QuoteOrderReq{
+		CakeID:          cakeID,
+		Mass:            from.Mass,
+		PromoCode:       NormalizePromoCode(from.GetPromoCode()),
+		SelectedOptions: selectedOptions,
+	}, nil
+}
+
+// OrderQuote Расчёт стоимости заказа
+type OrderQuote struct {
+	KgPrice       float64       // Цена за кг с учётом скидок и акций
+	PromoDiscount float64       // Скидка по промокоду
+	TotalPrice    float64       // Итоговая стоимость
+	SellerID      uuid.UUID     // Владелец торта
+	PromoCodeID   uuid.NullUUID // Применённый промокод
+	OptionsPrice  float64       // Сумма наценок за опции (входит в TotalPrice)
+	Options       []OrderOption // Выбранные опции с наценками
+}
+
+func (q *OrderQuote) ConvertToGrpcModel() *gen.QuoteOrderRes {
+	return &gen.QuoteOrderRes{
+		KgPrice:       q.KgPrice,
+		PromoDiscount: q.PromoDiscount,
+		TotalPrice:    q.TotalPrice,
+		OptionsPrice:  q.OptionsPrice,
+	}
+}
+
+// PromoCodeUsage Применение промокода к заказу
+type PromoCodeUsage struct {
+	PromoCodeID    uuid.UUID
+	UserID         uuid.UUID
+	OrderID        uuid.UUID
+	DiscountAmount float64
 }
 
 func Init(from *gen.MakeOrderReq) (OrderDB, error) {
@@ -53,6 +242,11 @@ func Init(from *gen.MakeOrderReq) (OrderDB, error) {
 		return OrderDB{}, err
 	}
 
+	selectedOptions, err := NewSelectedOptions(from.Options)
+	if err != nil {
+		return OrderDB{}, err
+	}
+
 	var paymentMethod PaymentMethod
 	switch from.PaymentMethod {
 	case gen.PaymentMethod_CASH:
@@ -73,5 +267,7 @@ func Init(from *gen.MakeOrderReq) (OrderDB, error) {
 		CakeID:            cakeID,
 		DeliveryAddressID: deliveryAddressID,
 		DeliveryDate:      deliveryDate,
+		PromoCode:         NormalizePromoCode(from.GetPromoCode()),
+		SelectedOptions:   selectedOptions,
 	}, nil
 }
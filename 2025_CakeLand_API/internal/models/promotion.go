@@ -0,0 +1,169 @@
+package models
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type DiscountKind string
+
+const (
+	DiscountKindPercent DiscountKind = "percent"
+	DiscountKindFixed   DiscountKind = "fixed"
+)
+
+func ConvertToDiscountKindFromGrpc(kind gen.DiscountKind) DiscountKind {
+	if kind == gen.DiscountKind_DISCOUNT_KIND_FIXED {
+		return DiscountKindFixed
+	}
+
+	return DiscountKindPercent
+}
+
+func (k DiscountKind) ConvertToGrpc() gen.DiscountKind {
+	if k == DiscountKindFixed {
+		return gen.DiscountKind_DISCOUNT_KIND_FIXED
+	}
+
+	return gen.DiscountKind_DISCOUNT_KIND_PERCENT
+}
+
+// Discount Скидка: процент или фиксированная сумма
+type Discount struct {
+	Kind  DiscountKind
+	Value float64
+}
+
+func (d Discount) Validate() error {
+	if d.Value <= 0 || (d.Kind == DiscountKindPercent && d.Value >= 100) {
+		return errs.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// Apply Возвращает цену после скидки (не меньше нуля).
+// Для акций то же правило считается в бд функцией cake_effective_price
+func (d Discount) Apply(price float64) float64 {
+	if d.Kind == DiscountKindPercent {
+		return price * (1 - d.Value/100)
+	}
+
+	return math.Max(price-d.Value, 0)
+}
+
+// RoundPrice Округляет сумму до копеек
+func RoundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+// Promotion Акция продавца: на торт, на его торты из категории или на весь ассортимент
+type Promotion struct {
+	ID          uuid.UUID
+	SellerID    uuid.UUID
+	CakeID      uuid.NullUUID // Торт (не задан — акция не ограничена тортом)
+	CategoryID  uuid.NullUUID // Категория (не задана — акция не ограничена категорией)
+	Discount    Discount
+	StartsAt    time.Time
+	EndsAt      time.Time
+	CreatedAt   time.Time
+	CancelledAt null.Time
+}
+
+func (p *Promotion) ConvertToGrpcModel() *gen.Promotion {
+	var cancelledAt *timestamppb.Timestamp
+	if p.CancelledAt.Valid {
+		cancelledAt = timestamppb.New(p.CancelledAt.Time)
+	}
+
+	return &gen.Promotion{
+		Id:          p.ID.String(),
+		CakeId:      nullUUIDToString(p.CakeID),
+		CategoryId:  nullUUIDToString(p.CategoryID),
+		Kind:        p.Discount.Kind.ConvertToGrpc(),
+		Value:       p.Discount.Value,
+		StartsAt:    timestamppb.New(p.StartsAt),
+		EndsAt:      timestamppb.New(p.EndsAt),
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		CancelledAt: cancelledAt,
+	}
+}
+
+// PromoCode Промокод продавца на заказ
+type PromoCode struct {
+	ID             uuid.UUID
+	Code           string // В верхнем регистре
+	SellerID       uuid.UUID
+	Discount       Discount // Скидка с суммы заказа
+	StartsAt       time.Time
+	ExpiresAt      time.Time
+	MaxUses        null.Int // Общий лимит применений (null — без ограничения)
+	MaxUsesPerUser int
+	UsesCount      int
+	CreatedAt      time.Time
+	CancelledAt    null.Time
+}
+
+// IsActive Проверяет, можно ли применить промокод в момент now (без учёта лимитов)
+func (p *PromoCode) IsActive(now time.Time) bool {
+	return !p.CancelledAt.Valid && !now.Before(p.StartsAt) && now.Before(p.ExpiresAt)
+}
+
+func (p *PromoCode) ConvertToGrpcModel() *gen.PromoCode {
+	var maxUses *int32
+	if p.MaxUses.Valid {
+		value := int32(p.MaxUses.Int64)
+		maxUses = &value
+	}
+
+	var cancelledAt *timestamppb.Timestamp
+	if p.CancelledAt.Valid {
+		cancelledAt = timestamppb.New(p.CancelledAt.Time)
+	}
+
+	return &gen.PromoCode{
+		Id:             p.ID.String(),
+		Code:           p.Code,
+		Kind:           p.Discount.Kind.ConvertToGrpc(),
+		Value:          p.Discount.Value,
+		StartsAt:       timestamppb.New(p.StartsAt),
+		ExpiresAt:      timestamppb.New(p.ExpiresAt),
+		MaxUses:        maxUses,
+		MaxUsesPerUser: int32(p.MaxUsesPerUser),
+		UsesCount:      int32(p.UsesCount),
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+		CancelledAt:    cancelledAt,
+	}
+}
+
+func nullUUIDToString(id uuid.NullUUID) *string {
+	if !id.Valid {
+		return nil
+	}
+
+	value := id.UUID.String()
+	return &value
+}
+
+var promoCodeRegexp = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// NormalizePromoCode Приводит промокод к виду, в котором он хранится в бд
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidatePromoCode Проверяет формат нормализованного промокода
+func ValidatePromoCode(code string) error {
+	if !promoCodeRegexp.MatchString(code) {
+		return errs.ErrInvalidInput
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package models
+
+type Role string
+
+const (
+	RoleCustomer  Role = "customer"
+	RoleSeller    Role = "seller"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+type Roles []Role
+
+// DefaultRoles Роли нового пользователя: он может и заказывать, и продавать торты
+var DefaultRoles = Roles{RoleCustomer, RoleSeller}
+
+// ParseRoles Неизвестные роли пропускаются
+func ParseRoles(values []string) Roles {
+	roles := make(Roles, 0, len(values))
+	for _, value := range values {
+		switch role := Role(value); role {
+		case RoleCustomer, RoleSeller, RoleModerator, RoleAdmin:
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// HasAny Есть ли у пользователя хотя бы одна из ролей
+func (r Roles) HasAny(roles ...Role) bool {
+	for _, have := range r {
+		for _, want := range roles {
+			if have == want {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r Roles) Strings() []string {
+	values := make([]string, len(r))
+	for i, role := range r {
+		values[i] = string(role)
+	}
+
+	return values
+}
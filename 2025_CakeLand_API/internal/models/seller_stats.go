@@ -0,0 +1,45 @@
+package models
+
+import (
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"github.com/guregu/null"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// SellerStats Агрегированная статистика витрины продавца
+type SellerStats struct {
+	Rating                 float64   // Средняя оценка по всем отзывам на торты продавца
+	ReviewsCount           int       // Количество отзывов
+	CompletedOrdersCount   int       // Количество доставленных заказов
+	AvgResponseTimeSeconds null.Int  // Среднее время ответа в чате (null, если продавец ещё не отвечал)
+	RegistrationDate       time.Time // Дата регистрации на платформе
+}
+
+func NewSellerStats(s *profileGen.SellerStats) SellerStats {
+	return SellerStats{
+		Rating:               s.GetRating(),
+		ReviewsCount:         int(s.GetReviewsCount()),
+		CompletedOrdersCount: int(s.GetCompletedOrdersCount()),
+		AvgResponseTimeSeconds: null.NewInt(
+			s.GetAvgResponseTimeSeconds(),
+			s != nil && s.AvgResponseTimeSeconds != nil,
+		),
+		RegistrationDate: s.GetRegistrationDate().AsTime(),
+	}
+}
+
+func (s *SellerStats) ConvertToGrpcModel() *profileGen.SellerStats {
+	var avgResponseTime *int64
+	if s.AvgResponseTimeSeconds.Valid {
+		avgResponseTime = &s.AvgResponseTimeSeconds.Int64
+	}
+
+	return &profileGen.SellerStats{
+		Rating:                 s.Rating,
+		ReviewsCount:           int32(s.ReviewsCount),
+		CompletedOrdersCount:   int32(s.CompletedOrdersCount),
+		AvgResponseTimeSeconds: avgResponseTime,
+		RegistrationDate:       timestamppb.New(s.RegistrationDate),
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+)
+
+// MaxServiceAreaRadiusKm Максимальный радиус доставки продавца
+const MaxServiceAreaRadiusKm = 200
+
+// GeoPoint Географическая точка
+type GeoPoint struct {
+	Latitude  float64 // Широта
+	Longitude float64 // Долгота
+}
+
+// Validate Проверяет, что координаты лежат в допустимых пределах
+func (p GeoPoint) Validate() error {
+	if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return errs.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// ServiceArea Зона доставки продавца
+type ServiceArea struct {
+	Center   GeoPoint // Центр зоны
+	RadiusKm float64  // Радиус доставки в километрах
+}
+
+func (a *ServiceArea) Validate() error {
+	if err := a.Center.Validate(); err != nil {
+		return err
+	}
+
+	if a.RadiusKm <= 0 || a.RadiusKm > MaxServiceAreaRadiusKm {
+		return errs.ErrInvalidInput
+	}
+
+	return nil
+}
+
+func NewServiceArea(a *profileGen.ServiceArea) *ServiceArea {
+	if a == nil {
+		return nil
+	}
+
+	return &ServiceArea{
+		Center: GeoPoint{
+			Latitude:  a.GetLatitude(),
+			Longitude: a.GetLongitude(),
+		},
+		RadiusKm: a.GetRadiusKm(),
+	}
+}
+
+func (a *ServiceArea) ConvertToGrpcModel() *profileGen.ServiceArea {
+	if a == nil {
+		return nil
+	}
+
+	return &profileGen.ServiceArea{
+		Latitude:  a.Center.Latitude,
+		Longitude: a.Center.Longitude,
+		RadiusKm:  a.RadiusKm,
+	}
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	gen "2025_CakeLand_API/internal/pkg/auth/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Session Сессия пользователя на устройстве (fingerprint)
+type Session struct {
+	ID          uuid.UUID // Код
+	Fingerprint string    // Отпечаток устройства
+	DeviceName  string    // Название устройства
+	IPAddress   string    // IP адрес последнего входа или обновления токена
+	CreatedAt   time.Time // Вход на устройстве
+	LastUsedAt  time.Time // Последнее обновление токена
+	ExpiresAt   time.Time // Когда истекает refresh токен
+}
+
+func (s *Session) ConvertToGrpcModel(currentFingerprint string) *gen.Session {
+	return &gen.Session{
+		Id:         s.ID.String(),
+		DeviceName: s.DeviceName,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt.Unix(),
+		LastUsedAt: s.LastUsedAt.Unix(),
+		ExpiresAt:  s.ExpiresAt.Unix(),
+		IsCurrent:  s.Fingerprint == currentFingerprint,
+	}
+}
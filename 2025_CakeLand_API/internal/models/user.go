@@ -3,27 +3,26 @@ package models
 import (
 	"2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
 	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
-	"encoding/json"
-	"errors"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-type RefreshTokenMap map[string]string // key: fingerprint, value: refreshToken
+// MaxUsersByIDs Сколько пользователей можно запросить одним GetUsersByIDs
+const MaxUsersByIDs = 100
 
 type User struct {
-	ID               uuid.UUID       // Код
-	FIO              null.String     // ФИО
-	Address          null.String     // Адрес
-	Nickname         string          // Уникальный псевдоним (default: id)
-	ImageURL         null.String     // Картинка
-	HeaderImageURL   null.String     // Картинка шапки профиля
-	Mail             string          // Почта
-	PasswordHash     []byte          // Пароль
-	Phone            null.String     // Телефон
-	CardNumber       null.String     // Номер кредитной карты
-	RefreshTokensMap RefreshTokenMap // Рефреш токены (key: fingerprint, value: refreshToken)
+	ID             uuid.UUID   // Код
+	FIO            null.String // ФИО
+	Address        null.String // Адрес
+	Nickname       string      // Уникальный псевдоним (default: id)
+	ImageURL       null.String // Картинка
+	HeaderImageURL null.String // Картинка шапки профиля
+	Mail           string      // Почта
+	PasswordHash   []byte      // Пароль
+	Phone          null.String // Телефон
+	CardNumber     null.String // Номер кредитной карты
+	Roles          Roles       // Роли пользователя
 }
 
 type UserInfo struct {
@@ -35,6 +34,8 @@ type UserInfo struct {
 	HeaderImageURL null.String
 	Mail           string
 	Phone          null.String
+	Stats          SellerStats
+	ServiceArea    *ServiceArea // Зона доставки (nil, если не задана)
 }
 
 func NewUserInfo(u *profileGen.Profile) *UserInfo {
@@ -62,6 +63,8 @@ func NewUserInfo(u *profileGen.Profile) *UserInfo {
 			u.GetHeaderImageUrl().GetValue(),
 			u.HeaderImageUrl != nil,
 		),
+		Stats:       NewSellerStats(u.GetStats()),
+		ServiceArea: NewServiceArea(u.GetServiceArea()),
 	}
 }
 
@@ -138,18 +141,7 @@ func (u *UserInfo) ConvertToGRPCProfile() *profileGen.Profile {
 		Phone:          phoneNumber,
 		ImageUrl:       imageURL,
 		HeaderImageUrl: headerImageURL,
+		Stats:          u.Stats.ConvertToGrpcModel(),
+		ServiceArea:    u.ServiceArea.ConvertToGrpcModel(),
 	}
 }
-
-// Scan Реализуем интерфейс sql.Scanner для JSONB
-func (rtm *RefreshTokenMap) Scan(src interface{}) error {
-	if src == nil {
-		return nil
-	}
-
-	bytes, ok := src.([]byte)
-	if !ok {
-		return errors.New("RefreshTokenMap: type assertion to []byte failed")
-	}
-	return json.Unmarshal(bytes, rtm)
-}
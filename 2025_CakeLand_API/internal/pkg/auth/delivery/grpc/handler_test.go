@@ -8,6 +8,7 @@ import (
 	"2025_CakeLand_API/internal/pkg/utils"
 	"2025_CakeLand_API/internal/pkg/utils/logger"
 	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"2025_CakeLand_API/internal/pkg/utils/password"
 	"context"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -27,7 +28,7 @@ func TestRegisterHandler(t *testing.T) {
 
 	// Создаём gRPC-хэндлер с мокнутым usecase
 	log := logger.NewLogger("local")
-	validator := utils.NewValidator()
+	validator := utils.NewValidator(password.DefaultPolicy())
 	mdProvider := md.NewMetadataProvider()
 	h := handler.NewGrpcAuthHandler(log, validator, mockAuthUsecase, mdProvider)
 
@@ -39,6 +40,9 @@ func TestRegisterHandler(t *testing.T) {
 			RefreshToken: "test-refresh-token",
 			ExpiresIn:    time.Time{},
 		}, nil)
+	mockAuthUsecase.EXPECT().
+		SendVerificationCode(gomock.Any(), dto.SendVerificationCodeReq{Email: "test@example.com"}).
+		Return(nil)
 
 	// Добавляем метаданные с fingerprint в контекст
 	md := metadata.New(map[string]string{
@@ -51,6 +55,7 @@ func TestRegisterHandler(t *testing.T) {
 		res, err := h.Register(ctx, &generated.RegisterRequest{
 			Email:    "test@example.com",
 			Password: "password123",
+			Nickname: "tester",
 		})
 
 		// Проверяем результат
@@ -73,6 +78,21 @@ func TestRegisterHandler(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 	})
 
+	t.Run("Email with display name", func(t *testing.T) {
+		res, err := h.Register(ctx, &generated.RegisterRequest{
+			Email:    "Tester <test@example.com>",
+			Password: "password123",
+			Nickname: "tester",
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
 	t.Run("Bad Password", func(t *testing.T) {
 		res, err := h.Register(ctx, &generated.RegisterRequest{
 			Email:    "test@example.com",
@@ -2,16 +2,20 @@ package handler
 
 import (
 	"2025_CakeLand_API/internal/domains"
+	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/auth"
 	gen "2025_CakeLand_API/internal/pkg/auth/delivery/grpc/generated"
 	"2025_CakeLand_API/internal/pkg/auth/dto"
 	"2025_CakeLand_API/internal/pkg/utils"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	md "2025_CakeLand_API/internal/pkg/utils/metadata"
 	"context"
 	"fmt"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log/slog"
+	"strings"
 )
 
 type GrpcAuthHandler struct {
@@ -47,7 +51,8 @@ func (h *GrpcAuthHandler) Register(ctx context.Context, req *gen.RegisterRequest
 	}
 
 	// Валидация
-	if err = h.validator.ValidateEmail(req.Email); err != nil {
+	email, err := h.validator.ParseEmail(req.Email)
+	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid email format")
 	} else if err = h.validator.ValidatePassword(req.Password); err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid password format")
@@ -57,15 +62,24 @@ func (h *GrpcAuthHandler) Register(ctx context.Context, req *gen.RegisterRequest
 
 	// Сохраняем пользователя в бд
 	res, err := h.usecase.Register(ctx, dto.RegisterReq{
-		Email:       req.Email,
+		Email:       email,
 		Password:    req.Password,
 		Nickname:    req.Nickname,
 		Fingerprint: fingerprint,
+		DeviceName:  h.deviceName(ctx),
+		IPAddress:   h.mdProvider.ClientIP(ctx),
 	})
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to register user")
 	}
 
+	// Аккаунт уже создан: если письмо не ушло, код можно запросить повторно через SendVerificationCode
+	if err = h.usecase.SendVerificationCode(ctx, dto.SendVerificationCodeReq{
+		Email: email,
+	}); err != nil {
+		h.log.WarnContext(ctx, "failed to send verification code", slog.String("error", err.Error()))
+	}
+
 	return &gen.RegisterResponse{
 		AccessToken:  res.AccessToken,
 		RefreshToken: res.RefreshToken,
@@ -82,27 +96,31 @@ func (h *GrpcAuthHandler) Login(ctx context.Context, req *gen.LoginRequest) (*ge
 		)
 	}
 
-	// Валидация
-	if err = h.validator.ValidateEmail(req.Email); err != nil {
+	// Валидация. Политика паролей действует только для новых паролей: старые должны продолжать работать
+	email, err := h.validator.ParseEmail(req.Email)
+	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid email format")
-	} else if err = h.validator.ValidatePassword(req.Password); err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid password format")
+	} else if req.Password == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "password is empty")
 	}
 
 	// Сохраняем пользователя в бд
 	res, loginErr := h.usecase.Login(ctx, dto.LoginReq{
-		Email:       req.Email,
+		Email:       email,
 		Password:    req.Password,
 		Fingerprint: fingerprint,
+		DeviceName:  h.deviceName(ctx),
+		IPAddress:   h.mdProvider.ClientIP(ctx),
 	})
 	if loginErr != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, loginErr, "failed to login")
 	}
 
 	return &gen.LoginResponse{
-		AccessToken:  res.AccessToken,
-		RefreshToken: res.RefreshToken,
-		ExpiresIn:    res.ExpiresIn.Unix(),
+		AccessToken:    res.AccessToken,
+		RefreshToken:   res.RefreshToken,
+		ExpiresIn:      res.ExpiresIn.Unix(),
+		ChallengeToken: res.ChallengeToken,
 	}, nil
 }
 
@@ -162,13 +180,509 @@ func (h *GrpcAuthHandler) UpdateAccessToken(ctx context.Context, _ *emptypb.Empt
 	res, err := h.usecase.UpdateAccessToken(ctx, dto.UpdateAccessTokenReq{
 		RefreshToken: refreshToken,
 		Fingerprint:  fingerprint,
+		IPAddress:    h.mdProvider.ClientIP(ctx),
 	})
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update access token")
 	}
 
 	return &gen.UpdateAccessTokenResponse{
-		AccessToken: res.AccessToken,
-		ExpiresIn:   res.ExpiresIn.Unix(),
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
+		ExpiresIn:    res.ExpiresIn.Unix(),
+	}, nil
+}
+
+func (h *GrpcAuthHandler) ListSessions(ctx context.Context, _ *emptypb.Empty) (*gen.ListSessionsResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fingerprint нужен только чтобы отметить текущую сессию
+	fingerprint, _ := h.mdProvider.GetValue(ctx, domains.KeyFingerprint)
+
+	// Бизнес логика
+	sessions, err := h.usecase.ListSessions(ctx, dto.ListSessionsReq{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch sessions")
+	}
+
+	// Ответ
+	grpcSessions := make([]*gen.Session, len(sessions))
+	for i, session := range sessions {
+		grpcSessions[i] = session.ConvertToGrpcModel(fingerprint)
+	}
+
+	return &gen.ListSessionsResponse{
+		Sessions: grpcSessions,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) RevokeSession(ctx context.Context, in *gen.RevokeSessionRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	sessionID, err := uuid.Parse(in.SessionId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'session_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.RevokeSession(ctx, dto.RevokeSessionReq{
+		UserID:    userID,
+		SessionID: sessionID,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to revoke session")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) RevokeAllOtherSessions(ctx context.Context, _ *emptypb.Empty) (*gen.RevokeAllOtherSessionsResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получение метаданных
+	fingerprint, err := h.mdProvider.GetValue(ctx, domains.KeyFingerprint)
+	if err != nil || fingerprint == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrNoMetadata,
+			fmt.Sprintf("missing required metadata: %s", domains.KeyFingerprint),
+		)
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.RevokeAllOtherSessions(ctx, dto.RevokeAllOtherSessionsReq{
+		UserID:      userID,
+		Fingerprint: fingerprint,
+	})
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to revoke sessions")
+	}
+
+	// Ответ
+	return &gen.RevokeAllOtherSessionsResponse{
+		RevokedCount: int32(res.RevokedCount),
+	}, nil
+}
+
+func (h *GrpcAuthHandler) SendVerificationCode(ctx context.Context, in *gen.SendVerificationCodeRequest) (*emptypb.Empty, error) {
+	// Валидация
+	email, err := h.validator.ParseEmail(in.Email)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid email format")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.SendVerificationCode(ctx, dto.SendVerificationCodeReq{
+		Email: email,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to send verification code")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) VerifyEmail(ctx context.Context, in *gen.VerifyEmailRequest) (*emptypb.Empty, error) {
+	// Валидация
+	email, err := h.validator.ParseEmail(in.Email)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid email format")
+	} else if in.Code == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "code is empty")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.VerifyEmail(ctx, dto.VerifyEmailReq{
+		Email: email,
+		Code:  in.Code,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to verify email")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) RequestPasswordReset(ctx context.Context, in *gen.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	// Валидация
+	email, err := h.validator.ParseEmail(in.Email)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid email format")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.RequestPasswordReset(ctx, dto.RequestPasswordResetReq{
+		Email: email,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to request password reset")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) ResetPassword(ctx context.Context, in *gen.ResetPasswordRequest) (*emptypb.Empty, error) {
+	// Валидация
+	email, err := h.validator.ParseEmail(in.Email)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid email format")
+	} else if in.Code == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "code is empty")
+	} else if err = h.validator.ValidatePassword(in.NewPassword); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid password format")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.ResetPassword(ctx, dto.ResetPasswordReq{
+		Email:       email,
+		Code:        in.Code,
+		NewPassword: in.NewPassword,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to reset password")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) ChangePassword(ctx context.Context, in *gen.ChangePasswordRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получение метаданных
+	fingerprint, err := h.mdProvider.GetValue(ctx, domains.KeyFingerprint)
+	if err != nil || fingerprint == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrNoMetadata,
+			fmt.Sprintf("missing required metadata: %s", domains.KeyFingerprint),
+		)
+	}
+
+	// Валидация
+	if in.CurrentPassword == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "current password is empty")
+	} else if err = h.validator.ValidatePassword(in.NewPassword); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid password format")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.ChangePassword(ctx, dto.ChangePasswordReq{
+		UserID:          userID,
+		Fingerprint:     fingerprint,
+		CurrentPassword: in.CurrentPassword,
+		NewPassword:     in.NewPassword,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to change password")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) GetJWKS(ctx context.Context, _ *emptypb.Empty) (*gen.JWKSResponse, error) {
+	// Бизнес логика
+	keys := h.usecase.JWKS(ctx)
+
+	// Ответ
+	grpcKeys := make([]*gen.JWK, len(keys))
+	for i, key := range keys {
+		grpcKeys[i] = key.ConvertToGrpcModel()
+	}
+
+	return &gen.JWKSResponse{
+		Keys: grpcKeys,
 	}, nil
 }
+
+func (h *GrpcAuthHandler) OAuthLogin(ctx context.Context, in *gen.OAuthLoginRequest) (*gen.OAuthLoginResponse, error) {
+	// Получение метаданных
+	fingerprint, err := h.mdProvider.GetValue(ctx, domains.KeyFingerprint)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err,
+			fmt.Sprintf("missing required metadata: %s", domains.KeyFingerprint),
+		)
+	}
+
+	// Валидация
+	provider, ok := models.NewOAuthProvider(in.Provider)
+	if !ok {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "unknown oauth provider")
+	} else if in.IdToken == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "id token is empty")
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.OAuthLogin(ctx, dto.OAuthLoginReq{
+		Provider:    provider,
+		IDToken:     in.IdToken,
+		Nonce:       in.Nonce,
+		Nickname:    strings.TrimSpace(in.Nickname),
+		Fingerprint: fingerprint,
+		DeviceName:  h.deviceName(ctx),
+		IPAddress:   h.mdProvider.ClientIP(ctx),
+	})
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to login with oauth provider")
+	}
+
+	// Ответ
+	return &gen.OAuthLoginResponse{
+		AccessToken:    res.AccessToken,
+		RefreshToken:   res.RefreshToken,
+		ExpiresIn:      res.ExpiresIn.Unix(),
+		IsNewUser:      res.Created,
+		ChallengeToken: res.ChallengeToken,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) LinkProvider(ctx context.Context, in *gen.LinkProviderRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Валидация
+	provider, ok := models.NewOAuthProvider(in.Provider)
+	if !ok {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "unknown oauth provider")
+	} else if in.IdToken == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "id token is empty")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.LinkProvider(ctx, dto.LinkProviderReq{
+		UserID:   userID,
+		Provider: provider,
+		IDToken:  in.IdToken,
+		Nonce:    in.Nonce,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to link oauth provider")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) UnlinkProvider(ctx context.Context, in *gen.UnlinkProviderRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Валидация
+	provider, ok := models.NewOAuthProvider(in.Provider)
+	if !ok {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "unknown oauth provider")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.UnlinkProvider(ctx, dto.UnlinkProviderReq{
+		UserID:   userID,
+		Provider: provider,
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to unlink oauth provider")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) RequestPhoneCode(ctx context.Context, in *gen.RequestPhoneCodeRequest) (*emptypb.Empty, error) {
+	// Валидация
+	phone, err := h.validator.ParsePhone(in.Phone)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid phone format")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.RequestPhoneCode(ctx, dto.RequestPhoneCodeReq{
+		Phone:     phone,
+		IPAddress: h.mdProvider.ClientIP(ctx),
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to send phone code")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcAuthHandler) VerifyPhoneCode(ctx context.Context, in *gen.VerifyPhoneCodeRequest) (*gen.VerifyPhoneCodeResponse, error) {
+	// Получение метаданных
+	fingerprint, err := h.mdProvider.GetValue(ctx, domains.KeyFingerprint)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err,
+			fmt.Sprintf("missing required metadata: %s", domains.KeyFingerprint),
+		)
+	}
+
+	// Валидация
+	phone, err := h.validator.ParsePhone(in.Phone)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid phone format")
+	} else if in.Code == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "code is empty")
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.VerifyPhoneCode(ctx, dto.VerifyPhoneCodeReq{
+		Phone:       phone,
+		Code:        in.Code,
+		Nickname:    strings.TrimSpace(in.Nickname),
+		Fingerprint: fingerprint,
+		DeviceName:  h.deviceName(ctx),
+		IPAddress:   h.mdProvider.ClientIP(ctx),
+	})
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to login with phone code")
+	}
+
+	// Ответ
+	return &gen.VerifyPhoneCodeResponse{
+		AccessToken:    res.AccessToken,
+		RefreshToken:   res.RefreshToken,
+		ExpiresIn:      res.ExpiresIn.Unix(),
+		IsNewUser:      res.Created,
+		ChallengeToken: res.ChallengeToken,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) LoginTwoFactor(ctx context.Context, in *gen.LoginTwoFactorRequest) (*gen.LoginResponse, error) {
+	// Получение метаданных
+	fingerprint, err := h.mdProvider.GetValue(ctx, domains.KeyFingerprint)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err,
+			fmt.Sprintf("missing required metadata: %s", domains.KeyFingerprint),
+		)
+	}
+
+	// Валидация
+	if in.ChallengeToken == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "challenge token is empty")
+	} else if in.Code == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "code is empty")
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.LoginTwoFactor(ctx, dto.LoginTwoFactorReq{
+		ChallengeToken: in.ChallengeToken,
+		Code:           in.Code,
+		Fingerprint:    fingerprint,
+		DeviceName:     h.deviceName(ctx),
+		IPAddress:      h.mdProvider.ClientIP(ctx),
+	})
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to login with second factor")
+	}
+
+	// Ответ
+	return &gen.LoginResponse{
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
+		ExpiresIn:    res.ExpiresIn.Unix(),
+	}, nil
+}
+
+func (h *GrpcAuthHandler) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*gen.EnrollTOTPResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.EnrollTOTP(ctx, userID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to enroll totp")
+	}
+
+	// Ответ
+	return &gen.EnrollTOTPResponse{
+		Secret:     res.Secret,
+		OtpauthUri: res.URI,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) VerifyTOTP(ctx context.Context, in *gen.VerifyTOTPRequest) (*gen.VerifyTOTPResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Валидация
+	if in.Code == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "code is empty")
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.VerifyTOTP(ctx, dto.VerifyTOTPReq{
+		UserID: userID,
+		Code:   strings.TrimSpace(in.Code),
+	})
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to verify totp")
+	}
+
+	// Ответ
+	return &gen.VerifyTOTPResponse{
+		RecoveryCodes: res.RecoveryCodes,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) DisableTOTP(ctx context.Context, in *gen.DisableTOTPRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Валидация
+	if in.Code == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "code is empty")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.DisableTOTP(ctx, dto.DisableTOTPReq{
+		UserID:    userID,
+		Code:      in.Code,
+		IPAddress: h.mdProvider.ClientIP(ctx),
+	}); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to disable totp")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+// userID Возвращает пользователя, которого положил в контекст authz.AuthUnaryInterceptor
+func (h *GrpcAuthHandler) userID(ctx context.Context) (uuid.UUID, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return uuid.Nil, errs.ConvertToGrpcError(ctx, h.log, err, "unauthenticated request")
+	}
+
+	return userID, nil
+}
+
+// deviceName Название устройства из метаданных. Необязательно
+func (h *GrpcAuthHandler) deviceName(ctx context.Context) string {
+	deviceName, _ := h.mdProvider.GetValue(ctx, domains.KeyDeviceName)
+	return deviceName
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	gen "2025_CakeLand_API/internal/pkg/auth/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+// MethodPolicies Вход (через провайдеров и по телефону), работа с refresh токеном, коды из писем и SMS, JWKS не требуют access токена.
+// Сессии, смена пароля, привязка провайдеров и настройка 2FA требуют авторизации
+var MethodPolicies = authz.MethodPolicies{
+	gen.Auth_Register_FullMethodName:             authz.PolicyPublic,
+	gen.Auth_Login_FullMethodName:                authz.PolicyPublic,
+	gen.Auth_UpdateAccessToken_FullMethodName:    authz.PolicyPublic,
+	gen.Auth_Logout_FullMethodName:               authz.PolicyPublic,
+	gen.Auth_SendVerificationCode_FullMethodName: authz.PolicyPublic,
+	gen.Auth_VerifyEmail_FullMethodName:          authz.PolicyPublic,
+	gen.Auth_RequestPasswordReset_FullMethodName: authz.PolicyPublic,
+	gen.Auth_ResetPassword_FullMethodName:        authz.PolicyPublic,
+	gen.Auth_GetJWKS_FullMethodName:              authz.PolicyPublic,
+	gen.Auth_OAuthLogin_FullMethodName:           authz.PolicyPublic,
+	gen.Auth_RequestPhoneCode_FullMethodName:     authz.PolicyPublic,
+	gen.Auth_VerifyPhoneCode_FullMethodName:      authz.PolicyPublic,
+	gen.Auth_LoginTwoFactor_FullMethodName:       authz.PolicyPublic,
+}
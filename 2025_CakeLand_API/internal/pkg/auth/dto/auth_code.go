@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateAuthCodeReq struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	Purpose        models.CodePurpose
+	CodeHash       string
+	ExpiresAt      time.Time
+	ResendCooldown time.Duration
+	MaxPerDay      int
+}
+
+type SpendAuthCodeAttemptReq struct {
+	UserID      uuid.UUID
+	Purpose     models.CodePurpose
+	MaxAttempts int
+}
+
+// AuthCodeDB Действующий (не истёкший) код
+type AuthCodeDB struct {
+	ID       uuid.UUID
+	CodeHash string
+}
@@ -6,9 +6,10 @@ import (
 )
 
 type CreateUserReq struct {
-	UUID             uuid.UUID
-	Email            string
-	Nickname         string
-	PasswordHash     []byte
-	RefreshTokensMap models.RefreshTokenMap
+	UUID         uuid.UUID
+	Email        string
+	Nickname     string
+	PasswordHash []byte
+	Roles        models.Roles
+	Session      CreateSessionReq // Сессия устройства, с которого зарегистрировались
 }
@@ -13,5 +13,7 @@ type GetUserByEmailRes struct {
 	ID               uuid.UUID
 	Email            string
 	PasswordHash     []byte
-	RefreshTokensMap models.RefreshTokenMap // key: fingerprint, value: refreshToken
+	Roles            models.Roles
+	EmailVerified    bool
+	TwoFactorEnabled bool
 }
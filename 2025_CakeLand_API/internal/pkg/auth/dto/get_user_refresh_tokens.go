@@ -1,9 +0,0 @@
-package dto
-
-type GetUserRefreshTokensReq struct {
-	UserID string
-}
-
-type GetUserRefreshTokensRes struct {
-	RefreshTokensMap map[string]string
-}
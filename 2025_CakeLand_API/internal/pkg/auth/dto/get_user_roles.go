@@ -0,0 +1,12 @@
+package dto
+
+import "2025_CakeLand_API/internal/models"
+
+type GetUserRolesReq struct {
+	UserID string
+}
+
+type GetUserRolesRes struct {
+	Roles            models.Roles
+	TwoFactorEnabled bool
+}
@@ -6,10 +6,15 @@ type LoginReq struct {
 	Email       string
 	Password    string
 	Fingerprint string
+	DeviceName  string
+	IPAddress   string
 }
 
+// LoginRes Пара токенов. Если у пользователя включена 2FA, вместо неё ChallengeToken для LoginTwoFactor,
+// ExpiresIn тогда — срок challenge токена
 type LoginRes struct {
-	AccessToken  string
-	RefreshToken string
-	ExpiresIn    time.Time
+	AccessToken    string
+	RefreshToken   string
+	ChallengeToken string
+	ExpiresIn      time.Time
 }
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"github.com/google/uuid"
+)
+
+// LoginAuditReq Запись журнала входов. FailureReason пуст для удачного входа. При входе по телефону Email пуст
+type LoginAuditReq struct {
+	ID            uuid.UUID
+	UserID        uuid.NullUUID
+	Email         string
+	Phone         string
+	IPAddress     string
+	Fingerprint   string
+	DeviceName    string
+	Success       bool
+	FailureReason models.LoginFailureReason
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"github.com/google/uuid"
+)
+
+type OAuthLoginReq struct {
+	Provider    models.OAuthProvider
+	IDToken     string
+	Nonce       string
+	Nickname    string // Для нового аккаунта. Пусто — берётся из почты
+	Fingerprint string
+	DeviceName  string
+	IPAddress   string
+}
+
+type OAuthLoginRes struct {
+	LoginRes
+	Created bool // Аккаунт создан этим входом
+}
+
+type LinkProviderReq struct {
+	UserID   uuid.UUID
+	Provider models.OAuthProvider
+	IDToken  string
+	Nonce    string
+}
+
+type UnlinkProviderReq struct {
+	UserID   uuid.UUID
+	Provider models.OAuthProvider
+}
+
+// CreateOAuthUserReq Пользователь без пароля, вошедший через провайдера
+type CreateOAuthUserReq struct {
+	UUID          uuid.UUID
+	Email         string
+	EmailVerified bool
+	Nickname      string
+	Roles         models.Roles
+	Identity      LinkOAuthIdentityReq
+}
+
+type LinkOAuthIdentityReq struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Provider models.OAuthProvider
+	Subject  string
+	Email    string
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type SendVerificationCodeReq struct {
+	Email string
+}
+
+type VerifyEmailReq struct {
+	Email string
+	Code  string
+}
+
+type RequestPasswordResetReq struct {
+	Email string
+}
+
+type ResetPasswordReq struct {
+	Email       string
+	Code        string
+	NewPassword string
+}
+
+type ChangePasswordReq struct {
+	UserID          uuid.UUID
+	Fingerprint     string // Сессия текущего устройства остаётся
+	CurrentPassword string
+	NewPassword     string
+}
+
+// UpdatePasswordReq Меняет пароль и завершает сессии пользователя, кроме KeepFingerprint (если задан)
+type UpdatePasswordReq struct {
+	UserID          uuid.UUID
+	PasswordHash    []byte
+	KeepFingerprint null.String
+}
+
+// RehashPasswordReq Хэш заменяется, только если пароль не успели сменить параллельно
+type RehashPasswordReq struct {
+	UserID          uuid.UUID
+	OldPasswordHash []byte
+	NewPasswordHash []byte
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+type RequestPhoneCodeReq struct {
+	Phone     string
+	IPAddress string
+}
+
+type VerifyPhoneCodeReq struct {
+	Phone       string
+	Code        string
+	Nickname    string // Для нового аккаунта. Пусто — генерируется
+	Fingerprint string
+	DeviceName  string
+	IPAddress   string
+}
+
+type VerifyPhoneCodeRes struct {
+	LoginRes
+	Created bool // Аккаунт создан этим входом
+}
+
+type CreatePhoneCodeReq struct {
+	Phone          string
+	CodeHash       string
+	ExpiresAt      time.Time
+	ResendCooldown time.Duration
+	MaxPerDay      int
+	MaxAttempts    int
+}
+
+// CreatePhoneCodeRes Created false, если не прошёл ResendCooldown или исчерпаны суточные лимиты номера.
+// RetryAt — когда можно запросить код снова
+type CreatePhoneCodeRes struct {
+	Created bool
+	RetryAt time.Time
+}
+
+// PhoneCodeDB Действующий (не истёкший) код
+type PhoneCodeDB struct {
+	CodeHash string
+}
+
+type GetUserByPhoneRes struct {
+	ID               uuid.UUID
+	Roles            models.Roles
+	TwoFactorEnabled bool
+}
+
+// CreatePhoneUserReq Пользователь без почты и пароля с подтверждённым номером
+type CreatePhoneUserReq struct {
+	UUID     uuid.UUID
+	Phone    string
+	Nickname string
+	Roles    models.Roles
+}
@@ -7,6 +7,8 @@ type RegisterReq struct {
 	Password    string
 	Nickname    string
 	Fingerprint string
+	DeviceName  string
+	IPAddress   string
 }
 
 type RegisterRes struct {
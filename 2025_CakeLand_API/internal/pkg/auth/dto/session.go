@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SessionDB Сессия в бд. Вместо refresh токена хранится его хэш
+type SessionDB struct {
+	ID               uuid.UUID
+	FamilyID         uuid.NullUUID // Семейство refresh токенов (NULL у сессий, перенесённых из старого формата)
+	RefreshTokenHash string
+}
+
+// CreateSessionReq Новая сессия заменяет прежнюю сессию того же устройства
+type CreateSessionReq struct {
+	ID               uuid.UUID
+	UserID           string
+	Fingerprint      string
+	FamilyID         uuid.UUID
+	RefreshTokenHash string
+	DeviceName       string
+	IPAddress        string
+	ExpiresAt        time.Time
+	AccessTokenID    string // jti access токена, выданного вместе с refresh токеном
+	AccessExpiresAt  time.Time
+}
+
+type GetSessionReq struct {
+	UserID      string
+	Fingerprint string
+}
+
+// RotateSessionReq Токен заменяется, только если в сессии всё ещё лежит OldRefreshTokenHash
+type RotateSessionReq struct {
+	UserID              string
+	Fingerprint         string
+	FamilyID            uuid.UUID
+	OldRefreshTokenHash string
+	NewRefreshTokenHash string
+	IPAddress           string
+	ExpiresAt           time.Time
+	AccessTokenID       string
+	AccessExpiresAt     time.Time
+}
+
+type DeleteSessionReq struct {
+	UserID      string
+	Fingerprint string
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ListSessionsReq struct {
+	UserID uuid.UUID
+}
+
+type RevokeSessionReq struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+}
+
+type RevokeAllOtherSessionsReq struct {
+	UserID      uuid.UUID
+	Fingerprint string // Сессия текущего устройства остаётся
+}
+
+type RevokeAllOtherSessionsRes struct {
+	RevokedCount int
+}
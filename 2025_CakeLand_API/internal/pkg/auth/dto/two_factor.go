@@ -0,0 +1,50 @@
+package dto
+
+import "github.com/google/uuid"
+
+// EnrollTOTPRes Секрет для приложения-аутентификатора: вручную (Secret) или QR кодом (URI)
+type EnrollTOTPRes struct {
+	Secret string
+	URI    string
+}
+
+type VerifyTOTPReq struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+// VerifyTOTPRes Коды восстановления показываются один раз: в бд лежат только хэши
+type VerifyTOTPRes struct {
+	RecoveryCodes []string
+}
+
+// DisableTOTPReq Code — код из приложения или код восстановления
+type DisableTOTPReq struct {
+	UserID    uuid.UUID
+	Code      string
+	IPAddress string
+}
+
+// LoginTwoFactorReq Code — код из приложения или код восстановления
+type LoginTwoFactorReq struct {
+	ChallengeToken string
+	Code           string
+	Fingerprint    string
+	DeviceName     string
+	IPAddress      string
+}
+
+// TOTPDB Секрет зашифрован ключом сервиса. Enabled false — подключение не подтверждено кодом
+type TOTPDB struct {
+	Secret       []byte
+	Enabled      bool
+	LastUsedStep int64
+}
+
+// EnableTOTPReq Secret — подтверждённый кодом секрет: если его успели заменить, 2FA не включается
+type EnableTOTPReq struct {
+	UserID             uuid.UUID
+	Secret             []byte
+	Step               int64
+	RecoveryCodeHashes []string
+}
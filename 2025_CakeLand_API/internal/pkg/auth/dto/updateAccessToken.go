@@ -5,9 +5,11 @@ import "time"
 type UpdateAccessTokenReq struct {
 	RefreshToken string
 	Fingerprint  string
+	IPAddress    string
 }
 
 type UpdateAccessTokenRes struct {
-	AccessToken string
-	ExpiresIn   time.Time
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Time
 }
@@ -1,6 +0,0 @@
-package dto
-
-type UpdateUserRefreshTokensReq struct {
-	UserID           string
-	RefreshTokensMap map[string]string
-}
@@ -1,8 +1,11 @@
 package auth
 
 import (
+	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/pkg/auth/dto"
 	"context"
+	"github.com/google/uuid"
+	"time"
 )
 
 // mockgen -source=internal/pkg/auth/interfaces.go -destination=internal/pkg/auth/mocks/mock_auth.go -package=mocks
@@ -12,11 +15,78 @@ type IAuthUsecase interface {
 	Login(context.Context, dto.LoginReq) (*dto.LoginRes, error)
 	Logout(context.Context, dto.LogoutReq) (*dto.LogoutRes, error)
 	UpdateAccessToken(context.Context, dto.UpdateAccessTokenReq) (*dto.UpdateAccessTokenRes, error)
+	ListSessions(context.Context, dto.ListSessionsReq) ([]models.Session, error)
+	RevokeSession(context.Context, dto.RevokeSessionReq) error
+	RevokeAllOtherSessions(context.Context, dto.RevokeAllOtherSessionsReq) (*dto.RevokeAllOtherSessionsRes, error)
+	SendVerificationCode(context.Context, dto.SendVerificationCodeReq) error
+	VerifyEmail(context.Context, dto.VerifyEmailReq) error
+	RequestPasswordReset(context.Context, dto.RequestPasswordResetReq) error
+	ResetPassword(context.Context, dto.ResetPasswordReq) error
+	ChangePassword(context.Context, dto.ChangePasswordReq) error
+	JWKS(context.Context) []models.JWK
+	OAuthLogin(context.Context, dto.OAuthLoginReq) (*dto.OAuthLoginRes, error)
+	LinkProvider(context.Context, dto.LinkProviderReq) error
+	UnlinkProvider(context.Context, dto.UnlinkProviderReq) error
+	RequestPhoneCode(context.Context, dto.RequestPhoneCodeReq) error
+	VerifyPhoneCode(context.Context, dto.VerifyPhoneCodeReq) (*dto.VerifyPhoneCodeRes, error)
+	LoginTwoFactor(context.Context, dto.LoginTwoFactorReq) (*dto.LoginRes, error)
+	EnrollTOTP(ctx context.Context, userID uuid.UUID) (*dto.EnrollTOTPRes, error)
+	VerifyTOTP(context.Context, dto.VerifyTOTPReq) (*dto.VerifyTOTPRes, error)
+	DisableTOTP(context.Context, dto.DisableTOTPReq) error
 }
 
 type IAuthRepository interface {
 	CreateUser(context.Context, dto.CreateUserReq) error
 	GetUserByEmail(context.Context, dto.GetUserByEmailReq) (*dto.GetUserByEmailRes, error)
-	UpdateUserRefreshTokens(context.Context, dto.UpdateUserRefreshTokensReq) error
-	GetUserRefreshTokens(context.Context, dto.GetUserRefreshTokensReq) (*dto.GetUserRefreshTokensRes, error)
+	GetUserRoles(context.Context, dto.GetUserRolesReq) (*dto.GetUserRolesRes, error)
+	CreateSession(context.Context, dto.CreateSessionReq) error
+	GetSession(context.Context, dto.GetSessionReq) (*dto.SessionDB, error)
+	RotateSession(context.Context, dto.RotateSessionReq) (bool, error)
+	DeleteSession(context.Context, dto.DeleteSessionReq) error
+	Sessions(context.Context, uuid.UUID) ([]models.Session, error)
+	DeleteSessionByID(ctx context.Context, userID, sessionID uuid.UUID) error
+	DeleteOtherSessions(ctx context.Context, userID uuid.UUID, fingerprint string) (int, error)
+	GetPasswordHash(ctx context.Context, userID uuid.UUID) ([]byte, error)
+	UpdatePassword(context.Context, dto.UpdatePasswordReq) error
+	RehashPassword(context.Context, dto.RehashPasswordReq) error
+	MarkEmailVerified(ctx context.Context, userID uuid.UUID) error
+	CreateAuthCode(context.Context, dto.CreateAuthCodeReq) (bool, error)
+	SpendAuthCodeAttempt(context.Context, dto.SpendAuthCodeAttemptReq) (*dto.AuthCodeDB, error)
+	DeleteAuthCode(ctx context.Context, codeID uuid.UUID) (bool, error)
+	SaveLoginAudit(context.Context, dto.LoginAuditReq) error
+	PurgeLoginAudit(ctx context.Context, before time.Time) error
+	GetUserIDByOAuthIdentity(ctx context.Context, provider models.OAuthProvider, subject string) (uuid.UUID, error)
+	CreateOAuthUser(context.Context, dto.CreateOAuthUserReq) error
+	LinkOAuthIdentity(context.Context, dto.LinkOAuthIdentityReq) error
+	UnlinkOAuthIdentity(ctx context.Context, userID uuid.UUID, provider models.OAuthProvider) error
+	CreatePhoneCode(context.Context, dto.CreatePhoneCodeReq) (*dto.CreatePhoneCodeRes, error)
+	SpendPhoneCodeAttempt(ctx context.Context, phone string, maxAttempts int) (*dto.PhoneCodeDB, error)
+	DeletePhoneCode(ctx context.Context, phone, codeHash string) (bool, error)
+	GetUserByPhone(ctx context.Context, phone string) (*dto.GetUserByPhoneRes, error)
+	CreatePhoneUser(context.Context, dto.CreatePhoneUserReq) error
+	SaveTOTPSecret(ctx context.Context, userID uuid.UUID, secret []byte) error
+	GetTOTP(ctx context.Context, userID uuid.UUID) (*dto.TOTPDB, error)
+	EnableTOTP(context.Context, dto.EnableTOTPReq) error
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	DeleteTOTP(ctx context.Context, userID uuid.UUID) error
+	GetTOTPAccountName(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// IOAuthVerifier Проверка ID токенов внешних провайдеров. Реализация: oauth.Verifier
+type IOAuthVerifier interface {
+	Verify(ctx context.Context, provider models.OAuthProvider, idToken, nonce string) (*models.OAuthIdentity, error)
+}
+
+// ILoginAttemptStore Счётчики неудачных входов по ключу (аккаунт или IP).
+// Реализации: repo.LoginAttemptRepository (postgres) и repo.MemoryLoginAttemptStore
+type ILoginAttemptStore interface {
+	// Reserve Атомарно засчитывает попытку как неудачу ещё до проверки и сразу ставит блокировку blockFor(неудач).
+	// Если ключ уже заблокирован, ничего не меняет и возвращает false и действующую блокировку
+	Reserve(ctx context.Context, key string, blockFor func(failures int) time.Duration) (models.LoginAttempts, bool, error)
+	// Refund Возвращает попытку, которая оказалась удачной. Блокировку снимает, только если её поставила эта попытка
+	Refund(ctx context.Context, key string, reserved models.LoginAttempts) error
+	Reset(ctx context.Context, key string) error
+	// Purge Удаляет счётчики, неудачи которых вышли из окна и блокировка истекла
+	Purge(ctx context.Context) error
 }
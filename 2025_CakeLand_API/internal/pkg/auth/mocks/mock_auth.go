@@ -5,11 +5,14 @@
 package mocks
 
 import (
+	models "2025_CakeLand_API/internal/models"
 	entities "2025_CakeLand_API/internal/pkg/auth/dto"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockIAuthUsecase is a mock of IAuthUsecase interface.
@@ -35,6 +38,92 @@ func (m *MockIAuthUsecase) EXPECT() *MockIAuthUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockIAuthUsecase) ChangePassword(arg0 context.Context, arg1 entities.ChangePasswordReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockIAuthUsecaseMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockIAuthUsecase)(nil).ChangePassword), arg0, arg1)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockIAuthUsecase) DisableTOTP(arg0 context.Context, arg1 entities.DisableTOTPReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockIAuthUsecaseMockRecorder) DisableTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockIAuthUsecase)(nil).DisableTOTP), arg0, arg1)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockIAuthUsecase) EnrollTOTP(ctx context.Context, userID uuid.UUID) (*entities.EnrollTOTPRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx, userID)
+	ret0, _ := ret[0].(*entities.EnrollTOTPRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockIAuthUsecaseMockRecorder) EnrollTOTP(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockIAuthUsecase)(nil).EnrollTOTP), ctx, userID)
+}
+
+// JWKS mocks base method.
+func (m *MockIAuthUsecase) JWKS(arg0 context.Context) []models.JWK {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS", arg0)
+	ret0, _ := ret[0].([]models.JWK)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockIAuthUsecaseMockRecorder) JWKS(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockIAuthUsecase)(nil).JWKS), arg0)
+}
+
+// LinkProvider mocks base method.
+func (m *MockIAuthUsecase) LinkProvider(arg0 context.Context, arg1 entities.LinkProviderReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkProvider", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkProvider indicates an expected call of LinkProvider.
+func (mr *MockIAuthUsecaseMockRecorder) LinkProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkProvider", reflect.TypeOf((*MockIAuthUsecase)(nil).LinkProvider), arg0, arg1)
+}
+
+// ListSessions mocks base method.
+func (m *MockIAuthUsecase) ListSessions(arg0 context.Context, arg1 entities.ListSessionsReq) ([]models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].([]models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockIAuthUsecaseMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockIAuthUsecase)(nil).ListSessions), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockIAuthUsecase) Login(arg0 context.Context, arg1 entities.LoginReq) (*entities.LoginRes, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +139,21 @@ func (mr *MockIAuthUsecaseMockRecorder) Login(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthUsecase)(nil).Login), arg0, arg1)
 }
 
+// LoginTwoFactor mocks base method.
+func (m *MockIAuthUsecase) LoginTwoFactor(arg0 context.Context, arg1 entities.LoginTwoFactorReq) (*entities.LoginRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginTwoFactor", arg0, arg1)
+	ret0, _ := ret[0].(*entities.LoginRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginTwoFactor indicates an expected call of LoginTwoFactor.
+func (mr *MockIAuthUsecaseMockRecorder) LoginTwoFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginTwoFactor", reflect.TypeOf((*MockIAuthUsecase)(nil).LoginTwoFactor), arg0, arg1)
+}
+
 // Logout mocks base method.
 func (m *MockIAuthUsecase) Logout(arg0 context.Context, arg1 entities.LogoutReq) (*entities.LogoutRes, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +169,21 @@ func (mr *MockIAuthUsecaseMockRecorder) Logout(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIAuthUsecase)(nil).Logout), arg0, arg1)
 }
 
+// OAuthLogin mocks base method.
+func (m *MockIAuthUsecase) OAuthLogin(arg0 context.Context, arg1 entities.OAuthLoginReq) (*entities.OAuthLoginRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OAuthLogin", arg0, arg1)
+	ret0, _ := ret[0].(*entities.OAuthLoginRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OAuthLogin indicates an expected call of OAuthLogin.
+func (mr *MockIAuthUsecaseMockRecorder) OAuthLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OAuthLogin", reflect.TypeOf((*MockIAuthUsecase)(nil).OAuthLogin), arg0, arg1)
+}
+
 // Register mocks base method.
 func (m *MockIAuthUsecase) Register(arg0 context.Context, arg1 entities.RegisterReq) (*entities.RegisterRes, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +199,105 @@ func (mr *MockIAuthUsecaseMockRecorder) Register(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthUsecase)(nil).Register), arg0, arg1)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockIAuthUsecase) RequestPasswordReset(arg0 context.Context, arg1 entities.RequestPasswordResetReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockIAuthUsecaseMockRecorder) RequestPasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockIAuthUsecase)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// RequestPhoneCode mocks base method.
+func (m *MockIAuthUsecase) RequestPhoneCode(arg0 context.Context, arg1 entities.RequestPhoneCodeReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPhoneCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPhoneCode indicates an expected call of RequestPhoneCode.
+func (mr *MockIAuthUsecaseMockRecorder) RequestPhoneCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPhoneCode", reflect.TypeOf((*MockIAuthUsecase)(nil).RequestPhoneCode), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockIAuthUsecase) ResetPassword(arg0 context.Context, arg1 entities.ResetPasswordReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockIAuthUsecaseMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockIAuthUsecase)(nil).ResetPassword), arg0, arg1)
+}
+
+// RevokeAllOtherSessions mocks base method.
+func (m *MockIAuthUsecase) RevokeAllOtherSessions(arg0 context.Context, arg1 entities.RevokeAllOtherSessionsReq) (*entities.RevokeAllOtherSessionsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(*entities.RevokeAllOtherSessionsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllOtherSessions indicates an expected call of RevokeAllOtherSessions.
+func (mr *MockIAuthUsecaseMockRecorder) RevokeAllOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllOtherSessions", reflect.TypeOf((*MockIAuthUsecase)(nil).RevokeAllOtherSessions), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockIAuthUsecase) RevokeSession(arg0 context.Context, arg1 entities.RevokeSessionReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockIAuthUsecaseMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockIAuthUsecase)(nil).RevokeSession), arg0, arg1)
+}
+
+// SendVerificationCode mocks base method.
+func (m *MockIAuthUsecase) SendVerificationCode(arg0 context.Context, arg1 entities.SendVerificationCodeReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerificationCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerificationCode indicates an expected call of SendVerificationCode.
+func (mr *MockIAuthUsecaseMockRecorder) SendVerificationCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationCode", reflect.TypeOf((*MockIAuthUsecase)(nil).SendVerificationCode), arg0, arg1)
+}
+
+// UnlinkProvider mocks base method.
+func (m *MockIAuthUsecase) UnlinkProvider(arg0 context.Context, arg1 entities.UnlinkProviderReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkProvider", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkProvider indicates an expected call of UnlinkProvider.
+func (mr *MockIAuthUsecaseMockRecorder) UnlinkProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkProvider", reflect.TypeOf((*MockIAuthUsecase)(nil).UnlinkProvider), arg0, arg1)
+}
+
 // UpdateAccessToken mocks base method.
 func (m *MockIAuthUsecase) UpdateAccessToken(arg0 context.Context, arg1 entities.UpdateAccessTokenReq) (*entities.UpdateAccessTokenRes, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +313,50 @@ func (mr *MockIAuthUsecaseMockRecorder) UpdateAccessToken(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessToken", reflect.TypeOf((*MockIAuthUsecase)(nil).UpdateAccessToken), arg0, arg1)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockIAuthUsecase) VerifyEmail(arg0 context.Context, arg1 entities.VerifyEmailReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockIAuthUsecaseMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockIAuthUsecase)(nil).VerifyEmail), arg0, arg1)
+}
+
+// VerifyPhoneCode mocks base method.
+func (m *MockIAuthUsecase) VerifyPhoneCode(arg0 context.Context, arg1 entities.VerifyPhoneCodeReq) (*entities.VerifyPhoneCodeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPhoneCode", arg0, arg1)
+	ret0, _ := ret[0].(*entities.VerifyPhoneCodeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPhoneCode indicates an expected call of VerifyPhoneCode.
+func (mr *MockIAuthUsecaseMockRecorder) VerifyPhoneCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPhoneCode", reflect.TypeOf((*MockIAuthUsecase)(nil).VerifyPhoneCode), arg0, arg1)
+}
+
+// VerifyTOTP mocks base method.
+func (m *MockIAuthUsecase) VerifyTOTP(arg0 context.Context, arg1 entities.VerifyTOTPReq) (*entities.VerifyTOTPRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTOTP", arg0, arg1)
+	ret0, _ := ret[0].(*entities.VerifyTOTPRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTOTP indicates an expected call of VerifyTOTP.
+func (mr *MockIAuthUsecaseMockRecorder) VerifyTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTOTP", reflect.TypeOf((*MockIAuthUsecase)(nil).VerifyTOTP), arg0, arg1)
+}
+
 // MockIAuthRepository is a mock of IAuthRepository interface.
 type MockIAuthRepository struct {
 	ctrl     *gomock.Controller
@@ -118,6 +380,78 @@ func (m *MockIAuthRepository) EXPECT() *MockIAuthRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateAuthCode mocks base method.
+func (m *MockIAuthRepository) CreateAuthCode(arg0 context.Context, arg1 entities.CreateAuthCodeReq) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthCode", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuthCode indicates an expected call of CreateAuthCode.
+func (mr *MockIAuthRepositoryMockRecorder) CreateAuthCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthCode", reflect.TypeOf((*MockIAuthRepository)(nil).CreateAuthCode), arg0, arg1)
+}
+
+// CreateOAuthUser mocks base method.
+func (m *MockIAuthRepository) CreateOAuthUser(arg0 context.Context, arg1 entities.CreateOAuthUserReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOAuthUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOAuthUser indicates an expected call of CreateOAuthUser.
+func (mr *MockIAuthRepositoryMockRecorder) CreateOAuthUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOAuthUser", reflect.TypeOf((*MockIAuthRepository)(nil).CreateOAuthUser), arg0, arg1)
+}
+
+// CreatePhoneCode mocks base method.
+func (m *MockIAuthRepository) CreatePhoneCode(arg0 context.Context, arg1 entities.CreatePhoneCodeReq) (*entities.CreatePhoneCodeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePhoneCode", arg0, arg1)
+	ret0, _ := ret[0].(*entities.CreatePhoneCodeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePhoneCode indicates an expected call of CreatePhoneCode.
+func (mr *MockIAuthRepositoryMockRecorder) CreatePhoneCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhoneCode", reflect.TypeOf((*MockIAuthRepository)(nil).CreatePhoneCode), arg0, arg1)
+}
+
+// CreatePhoneUser mocks base method.
+func (m *MockIAuthRepository) CreatePhoneUser(arg0 context.Context, arg1 entities.CreatePhoneUserReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePhoneUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePhoneUser indicates an expected call of CreatePhoneUser.
+func (mr *MockIAuthRepositoryMockRecorder) CreatePhoneUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhoneUser", reflect.TypeOf((*MockIAuthRepository)(nil).CreatePhoneUser), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockIAuthRepository) CreateSession(arg0 context.Context, arg1 entities.CreateSessionReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockIAuthRepositoryMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIAuthRepository)(nil).CreateSession), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockIAuthRepository) CreateUser(arg0 context.Context, arg1 entities.CreateUserReq) error {
 	m.ctrl.T.Helper()
@@ -132,6 +466,167 @@ func (mr *MockIAuthRepositoryMockRecorder) CreateUser(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIAuthRepository)(nil).CreateUser), arg0, arg1)
 }
 
+// DeleteAuthCode mocks base method.
+func (m *MockIAuthRepository) DeleteAuthCode(ctx context.Context, codeID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthCode", ctx, codeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAuthCode indicates an expected call of DeleteAuthCode.
+func (mr *MockIAuthRepositoryMockRecorder) DeleteAuthCode(ctx, codeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthCode", reflect.TypeOf((*MockIAuthRepository)(nil).DeleteAuthCode), ctx, codeID)
+}
+
+// DeleteOtherSessions mocks base method.
+func (m *MockIAuthRepository) DeleteOtherSessions(ctx context.Context, userID uuid.UUID, fingerprint string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOtherSessions", ctx, userID, fingerprint)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOtherSessions indicates an expected call of DeleteOtherSessions.
+func (mr *MockIAuthRepositoryMockRecorder) DeleteOtherSessions(ctx, userID, fingerprint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessions", reflect.TypeOf((*MockIAuthRepository)(nil).DeleteOtherSessions), ctx, userID, fingerprint)
+}
+
+// DeletePhoneCode mocks base method.
+func (m *MockIAuthRepository) DeletePhoneCode(ctx context.Context, phone, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhoneCode", ctx, phone, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePhoneCode indicates an expected call of DeletePhoneCode.
+func (mr *MockIAuthRepositoryMockRecorder) DeletePhoneCode(ctx, phone, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhoneCode", reflect.TypeOf((*MockIAuthRepository)(nil).DeletePhoneCode), ctx, phone, codeHash)
+}
+
+// DeleteSession mocks base method.
+func (m *MockIAuthRepository) DeleteSession(arg0 context.Context, arg1 entities.DeleteSessionReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockIAuthRepositoryMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockIAuthRepository)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteSessionByID mocks base method.
+func (m *MockIAuthRepository) DeleteSessionByID(ctx context.Context, userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionByID", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionByID indicates an expected call of DeleteSessionByID.
+func (mr *MockIAuthRepositoryMockRecorder) DeleteSessionByID(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionByID", reflect.TypeOf((*MockIAuthRepository)(nil).DeleteSessionByID), ctx, userID, sessionID)
+}
+
+// DeleteTOTP mocks base method.
+func (m *MockIAuthRepository) DeleteTOTP(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTOTP", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTOTP indicates an expected call of DeleteTOTP.
+func (mr *MockIAuthRepositoryMockRecorder) DeleteTOTP(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTOTP", reflect.TypeOf((*MockIAuthRepository)(nil).DeleteTOTP), ctx, userID)
+}
+
+// EnableTOTP mocks base method.
+func (m *MockIAuthRepository) EnableTOTP(arg0 context.Context, arg1 entities.EnableTOTPReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTOTP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTOTP indicates an expected call of EnableTOTP.
+func (mr *MockIAuthRepositoryMockRecorder) EnableTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTOTP", reflect.TypeOf((*MockIAuthRepository)(nil).EnableTOTP), arg0, arg1)
+}
+
+// GetPasswordHash mocks base method.
+func (m *MockIAuthRepository) GetPasswordHash(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordHash", ctx, userID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordHash indicates an expected call of GetPasswordHash.
+func (mr *MockIAuthRepositoryMockRecorder) GetPasswordHash(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordHash", reflect.TypeOf((*MockIAuthRepository)(nil).GetPasswordHash), ctx, userID)
+}
+
+// GetSession mocks base method.
+func (m *MockIAuthRepository) GetSession(arg0 context.Context, arg1 entities.GetSessionReq) (*entities.SessionDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(*entities.SessionDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockIAuthRepositoryMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockIAuthRepository)(nil).GetSession), arg0, arg1)
+}
+
+// GetTOTP mocks base method.
+func (m *MockIAuthRepository) GetTOTP(ctx context.Context, userID uuid.UUID) (*entities.TOTPDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTOTP", ctx, userID)
+	ret0, _ := ret[0].(*entities.TOTPDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTOTP indicates an expected call of GetTOTP.
+func (mr *MockIAuthRepositoryMockRecorder) GetTOTP(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTOTP", reflect.TypeOf((*MockIAuthRepository)(nil).GetTOTP), ctx, userID)
+}
+
+// GetTOTPAccountName mocks base method.
+func (m *MockIAuthRepository) GetTOTPAccountName(ctx context.Context, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTOTPAccountName", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTOTPAccountName indicates an expected call of GetTOTPAccountName.
+func (mr *MockIAuthRepositoryMockRecorder) GetTOTPAccountName(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTOTPAccountName", reflect.TypeOf((*MockIAuthRepository)(nil).GetTOTPAccountName), ctx, userID)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockIAuthRepository) GetUserByEmail(arg0 context.Context, arg1 entities.GetUserByEmailReq) (*entities.GetUserByEmailRes, error) {
 	m.ctrl.T.Helper()
@@ -147,31 +642,368 @@ func (mr *MockIAuthRepositoryMockRecorder) GetUserByEmail(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockIAuthRepository)(nil).GetUserByEmail), arg0, arg1)
 }
 
-// GetUserRefreshTokens mocks base method.
-func (m *MockIAuthRepository) GetUserRefreshTokens(arg0 context.Context, arg1 entities.GetUserRefreshTokensReq) (*entities.GetUserRefreshTokensRes, error) {
+// GetUserByPhone mocks base method.
+func (m *MockIAuthRepository) GetUserByPhone(ctx context.Context, phone string) (*entities.GetUserByPhoneRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhone", ctx, phone)
+	ret0, _ := ret[0].(*entities.GetUserByPhoneRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhone indicates an expected call of GetUserByPhone.
+func (mr *MockIAuthRepositoryMockRecorder) GetUserByPhone(ctx, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhone", reflect.TypeOf((*MockIAuthRepository)(nil).GetUserByPhone), ctx, phone)
+}
+
+// GetUserIDByOAuthIdentity mocks base method.
+func (m *MockIAuthRepository) GetUserIDByOAuthIdentity(ctx context.Context, provider models.OAuthProvider, subject string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByOAuthIdentity", ctx, provider, subject)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByOAuthIdentity indicates an expected call of GetUserIDByOAuthIdentity.
+func (mr *MockIAuthRepositoryMockRecorder) GetUserIDByOAuthIdentity(ctx, provider, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByOAuthIdentity", reflect.TypeOf((*MockIAuthRepository)(nil).GetUserIDByOAuthIdentity), ctx, provider, subject)
+}
+
+// GetUserRoles mocks base method.
+func (m *MockIAuthRepository) GetUserRoles(arg0 context.Context, arg1 entities.GetUserRolesReq) (*entities.GetUserRolesRes, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserRefreshTokens", arg0, arg1)
-	ret0, _ := ret[0].(*entities.GetUserRefreshTokensRes)
+	ret := m.ctrl.Call(m, "GetUserRoles", arg0, arg1)
+	ret0, _ := ret[0].(*entities.GetUserRolesRes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserRefreshTokens indicates an expected call of GetUserRefreshTokens.
-func (mr *MockIAuthRepositoryMockRecorder) GetUserRefreshTokens(arg0, arg1 interface{}) *gomock.Call {
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockIAuthRepositoryMockRecorder) GetUserRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockIAuthRepository)(nil).GetUserRoles), arg0, arg1)
+}
+
+// LinkOAuthIdentity mocks base method.
+func (m *MockIAuthRepository) LinkOAuthIdentity(arg0 context.Context, arg1 entities.LinkOAuthIdentityReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkOAuthIdentity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkOAuthIdentity indicates an expected call of LinkOAuthIdentity.
+func (mr *MockIAuthRepositoryMockRecorder) LinkOAuthIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkOAuthIdentity", reflect.TypeOf((*MockIAuthRepository)(nil).LinkOAuthIdentity), arg0, arg1)
+}
+
+// MarkEmailVerified mocks base method.
+func (m *MockIAuthRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockIAuthRepositoryMockRecorder) MarkEmailVerified(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockIAuthRepository)(nil).MarkEmailVerified), ctx, userID)
+}
+
+// PurgeLoginAudit mocks base method.
+func (m *MockIAuthRepository) PurgeLoginAudit(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeLoginAudit", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeLoginAudit indicates an expected call of PurgeLoginAudit.
+func (mr *MockIAuthRepositoryMockRecorder) PurgeLoginAudit(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeLoginAudit", reflect.TypeOf((*MockIAuthRepository)(nil).PurgeLoginAudit), ctx, before)
+}
+
+// RehashPassword mocks base method.
+func (m *MockIAuthRepository) RehashPassword(arg0 context.Context, arg1 entities.RehashPasswordReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehashPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RehashPassword indicates an expected call of RehashPassword.
+func (mr *MockIAuthRepositoryMockRecorder) RehashPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehashPassword", reflect.TypeOf((*MockIAuthRepository)(nil).RehashPassword), arg0, arg1)
+}
+
+// RotateSession mocks base method.
+func (m *MockIAuthRepository) RotateSession(arg0 context.Context, arg1 entities.RotateSessionReq) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockIAuthRepositoryMockRecorder) RotateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockIAuthRepository)(nil).RotateSession), arg0, arg1)
+}
+
+// SaveLoginAudit mocks base method.
+func (m *MockIAuthRepository) SaveLoginAudit(arg0 context.Context, arg1 entities.LoginAuditReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLoginAudit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLoginAudit indicates an expected call of SaveLoginAudit.
+func (mr *MockIAuthRepositoryMockRecorder) SaveLoginAudit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLoginAudit", reflect.TypeOf((*MockIAuthRepository)(nil).SaveLoginAudit), arg0, arg1)
+}
+
+// SaveTOTPSecret mocks base method.
+func (m *MockIAuthRepository) SaveTOTPSecret(ctx context.Context, userID uuid.UUID, secret []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTOTPSecret", ctx, userID, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTOTPSecret indicates an expected call of SaveTOTPSecret.
+func (mr *MockIAuthRepositoryMockRecorder) SaveTOTPSecret(ctx, userID, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTOTPSecret", reflect.TypeOf((*MockIAuthRepository)(nil).SaveTOTPSecret), ctx, userID, secret)
+}
+
+// Sessions mocks base method.
+func (m *MockIAuthRepository) Sessions(arg0 context.Context, arg1 uuid.UUID) ([]models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sessions", arg0, arg1)
+	ret0, _ := ret[0].([]models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sessions indicates an expected call of Sessions.
+func (mr *MockIAuthRepositoryMockRecorder) Sessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sessions", reflect.TypeOf((*MockIAuthRepository)(nil).Sessions), arg0, arg1)
+}
+
+// SpendAuthCodeAttempt mocks base method.
+func (m *MockIAuthRepository) SpendAuthCodeAttempt(arg0 context.Context, arg1 entities.SpendAuthCodeAttemptReq) (*entities.AuthCodeDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendAuthCodeAttempt", arg0, arg1)
+	ret0, _ := ret[0].(*entities.AuthCodeDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendAuthCodeAttempt indicates an expected call of SpendAuthCodeAttempt.
+func (mr *MockIAuthRepositoryMockRecorder) SpendAuthCodeAttempt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendAuthCodeAttempt", reflect.TypeOf((*MockIAuthRepository)(nil).SpendAuthCodeAttempt), arg0, arg1)
+}
+
+// SpendPhoneCodeAttempt mocks base method.
+func (m *MockIAuthRepository) SpendPhoneCodeAttempt(ctx context.Context, phone string, maxAttempts int) (*entities.PhoneCodeDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendPhoneCodeAttempt", ctx, phone, maxAttempts)
+	ret0, _ := ret[0].(*entities.PhoneCodeDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendPhoneCodeAttempt indicates an expected call of SpendPhoneCodeAttempt.
+func (mr *MockIAuthRepositoryMockRecorder) SpendPhoneCodeAttempt(ctx, phone, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendPhoneCodeAttempt", reflect.TypeOf((*MockIAuthRepository)(nil).SpendPhoneCodeAttempt), ctx, phone, maxAttempts)
+}
+
+// UnlinkOAuthIdentity mocks base method.
+func (m *MockIAuthRepository) UnlinkOAuthIdentity(ctx context.Context, userID uuid.UUID, provider models.OAuthProvider) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkOAuthIdentity", ctx, userID, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkOAuthIdentity indicates an expected call of UnlinkOAuthIdentity.
+func (mr *MockIAuthRepositoryMockRecorder) UnlinkOAuthIdentity(ctx, userID, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkOAuthIdentity", reflect.TypeOf((*MockIAuthRepository)(nil).UnlinkOAuthIdentity), ctx, userID, provider)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockIAuthRepository) UpdatePassword(arg0 context.Context, arg1 entities.UpdatePasswordReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockIAuthRepositoryMockRecorder) UpdatePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockIAuthRepository)(nil).UpdatePassword), arg0, arg1)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockIAuthRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockIAuthRepositoryMockRecorder) UseRecoveryCode(ctx, userID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockIAuthRepository)(nil).UseRecoveryCode), ctx, userID, codeHash)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockIAuthRepository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", ctx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockIAuthRepositoryMockRecorder) UseTOTPStep(ctx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockIAuthRepository)(nil).UseTOTPStep), ctx, userID, step)
+}
+
+// MockIOAuthVerifier is a mock of IOAuthVerifier interface.
+type MockIOAuthVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOAuthVerifierMockRecorder
+}
+
+// MockIOAuthVerifierMockRecorder is the mock recorder for MockIOAuthVerifier.
+type MockIOAuthVerifierMockRecorder struct {
+	mock *MockIOAuthVerifier
+}
+
+// NewMockIOAuthVerifier creates a new mock instance.
+func NewMockIOAuthVerifier(ctrl *gomock.Controller) *MockIOAuthVerifier {
+	mock := &MockIOAuthVerifier{ctrl: ctrl}
+	mock.recorder = &MockIOAuthVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOAuthVerifier) EXPECT() *MockIOAuthVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockIOAuthVerifier) Verify(ctx context.Context, provider models.OAuthProvider, idToken, nonce string) (*models.OAuthIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, provider, idToken, nonce)
+	ret0, _ := ret[0].(*models.OAuthIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockIOAuthVerifierMockRecorder) Verify(ctx, provider, idToken, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockIOAuthVerifier)(nil).Verify), ctx, provider, idToken, nonce)
+}
+
+// MockILoginAttemptStore is a mock of ILoginAttemptStore interface.
+type MockILoginAttemptStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockILoginAttemptStoreMockRecorder
+}
+
+// MockILoginAttemptStoreMockRecorder is the mock recorder for MockILoginAttemptStore.
+type MockILoginAttemptStoreMockRecorder struct {
+	mock *MockILoginAttemptStore
+}
+
+// NewMockILoginAttemptStore creates a new mock instance.
+func NewMockILoginAttemptStore(ctrl *gomock.Controller) *MockILoginAttemptStore {
+	mock := &MockILoginAttemptStore{ctrl: ctrl}
+	mock.recorder = &MockILoginAttemptStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILoginAttemptStore) EXPECT() *MockILoginAttemptStoreMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockILoginAttemptStore) Purge(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockILoginAttemptStoreMockRecorder) Purge(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockILoginAttemptStore)(nil).Purge), ctx)
+}
+
+// Refund mocks base method.
+func (m *MockILoginAttemptStore) Refund(ctx context.Context, key string, reserved models.LoginAttempts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, key, reserved)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockILoginAttemptStoreMockRecorder) Refund(ctx, key, reserved interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockILoginAttemptStore)(nil).Refund), ctx, key, reserved)
+}
+
+// Reserve mocks base method.
+func (m *MockILoginAttemptStore) Reserve(ctx context.Context, key string, blockFor func(int) time.Duration) (models.LoginAttempts, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, key, blockFor)
+	ret0, _ := ret[0].(models.LoginAttempts)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockILoginAttemptStoreMockRecorder) Reserve(ctx, key, blockFor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRefreshTokens", reflect.TypeOf((*MockIAuthRepository)(nil).GetUserRefreshTokens), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockILoginAttemptStore)(nil).Reserve), ctx, key, blockFor)
 }
 
-// UpdateUserRefreshTokens mocks base method.
-func (m *MockIAuthRepository) UpdateUserRefreshTokens(arg0 context.Context, arg1 entities.UpdateUserRefreshTokensReq) error {
+// Reset mocks base method.
+func (m *MockILoginAttemptStore) Reset(ctx context.Context, key string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserRefreshTokens", arg0, arg1)
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserRefreshTokens indicates an expected call of UpdateUserRefreshTokens.
-func (mr *MockIAuthRepositoryMockRecorder) UpdateUserRefreshTokens(arg0, arg1 interface{}) *gomock.Call {
+// Reset indicates an expected call of Reset.
+func (mr *MockILoginAttemptStoreMockRecorder) Reset(ctx, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRefreshTokens", reflect.TypeOf((*MockIAuthRepository)(nil).UpdateUserRefreshTokens), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockILoginAttemptStore)(nil).Reset), ctx, key)
 }
@@ -0,0 +1,159 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth"
+	"2025_CakeLand_API/internal/pkg/config"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const (
+	// Строка счётчика создаётся заранее и сразу блокируется: очистка не удалит её до конца транзакции
+	createLoginAttemptCommand = `INSERT INTO login_attempt (key) VALUES ($1) ON CONFLICT (key) DO UPDATE SET key = excluded.key`
+	// Неудачи старше окна не считаются, а блокировка действует до своего срока
+	lockLoginAttemptCommand = `
+		SELECT CASE WHEN last_failure_at > now() - make_interval(secs => $2) THEN failures ELSE 0 END,
+			   blocked_until,
+			   now()
+		FROM login_attempt
+		WHERE key = $1
+		FOR UPDATE
+	`
+	reserveLoginAttemptCommand = `UPDATE login_attempt SET failures = $2, last_failure_at = now(), blocked_until = $3 WHERE key = $1`
+	// Блокировку снимаем, только если её не продлила другая попытка
+	refundLoginAttemptCommand = `
+		UPDATE login_attempt
+		SET failures      = GREATEST(failures - 1, 0),
+			blocked_until = CASE WHEN blocked_until = $2 THEN NULL ELSE blocked_until END
+		WHERE key = $1
+	`
+	resetLoginAttemptCommand = `DELETE FROM login_attempt WHERE key = $1`
+	// Счётчик больше не нужен, когда его неудачи вышли из окна, а блокировка истекла
+	purgeLoginAttemptsCommand = `
+		DELETE FROM login_attempt
+		WHERE last_failure_at <= now() - make_interval(secs => $1)
+		  AND (blocked_until IS NULL OR blocked_until <= now())
+	`
+)
+
+const (
+	LoginAttemptStoragePostgres = "postgres"
+	LoginAttemptStorageMemory   = "memory"
+)
+
+// NewLoginAttemptStore Хранилище счётчиков неудачных входов, выбранное в конфиге
+func NewLoginAttemptStore(db *sql.DB, conf *config.LoginLimitsConfig) (auth.ILoginAttemptStore, error) {
+	switch conf.Storage {
+	case LoginAttemptStoragePostgres:
+		return NewLoginAttemptRepository(db, conf.Window), nil
+	case LoginAttemptStorageMemory:
+		return NewMemoryLoginAttemptStore(conf.Window), nil
+	}
+
+	return nil, fmt.Errorf("неизвестное хранилище счётчиков входа: %q", conf.Storage)
+}
+
+// LoginAttemptRepository Счётчики неудачных входов в postgres: общие для всех экземпляров сервиса
+type LoginAttemptRepository struct {
+	db     *sql.DB
+	window time.Duration
+}
+
+func NewLoginAttemptRepository(db *sql.DB, window time.Duration) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		db:     db,
+		window: window,
+	}
+}
+
+// Reserve Строка ключа блокируется до конца транзакции: параллельные попытки получают разные номера
+// и видят блокировку, поставленную предыдущей
+func (r *LoginAttemptRepository) Reserve(
+	ctx context.Context,
+	key string,
+	blockFor func(failures int) time.Duration,
+) (models.LoginAttempts, bool, error) {
+	const methodName = "[LoginAttemptRepository.Reserve]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.LoginAttempts{}, false, errs.WrapDBError(methodName, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, createLoginAttemptCommand, key); err != nil {
+		_ = tx.Rollback()
+		return models.LoginAttempts{}, false, errs.WrapDBError(methodName, err)
+	}
+
+	var (
+		attempts     models.LoginAttempts
+		blockedUntil sql.NullTime
+		now          time.Time
+	)
+	if err = tx.QueryRowContext(ctx, lockLoginAttemptCommand, key, r.window.Seconds()).Scan(
+		&attempts.Failures,
+		&blockedUntil,
+		&now,
+	); err != nil {
+		_ = tx.Rollback()
+		return models.LoginAttempts{}, false, errs.WrapDBError(methodName, err)
+	}
+	if blockedUntil.Valid && blockedUntil.Time.After(now) {
+		_ = tx.Rollback()
+		attempts.BlockedUntil = blockedUntil.Time
+		return attempts, false, nil
+	}
+
+	attempts.Failures++
+	blockedUntil = sql.NullTime{}
+	if delay := blockFor(attempts.Failures); delay > 0 {
+		// Точность postgres — микросекунды: Refund сравнивает срок с сохранённым
+		attempts.BlockedUntil = now.Add(delay).Truncate(time.Microsecond)
+		blockedUntil = sql.NullTime{Time: attempts.BlockedUntil, Valid: true}
+	}
+	if _, err = tx.ExecContext(ctx, reserveLoginAttemptCommand, key, attempts.Failures, blockedUntil); err != nil {
+		_ = tx.Rollback()
+		return models.LoginAttempts{}, false, errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.LoginAttempts{}, false, errs.WrapDBError(methodName, err)
+	}
+
+	return attempts, true, nil
+}
+
+func (r *LoginAttemptRepository) Refund(ctx context.Context, key string, reserved models.LoginAttempts) error {
+	const methodName = "[LoginAttemptRepository.Refund]"
+
+	blockedUntil := sql.NullTime{Time: reserved.BlockedUntil, Valid: !reserved.BlockedUntil.IsZero()}
+	if _, err := r.db.ExecContext(ctx, refundLoginAttemptCommand, key, blockedUntil); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	const methodName = "[LoginAttemptRepository.Reset]"
+
+	if _, err := r.db.ExecContext(ctx, resetLoginAttemptCommand, key); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) Purge(ctx context.Context) error {
+	const methodName = "[LoginAttemptRepository.Purge]"
+
+	if _, err := r.db.ExecContext(ctx, purgeLoginAttemptsCommand, r.window.Seconds()); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
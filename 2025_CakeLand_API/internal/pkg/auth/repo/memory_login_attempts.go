@@ -0,0 +1,99 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryLoginAttempt struct {
+	failures      int
+	lastFailureAt time.Time
+	blockedUntil  time.Time
+}
+
+// MemoryLoginAttemptStore Счётчики неудачных входов в памяти процесса: для локального запуска и одного экземпляра.
+// Сбрасываются при перезапуске
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	window   time.Duration
+	attempts map[string]*memoryLoginAttempt
+}
+
+func NewMemoryLoginAttemptStore(window time.Duration) *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		window:   window,
+		attempts: make(map[string]*memoryLoginAttempt),
+	}
+}
+
+func (s *MemoryLoginAttemptStore) Reserve(_ context.Context, key string, blockFor func(failures int) time.Duration) (models.LoginAttempts, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	attempt, ok := s.attempts[key]
+	if !ok {
+		attempt = &memoryLoginAttempt{}
+		s.attempts[key] = attempt
+	}
+	if attempt.blockedUntil.After(now) {
+		return models.LoginAttempts{Failures: attempt.failures, BlockedUntil: attempt.blockedUntil}, false, nil
+	}
+
+	if now.Sub(attempt.lastFailureAt) >= s.window {
+		attempt.failures = 0
+	}
+	attempt.failures++
+	attempt.lastFailureAt = now
+	attempt.blockedUntil = time.Time{}
+	if delay := blockFor(attempt.failures); delay > 0 {
+		attempt.blockedUntil = now.Add(delay)
+	}
+
+	return models.LoginAttempts{Failures: attempt.failures, BlockedUntil: attempt.blockedUntil}, true, nil
+}
+
+func (s *MemoryLoginAttemptStore) Refund(_ context.Context, key string, reserved models.LoginAttempts) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempt, ok := s.attempts[key]
+	if !ok {
+		return nil
+	}
+	if attempt.failures > 0 {
+		attempt.failures--
+	}
+	if !reserved.BlockedUntil.IsZero() && attempt.blockedUntil.Equal(reserved.BlockedUntil) {
+		attempt.blockedUntil = time.Time{}
+	}
+	if attempt.failures == 0 && attempt.blockedUntil.IsZero() {
+		delete(s.attempts, key)
+	}
+
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) Purge(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, attempt := range s.attempts {
+		if now.Sub(attempt.lastFailureAt) >= s.window && !attempt.blockedUntil.After(now) {
+			delete(s.attempts, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	getUserIDByOAuthIdentityCommand = `SELECT user_id FROM user_oauth_identity WHERE provider = $1 AND subject = $2`
+	createOAuthUserCommand          = `
+		INSERT INTO "user" (id, nickname, mail, password_hash, roles, email_verified)
+		VALUES ($1, $2, $3, NULL, $4::user_role[], $5)
+	`
+	linkOAuthIdentityCommand = `
+		INSERT INTO user_oauth_identity (id, user_id, provider, subject, email)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	// Строка пользователя блокируется до конца транзакции: параллельные отвязки разных провайдеров
+	// выполняются по очереди, и каждая видит, что удалила предыдущая
+	lockOAuthUserCommand = `SELECT id FROM "user" WHERE id = $1 FOR UPDATE`
+	// Провайдер отвязывается, только если остаётся другой способ входа: пароль, подтверждённый телефон или ещё один провайдер
+	unlinkOAuthIdentityCommand = `
+		DELETE FROM user_oauth_identity
+		WHERE user_id = $1 AND provider = $2
+		  AND (EXISTS (SELECT 1 FROM "user" WHERE id = $1 AND (password_hash IS NOT NULL OR phone_verified))
+			OR (SELECT count(*) FROM user_oauth_identity WHERE user_id = $1) > 1)
+	`
+	isOAuthIdentityLinkedCommand = `SELECT EXISTS (SELECT 1 FROM user_oauth_identity WHERE user_id = $1 AND provider = $2)`
+)
+
+// GetUserIDByOAuthIdentity Пользователь, к которому привязан аккаунт провайдера
+func (r *AuthRepository) GetUserIDByOAuthIdentity(ctx context.Context, provider models.OAuthProvider, subject string) (uuid.UUID, error) {
+	const methodName = "[AuthRepository.GetUserIDByOAuthIdentity]"
+
+	var userID uuid.UUID
+	if err := r.db.QueryRowContext(ctx, getUserIDByOAuthIdentityCommand, provider, subject).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errs.ErrNotFound
+		}
+		return uuid.Nil, errs.WrapDBError(methodName, err)
+	}
+
+	return userID, nil
+}
+
+// CreateOAuthUser Создаёт пользователя без пароля вместе с привязкой провайдера
+func (r *AuthRepository) CreateOAuthUser(ctx context.Context, in dto.CreateOAuthUserReq) error {
+	const methodName = "[AuthRepository.CreateOAuthUser]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		createOAuthUserCommand,
+		in.UUID,
+		in.Nickname,
+		in.Email,
+		pq.Array(in.Roles.Strings()),
+		in.EmailVerified,
+	); err != nil {
+		_ = tx.Rollback()
+		if errs.IsUniqueViolation(err) {
+			return errs.ErrAlreadyExists
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = linkOAuthIdentity(ctx, tx, in.Identity); err != nil {
+		_ = tx.Rollback()
+		if errs.IsUniqueViolation(err) {
+			return errs.ErrAlreadyExists
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// LinkOAuthIdentity Привязывает аккаунт провайдера. ErrAlreadyExists, если он привязан к кому-то
+// или у пользователя уже есть аккаунт этого провайдера
+func (r *AuthRepository) LinkOAuthIdentity(ctx context.Context, in dto.LinkOAuthIdentityReq) error {
+	const methodName = "[AuthRepository.LinkOAuthIdentity]"
+
+	if err := linkOAuthIdentity(ctx, r.db, in); err != nil {
+		if errs.IsUniqueViolation(err) {
+			return errs.ErrAlreadyExists
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// UnlinkOAuthIdentity Отвязывает провайдера. ErrNotFound, если он не привязан, ErrLastLoginMethod, если это последний способ входа
+func (r *AuthRepository) UnlinkOAuthIdentity(ctx context.Context, userID uuid.UUID, provider models.OAuthProvider) error {
+	const methodName = "[AuthRepository.UnlinkOAuthIdentity]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	var lockedID uuid.UUID
+	if err = tx.QueryRowContext(ctx, lockOAuthUserCommand, userID).Scan(&lockedID); err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrNotFound
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	res, err := tx.ExecContext(ctx, unlinkOAuthIdentityCommand, userID, provider)
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if affected > 0 {
+		if err = tx.Commit(); err != nil {
+			return errs.WrapDBError(methodName, err)
+		}
+		return nil
+	}
+
+	var linked bool
+	err = tx.QueryRowContext(ctx, isOAuthIdentityLinkedCommand, userID, provider).Scan(&linked)
+	_ = tx.Rollback()
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+	if linked {
+		return errs.ErrLastLoginMethod
+	}
+
+	return errs.ErrNotFound
+}
+
+func linkOAuthIdentity(ctx context.Context, db execer, in dto.LinkOAuthIdentityReq) error {
+	_, err := db.ExecContext(ctx, linkOAuthIdentityCommand,
+		in.ID,
+		in.UserID,
+		in.Provider,
+		in.Subject,
+		in.Email,
+	)
+	return err
+}
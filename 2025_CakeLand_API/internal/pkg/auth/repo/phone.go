@@ -0,0 +1,162 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	// Новый код заменяет прежний не раньше $4 секунд после его отправки. За сутки с первого кода на номер
+	// отправляется не больше $5 кодов, неверные попытки за эти сутки копятся: новый код их не обнуляет,
+	// а после $6 неверных попыток новые коды не отправляются. Основной запрос видит таблицу до вставки,
+	// поэтому при отказе возвращает, когда можно повторить
+	createPhoneCodeCommand = `
+		WITH upserted AS (
+			INSERT INTO phone_code (phone, code_hash, expires_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (phone) DO UPDATE
+				SET code_hash         = excluded.code_hash,
+					sent_at           = now(),
+					expires_at        = excluded.expires_at,
+					attempts          = CASE WHEN phone_code.window_started_at <= now() - INTERVAL '1 day' THEN 0 ELSE phone_code.attempts END,
+					sent_count        = CASE WHEN phone_code.window_started_at <= now() - INTERVAL '1 day' THEN 1 ELSE phone_code.sent_count + 1 END,
+					window_started_at = CASE WHEN phone_code.window_started_at <= now() - INTERVAL '1 day' THEN now() ELSE phone_code.window_started_at END
+				WHERE phone_code.sent_at <= now() - make_interval(secs => $4)
+				  AND (phone_code.window_started_at <= now() - INTERVAL '1 day'
+					OR (phone_code.sent_count < $5 AND phone_code.attempts < $6))
+			RETURNING sent_at
+		)
+		SELECT TRUE, sent_at FROM upserted
+		UNION ALL
+		SELECT FALSE,
+			   CASE
+				   WHEN window_started_at > now() - INTERVAL '1 day' AND (sent_count >= $5 OR attempts >= $6)
+					   THEN GREATEST(window_started_at + INTERVAL '1 day', sent_at + make_interval(secs => $4))
+				   ELSE sent_at + make_interval(secs => $4)
+			   END
+		FROM phone_code
+		WHERE phone = $1 AND NOT EXISTS (SELECT 1 FROM upserted)
+	`
+	// Попытка расходуется до сравнения кода: параллельные запросы не получат больше $2 попыток
+	spendPhoneCodeAttemptCommand = `
+		UPDATE phone_code
+		SET attempts = attempts + 1
+		WHERE phone = $1 AND attempts < $2 AND expires_at > now()
+		RETURNING code_hash
+	`
+	// Гасится именно проверенный код: если его успели заменить новым, новый остаётся
+	deletePhoneCodeCommand = `DELETE FROM phone_code WHERE phone = $1 AND code_hash = $2`
+	getUserByPhoneCommand  = `SELECT id, roles, ` + twoFactorEnabledColumn + ` FROM "user" u WHERE phone = $1 AND phone_verified`
+	createPhoneUserCommand = `
+		INSERT INTO "user" (id, nickname, mail, password_hash, phone, phone_verified, roles)
+		VALUES ($1, $2, NULL, NULL, $3, TRUE, $4::user_role[])
+	`
+)
+
+// CreatePhoneCode Сохраняет код, прежний код на этот номер перестаёт действовать.
+// Пока не прошёл ResendCooldown или исчерпаны суточные лимиты номера, ничего не меняет
+func (r *AuthRepository) CreatePhoneCode(ctx context.Context, in dto.CreatePhoneCodeReq) (*dto.CreatePhoneCodeRes, error) {
+	const methodName = "[AuthRepository.CreatePhoneCode]"
+
+	var (
+		res dto.CreatePhoneCodeRes
+		at  time.Time
+	)
+	if err := r.db.QueryRowContext(ctx, createPhoneCodeCommand,
+		in.Phone,
+		in.CodeHash,
+		in.ExpiresAt,
+		in.ResendCooldown.Seconds(),
+		in.MaxPerDay,
+		in.MaxAttempts,
+	).Scan(&res.Created, &at); err != nil {
+		// Код на этот номер только что создал параллельный запрос, ещё не видный в снимке
+		if errors.Is(err, sql.ErrNoRows) {
+			return &dto.CreatePhoneCodeRes{RetryAt: time.Now().Add(in.ResendCooldown)}, nil
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	if !res.Created {
+		res.RetryAt = at
+	}
+
+	return &res, nil
+}
+
+// SpendPhoneCodeAttempt Расходует попытку ввода действующего кода и возвращает его для сравнения.
+// ErrNotFound, если кода нет, он истёк или попытки кончились
+func (r *AuthRepository) SpendPhoneCodeAttempt(ctx context.Context, phone string, maxAttempts int) (*dto.PhoneCodeDB, error) {
+	const methodName = "[AuthRepository.SpendPhoneCodeAttempt]"
+
+	var code dto.PhoneCodeDB
+	if err := r.db.QueryRowContext(ctx, spendPhoneCodeAttemptCommand, phone, maxAttempts).Scan(&code.CodeHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &code, nil
+}
+
+// DeletePhoneCode Гасит код. false, если его уже погасил параллельный запрос или заменил новый код
+func (r *AuthRepository) DeletePhoneCode(ctx context.Context, phone, codeHash string) (bool, error) {
+	const methodName = "[AuthRepository.DeletePhoneCode]"
+
+	res, err := r.db.ExecContext(ctx, deletePhoneCodeCommand, phone, codeHash)
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return affected > 0, nil
+}
+
+// GetUserByPhone Пользователь с подтверждённым номером. ErrNotFound, если такого нет
+func (r *AuthRepository) GetUserByPhone(ctx context.Context, phone string) (*dto.GetUserByPhoneRes, error) {
+	const methodName = "[AuthRepository.GetUserByPhone]"
+
+	var (
+		res   dto.GetUserByPhoneRes
+		roles pq.StringArray
+	)
+	if err := r.db.QueryRowContext(ctx, getUserByPhoneCommand, phone).Scan(&res.ID, &roles, &res.TwoFactorEnabled); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	res.Roles = models.ParseRoles(roles)
+
+	return &res, nil
+}
+
+// CreatePhoneUser Создаёт пользователя без почты и пароля. ErrAlreadyExists, если ник занят
+// или номер успел подтвердить параллельный запрос
+func (r *AuthRepository) CreatePhoneUser(ctx context.Context, in dto.CreatePhoneUserReq) error {
+	const methodName = "[AuthRepository.CreatePhoneUser]"
+
+	if _, err := r.db.ExecContext(ctx, createPhoneUserCommand,
+		in.UUID,
+		in.Nickname,
+		in.Phone,
+		pq.Array(in.Roles.Strings()),
+	); err != nil {
+		if errs.IsUniqueViolation(err) {
+			return errs.ErrAlreadyExists
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
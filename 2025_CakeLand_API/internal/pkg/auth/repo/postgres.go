@@ -1,23 +1,158 @@
 package repo
 
 import (
+	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/auth/dto"
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
-	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
 )
 
 const (
-	isUserExistsCommand            = `SELECT EXISTS(SELECT 1 FROM "user" WHERE mail = $1);`
-	createUserCommand              = `INSERT INTO "user" (id, nickname, mail, password_hash, refresh_tokens_map) VALUES ($1, $2, $3, $4, $5);`
-	getUserByEmailCommand          = `SELECT id, mail, refresh_tokens_map, password_hash FROM "user" WHERE mail = $1;`
-	updateUserRefreshTokensCommand = `UPDATE "user" SET refresh_tokens_map = $1 WHERE id = $2;`
-	getUserRefreshTokensCommand    = `SELECT refresh_tokens_map FROM "user" where id = $1`
+	isUserExistsCommand         = `SELECT EXISTS(SELECT 1 FROM "user" WHERE mail = $1);`
+	createUserCommand           = `INSERT INTO "user" (id, nickname, mail, password_hash, roles) VALUES ($1, $2, $3, $4, $5::user_role[]);`
+	getUserByEmailCommand       = `SELECT id, mail, password_hash, roles, email_verified, ` + twoFactorEnabledColumn + ` FROM "user" u WHERE mail = $1;`
+	getUserRolesCommand         = `SELECT roles, ` + twoFactorEnabledColumn + ` FROM "user" u WHERE id = $1`
+	getPasswordHashCommand      = `SELECT password_hash FROM "user" WHERE id = $1`
+	updatePasswordCommand       = `UPDATE "user" SET password_hash = $2 WHERE id = $1`
+	rehashPasswordCommand       = `UPDATE "user" SET password_hash = $3 WHERE id = $1 AND password_hash = $2`
+	markEmailVerifiedCommand    = `UPDATE "user" SET email_verified = TRUE WHERE id = $1`
+	deleteSessionsExceptCommand = `WITH deleted AS (DELETE FROM user_session WHERE user_id = $1 AND fingerprint IS DISTINCT FROM $2 RETURNING access_token_id, access_expires_at)` + denyDeletedAccessTokens
+	// Новый код заменяет прежний с той же целью вместе со счётчиком попыток, но не раньше $6 секунд после его отправки
+	// и не больше $7 кодов за сутки с первого из них
+	createAuthCodeCommand = `
+		INSERT INTO auth_code (id, user_id, purpose, code_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, purpose) DO UPDATE
+			SET id                = excluded.id,
+				code_hash         = excluded.code_hash,
+				attempts          = 0,
+				created_at        = now(),
+				expires_at        = excluded.expires_at,
+				sent_count        = CASE WHEN auth_code.window_started_at <= now() - INTERVAL '1 day' THEN 1 ELSE auth_code.sent_count + 1 END,
+				window_started_at = CASE WHEN auth_code.window_started_at <= now() - INTERVAL '1 day' THEN now() ELSE auth_code.window_started_at END
+			WHERE auth_code.created_at <= now() - make_interval(secs => $6)
+			  AND (auth_code.window_started_at <= now() - INTERVAL '1 day' OR auth_code.sent_count < $7)
+	`
+	// Попытка расходуется до сравнения кода: параллельные запросы не получат больше $3 попыток на код
+	spendAuthCodeAttemptCommand = `
+		UPDATE auth_code
+		SET attempts = attempts + 1
+		WHERE user_id = $1 AND purpose = $2 AND attempts < $3 AND expires_at > now()
+		RETURNING id, code_hash
+	`
+	deleteAuthCodeCommand = `DELETE FROM auth_code WHERE id = $1`
+	saveLoginAuditCommand = `
+		INSERT INTO login_audit (id, user_id, email, phone, ip_address, fingerprint, device_name, success, failure_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	// Старый журнал удаляется порциями по $2 записей, чтобы не держать долгих блокировок
+	purgeLoginAuditCommand = `
+		DELETE FROM login_audit
+		WHERE id IN (SELECT id FROM login_audit WHERE created_at < $1 LIMIT $2)
+	`
+	// Повторный вход с того же устройства заменяет сессию целиком.
+	// Access токен заменённой сессии, если ещё действует, попадает в denylist в том же запросе
+	createSessionCommand = `
+		WITH old AS (
+			SELECT access_token_id, access_expires_at
+			FROM user_session
+			WHERE user_id = $2 AND fingerprint = $3
+			FOR UPDATE
+		),
+		upserted AS (
+			INSERT INTO user_session (id, user_id, fingerprint, family_id, refresh_token_hash, device_name, ip_address,
+									  expires_at, access_token_id, access_expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (user_id, fingerprint) DO UPDATE
+				SET id                 = excluded.id,
+					family_id          = excluded.family_id,
+					refresh_token_hash = excluded.refresh_token_hash,
+					device_name        = excluded.device_name,
+					ip_address         = excluded.ip_address,
+					created_at         = now(),
+					last_used_at       = now(),
+					expires_at         = excluded.expires_at,
+					access_token_id    = excluded.access_token_id,
+					access_expires_at  = excluded.access_expires_at
+			RETURNING id
+		),
+		denied AS (
+			INSERT INTO access_token_denylist (jti, expires_at)
+			SELECT access_token_id, access_expires_at
+			FROM old
+			WHERE access_token_id IS NOT NULL AND access_expires_at > now()
+			ON CONFLICT (jti) DO NOTHING
+		)
+		SELECT count(*) FROM upserted
+	`
+	getSessionCommand = `
+		SELECT id, family_id, refresh_token_hash
+		FROM user_session
+		WHERE user_id = $1 AND fingerprint = $2 AND expires_at > now()
+	`
+	// Токен заменяется, только если в сессии всё ещё лежит старый: параллельная ротация не пройдёт.
+	// Прежний access токен сессии, если ещё действует, попадает в denylist. Возвращает число обновлённых сессий
+	rotateSessionCommand = `
+		WITH old AS (
+			SELECT id, access_token_id, access_expires_at
+			FROM user_session
+			WHERE user_id = $1 AND fingerprint = $2 AND refresh_token_hash = $4
+			FOR UPDATE
+		),
+		rotated AS (
+			UPDATE user_session s
+			SET refresh_token_hash = $5,
+				family_id          = $3,
+				ip_address         = $6,
+				expires_at         = $7,
+				access_token_id    = $8,
+				access_expires_at  = $9,
+				last_used_at       = now()
+			FROM old
+			WHERE s.id = old.id
+			RETURNING old.access_token_id, old.access_expires_at
+		),
+		denied AS (
+			INSERT INTO access_token_denylist (jti, expires_at)
+			SELECT access_token_id, access_expires_at
+			FROM rotated
+			WHERE access_token_id IS NOT NULL AND access_expires_at > now()
+			ON CONFLICT (jti) DO NOTHING
+		)
+		SELECT count(*) FROM rotated
+	`
+	deleteSessionCommand = `WITH deleted AS (DELETE FROM user_session WHERE user_id = $1 AND fingerprint = $2 RETURNING access_token_id, access_expires_at)` + denyDeletedAccessTokens
+	sessionsCommand      = `
+		SELECT id, fingerprint, device_name, ip_address, created_at, last_used_at, expires_at
+		FROM user_session
+		WHERE user_id = $1 AND expires_at > now()
+		ORDER BY last_used_at DESC
+	`
+	deleteSessionByIDCommand   = `WITH deleted AS (DELETE FROM user_session WHERE id = $1 AND user_id = $2 RETURNING access_token_id, access_expires_at)` + denyDeletedAccessTokens
+	deleteOtherSessionsCommand = `WITH deleted AS (DELETE FROM user_session WHERE user_id = $1 AND fingerprint <> $2 RETURNING access_token_id, access_expires_at)` + denyDeletedAccessTokens
 )
 
+// denyDeletedAccessTokens Продолжение удаления сессий (CTE deleted): ещё действующие access токены удалённых сессий
+// попадают в denylist, истёкшие записи из него убираются. Возвращает число удалённых сессий
+const denyDeletedAccessTokens = `,
+	denied AS (
+		INSERT INTO access_token_denylist (jti, expires_at)
+		SELECT access_token_id, access_expires_at
+		FROM deleted
+		WHERE access_token_id IS NOT NULL AND access_expires_at > now()
+		ON CONFLICT (jti) DO NOTHING
+	),
+	purged AS (
+		DELETE FROM access_token_denylist WHERE expires_at <= now()
+	)
+	SELECT count(*) FROM deleted
+`
+
 type AuthRepository struct {
 	db *sql.DB
 }
@@ -43,21 +178,31 @@ func (r *AuthRepository) CreateUser(ctx context.Context, in dto.CreateUserReq) e
 		return errs.ErrAlreadyExists
 	}
 
-	// Сериализация RefreshTokensMap в JSON
-	refreshTokensJSON, err := json.Marshal(in.RefreshTokensMap)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("%s: failed to marshal refreshTokensJSON: %v", methodName, err)
+		return errs.WrapDBError(methodName, err)
 	}
 
 	// Выполнение команды создания пользователя
-	if _, err = r.db.ExecContext(ctx,
+	if _, err = tx.ExecContext(ctx,
 		createUserCommand,
 		in.UUID,
 		in.Nickname,
 		in.Email,
 		in.PasswordHash,
-		refreshTokensJSON,
+		pq.Array(in.Roles.Strings()),
 	); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	// Сессия устройства, с которого зарегистрировались
+	if err = createSession(ctx, tx, in.Session); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
 		return errs.WrapDBError(methodName, err)
 	}
 
@@ -68,52 +213,357 @@ func (r *AuthRepository) GetUserByEmail(ctx context.Context, in dto.GetUserByEma
 	const methodName = "[AuthRepository.GetUserByEmail]"
 
 	row := r.db.QueryRowContext(ctx, getUserByEmailCommand, in.Email)
-	var res dto.GetUserByEmailRes
-	if err := row.Scan(&res.ID, &res.Email, &res.RefreshTokensMap, &res.PasswordHash); err != nil {
+	var (
+		res   dto.GetUserByEmailRes
+		roles pq.StringArray
+	)
+	if err := row.Scan(&res.ID, &res.Email, &res.PasswordHash, &roles, &res.EmailVerified, &res.TwoFactorEnabled); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrNotFound
 		}
 		return nil, errs.WrapDBError(methodName, err)
 	}
+	res.Roles = models.ParseRoles(roles)
 
 	return &res, nil
 }
 
-func (r *AuthRepository) UpdateUserRefreshTokens(ctx context.Context, in dto.UpdateUserRefreshTokensReq) error {
-	const methodName = "[AuthRepository.UpdateUserRefreshTokens]"
+func (r *AuthRepository) GetUserRoles(ctx context.Context, in dto.GetUserRolesReq) (*dto.GetUserRolesRes, error) {
+	const methodName = "[AuthRepository.GetUserRoles]"
+
+	var (
+		res   dto.GetUserRolesRes
+		roles pq.StringArray
+	)
+	if err := r.db.QueryRowContext(ctx, getUserRolesCommand, in.UserID).Scan(&roles, &res.TwoFactorEnabled); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	res.Roles = models.ParseRoles(roles)
+
+	return &res, nil
+}
+
+// CreateSession Создаёт сессию устройства. Прежняя сессия устройства заменяется, её access токен отзывается
+func (r *AuthRepository) CreateSession(ctx context.Context, in dto.CreateSessionReq) error {
+	const methodName = "[AuthRepository.CreateSession]"
+
+	if err := createSession(ctx, r.db, in); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// GetSession Действующая сессия устройства. ErrNotFound, если её нет или она истекла
+func (r *AuthRepository) GetSession(ctx context.Context, in dto.GetSessionReq) (*dto.SessionDB, error) {
+	const methodName = "[AuthRepository.GetSession]"
+
+	var session dto.SessionDB
+	if err := r.db.QueryRowContext(ctx, getSessionCommand, in.UserID, in.Fingerprint).Scan(
+		&session.ID,
+		&session.FamilyID,
+		&session.RefreshTokenHash,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &session, nil
+}
+
+// RotateSession Заменяет refresh токен сессии и отзывает её прежний access токен. false, если старого токена там уже нет
+func (r *AuthRepository) RotateSession(ctx context.Context, in dto.RotateSessionReq) (bool, error) {
+	const methodName = "[AuthRepository.RotateSession]"
+
+	var rotated int
+	if err := r.db.QueryRowContext(ctx, rotateSessionCommand,
+		in.UserID,
+		in.Fingerprint,
+		in.FamilyID,
+		in.OldRefreshTokenHash,
+		in.NewRefreshTokenHash,
+		in.IPAddress,
+		in.ExpiresAt,
+		in.AccessTokenID,
+		in.AccessExpiresAt,
+	).Scan(&rotated); err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
 
-	// Сериализация RefreshTokensMap в JSON
-	refreshTokensJSON, err := json.Marshal(in.RefreshTokensMap)
+	return rotated > 0, nil
+}
+
+// DeleteSession Удаляет сессию устройства
+func (r *AuthRepository) DeleteSession(ctx context.Context, in dto.DeleteSessionReq) error {
+	const methodName = "[AuthRepository.DeleteSession]"
+
+	if _, err := r.db.ExecContext(ctx, deleteSessionCommand, in.UserID, in.Fingerprint); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// Sessions Действующие сессии пользователя, последние использованные первыми
+func (r *AuthRepository) Sessions(ctx context.Context, userID uuid.UUID) ([]models.Session, error) {
+	const methodName = "[AuthRepository.Sessions]"
+
+	rows, err := r.db.QueryContext(ctx, sessionsCommand, userID)
 	if err != nil {
-		return fmt.Errorf("%s: failed to marshal refreshTokensJSON: %v", methodName, err)
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	defer rows.Close()
+
+	var sessions []models.Session
+	for rows.Next() {
+		var session models.Session
+		if err = rows.Scan(
+			&session.ID,
+			&session.Fingerprint,
+			&session.DeviceName,
+			&session.IPAddress,
+			&session.CreatedAt,
+			&session.LastUsedAt,
+			&session.ExpiresAt,
+		); err != nil {
+			return nil, errs.WrapDBError(methodName, err)
+		}
+		sessions = append(sessions, session)
 	}
+	if err = rows.Err(); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return sessions, nil
+}
 
-	// Выполнение команды обновления токенов
-	if _, err = r.db.ExecContext(ctx, updateUserRefreshTokensCommand, refreshTokensJSON, in.UserID); err != nil {
+// DeleteSessionByID Удаляет сессию пользователя по коду. ErrNotFound, если такой сессии у пользователя нет
+func (r *AuthRepository) DeleteSessionByID(ctx context.Context, userID, sessionID uuid.UUID) error {
+	const methodName = "[AuthRepository.DeleteSessionByID]"
+
+	var deleted int
+	if err := r.db.QueryRowContext(ctx, deleteSessionByIDCommand, sessionID, userID).Scan(&deleted); err != nil {
 		return errs.WrapDBError(methodName, err)
 	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
 
 	return nil
 }
 
-func (r *AuthRepository) GetUserRefreshTokens(ctx context.Context, in dto.GetUserRefreshTokensReq) (*dto.GetUserRefreshTokensRes, error) {
-	const methodName = "[AuthRepository.GetUserRefreshTokens]"
+// DeleteOtherSessions Удаляет все сессии пользователя, кроме сессии устройства. Возвращает число удалённых
+func (r *AuthRepository) DeleteOtherSessions(ctx context.Context, userID uuid.UUID, fingerprint string) (int, error) {
+	const methodName = "[AuthRepository.DeleteOtherSessions]"
 
-	var refreshTokens []byte
-	row := r.db.QueryRowContext(ctx, getUserRefreshTokensCommand, in.UserID)
-	if err := row.Scan(&refreshTokens); err != nil {
+	var deleted int
+	if err := r.db.QueryRowContext(ctx, deleteOtherSessionsCommand, userID, fingerprint).Scan(&deleted); err != nil {
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	return deleted, nil
+}
+
+// GetPasswordHash Хэш пароля пользователя
+func (r *AuthRepository) GetPasswordHash(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	const methodName = "[AuthRepository.GetPasswordHash]"
+
+	var passwordHash []byte
+	if err := r.db.QueryRowContext(ctx, getPasswordHashCommand, userID).Scan(&passwordHash); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrNotFound
 		}
 		return nil, errs.WrapDBError(methodName, err)
 	}
 
-	var refreshTokensMap map[string]string
-	if err := json.Unmarshal(refreshTokens, &refreshTokensMap); err != nil {
-		return nil, fmt.Errorf("%s: failed to unmarshal refreshTokensMap: %w", methodName, err)
+	return passwordHash, nil
+}
+
+// UpdatePassword Меняет пароль и в той же транзакции завершает сессии, выданные со старым паролем
+func (r *AuthRepository) UpdatePassword(ctx context.Context, in dto.UpdatePasswordReq) error {
+	const methodName = "[AuthRepository.UpdatePassword]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	res, err := tx.ExecContext(ctx, updatePasswordCommand, in.UserID, in.PasswordHash)
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
 	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return errs.ErrNotFound
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteSessionsExceptCommand, in.UserID, in.KeepFingerprint); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// RehashPassword Заменяет хэш того же пароля на хэш с текущими параметрами
+func (r *AuthRepository) RehashPassword(ctx context.Context, in dto.RehashPasswordReq) error {
+	const methodName = "[AuthRepository.RehashPassword]"
+
+	if _, err := r.db.ExecContext(ctx, rehashPasswordCommand, in.UserID, in.OldPasswordHash, in.NewPasswordHash); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// MarkEmailVerified Отмечает почту пользователя подтверждённой
+func (r *AuthRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	const methodName = "[AuthRepository.MarkEmailVerified]"
+
+	if _, err := r.db.ExecContext(ctx, markEmailVerifiedCommand, userID); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// CreateAuthCode Сохраняет код, прежний код с той же целью перестаёт действовать.
+// false, если не прошёл ResendCooldown с отправки прежнего или за сутки отправлено MaxPerDay кодов
+func (r *AuthRepository) CreateAuthCode(ctx context.Context, in dto.CreateAuthCodeReq) (bool, error) {
+	const methodName = "[AuthRepository.CreateAuthCode]"
+
+	res, err := r.db.ExecContext(ctx, createAuthCodeCommand,
+		in.ID,
+		in.UserID,
+		in.Purpose,
+		in.CodeHash,
+		in.ExpiresAt,
+		in.ResendCooldown.Seconds(),
+		in.MaxPerDay,
+	)
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return affected > 0, nil
+}
+
+// SpendAuthCodeAttempt Расходует попытку ввода действующего кода и возвращает его для сравнения.
+// ErrNotFound, если кода нет, он истёк или попытки кончились
+func (r *AuthRepository) SpendAuthCodeAttempt(ctx context.Context, in dto.SpendAuthCodeAttemptReq) (*dto.AuthCodeDB, error) {
+	const methodName = "[AuthRepository.SpendAuthCodeAttempt]"
+
+	var code dto.AuthCodeDB
+	if err := r.db.QueryRowContext(ctx, spendAuthCodeAttemptCommand, in.UserID, in.Purpose, in.MaxAttempts).Scan(
+		&code.ID,
+		&code.CodeHash,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &code, nil
+}
+
+// DeleteAuthCode Гасит код. false, если его уже погасил параллельный запрос
+func (r *AuthRepository) DeleteAuthCode(ctx context.Context, codeID uuid.UUID) (bool, error) {
+	const methodName = "[AuthRepository.DeleteAuthCode]"
+
+	res, err := r.db.ExecContext(ctx, deleteAuthCodeCommand, codeID)
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return affected > 0, nil
+}
+
+// SaveLoginAudit Добавляет запись в журнал входов
+func (r *AuthRepository) SaveLoginAudit(ctx context.Context, in dto.LoginAuditReq) error {
+	const methodName = "[AuthRepository.SaveLoginAudit]"
+
+	if _, err := r.db.ExecContext(ctx, saveLoginAuditCommand,
+		in.ID,
+		in.UserID,
+		in.Email,
+		in.Phone,
+		in.IPAddress,
+		in.Fingerprint,
+		in.DeviceName,
+		in.Success,
+		in.FailureReason,
+	); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// loginAuditPurgeBatch Сколько записей журнала входов удаляется за один запрос
+const loginAuditPurgeBatch = 10000
+
+// PurgeLoginAudit Удаляет записи журнала входов старше before
+func (r *AuthRepository) PurgeLoginAudit(ctx context.Context, before time.Time) error {
+	const methodName = "[AuthRepository.PurgeLoginAudit]"
+
+	for {
+		res, err := r.db.ExecContext(ctx, purgeLoginAuditCommand, before, loginAuditPurgeBatch)
+		if err != nil {
+			return errs.WrapDBError(methodName, err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return errs.WrapDBError(methodName, err)
+		}
+		if deleted < loginAuditPurgeBatch {
+			return nil
+		}
+	}
+}
+
+// execer Общее у *sql.DB и *sql.Tx: сессия создаётся и отдельно, и вместе с пользователем
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
 
-	return &dto.GetUserRefreshTokensRes{
-		RefreshTokensMap: refreshTokensMap,
-	}, nil
+func createSession(ctx context.Context, db execer, in dto.CreateSessionReq) error {
+	_, err := db.ExecContext(ctx, createSessionCommand,
+		in.ID,
+		in.UserID,
+		in.Fingerprint,
+		in.FamilyID,
+		in.RefreshTokenHash,
+		in.DeviceName,
+		in.IPAddress,
+		in.ExpiresAt,
+		in.AccessTokenID,
+		in.AccessExpiresAt,
+	)
+	return err
 }
@@ -0,0 +1,196 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// twoFactorEnabledColumn Включена ли 2FA у пользователя u. Добавляется к запросам пользователя при входе
+	twoFactorEnabledColumn = `EXISTS (SELECT 1 FROM user_totp t WHERE t.user_id = u.id AND t.enabled)`
+	// Новое подключение заменяет неподтверждённое. Включённую 2FA не трогает
+	saveTOTPSecretCommand = `
+		INSERT INTO user_totp (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+			SET secret         = excluded.secret,
+				last_used_step = 0,
+				created_at     = now()
+			WHERE NOT user_totp.enabled
+	`
+	getTOTPCommand    = `SELECT secret, enabled, last_used_step FROM user_totp WHERE user_id = $1`
+	enableTOTPCommand = `
+		UPDATE user_totp
+		SET enabled = TRUE, enabled_at = now(), last_used_step = $2
+		WHERE user_id = $1 AND NOT enabled AND secret = $3
+	`
+	// Код принимается, только если его интервал новее последнего принятого: параллельный вход тем же кодом не пройдёт
+	useTOTPStepCommand         = `UPDATE user_totp SET last_used_step = $2 WHERE user_id = $1 AND enabled AND last_used_step < $2`
+	deleteRecoveryCodesCommand = `DELETE FROM user_recovery_code WHERE user_id = $1`
+	createRecoveryCodeCommand  = `INSERT INTO user_recovery_code (user_id, code_hash) VALUES ($1, $2)`
+	useRecoveryCodeCommand     = `DELETE FROM user_recovery_code WHERE user_id = $1 AND code_hash = $2`
+	deleteTOTPCommand          = `DELETE FROM user_totp WHERE user_id = $1`
+	getTOTPAccountNameCommand  = `SELECT COALESCE(mail, phone, nickname) FROM "user" WHERE id = $1`
+)
+
+// SaveTOTPSecret Начинает подключение 2FA с новым секретом. ErrAlreadyExists, если 2FA уже включена
+func (r *AuthRepository) SaveTOTPSecret(ctx context.Context, userID uuid.UUID, secret []byte) error {
+	const methodName = "[AuthRepository.SaveTOTPSecret]"
+
+	res, err := r.db.ExecContext(ctx, saveTOTPSecretCommand, userID, secret)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+	if affected == 0 {
+		return errs.ErrAlreadyExists
+	}
+
+	return nil
+}
+
+// GetTOTP Секрет пользователя. ErrNotFound, если подключение 2FA не начиналось
+func (r *AuthRepository) GetTOTP(ctx context.Context, userID uuid.UUID) (*dto.TOTPDB, error) {
+	const methodName = "[AuthRepository.GetTOTP]"
+
+	var res dto.TOTPDB
+	if err := r.db.QueryRowContext(ctx, getTOTPCommand, userID).Scan(&res.Secret, &res.Enabled, &res.LastUsedStep); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &res, nil
+}
+
+// EnableTOTP Включает 2FA и заменяет коды восстановления. ErrAlreadyExists, если её успел включить
+// параллельный запрос или секрет заменило новое подключение
+func (r *AuthRepository) EnableTOTP(ctx context.Context, in dto.EnableTOTPReq) error {
+	const methodName = "[AuthRepository.EnableTOTP]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	res, err := tx.ExecContext(ctx, enableTOTPCommand, in.UserID, in.Step, in.Secret)
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return errs.ErrAlreadyExists
+	}
+
+	if err = replaceRecoveryCodes(ctx, tx, in.UserID, in.RecoveryCodeHashes); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// UseTOTPStep Запоминает интервал принятого кода. false, если код этого или более позднего интервала уже принят
+func (r *AuthRepository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	const methodName = "[AuthRepository.UseTOTPStep]"
+
+	res, err := r.db.ExecContext(ctx, useTOTPStepCommand, userID, step)
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return affected > 0, nil
+}
+
+// UseRecoveryCode Гасит код восстановления. false, если такого кода нет или он уже использован
+func (r *AuthRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	const methodName = "[AuthRepository.UseRecoveryCode]"
+
+	res, err := r.db.ExecContext(ctx, useRecoveryCodeCommand, userID, codeHash)
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteTOTP Отключает 2FA вместе с кодами восстановления
+func (r *AuthRepository) DeleteTOTP(ctx context.Context, userID uuid.UUID) error {
+	const methodName = "[AuthRepository.DeleteTOTP]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteTOTPCommand, userID); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if _, err = tx.ExecContext(ctx, deleteRecoveryCodesCommand, userID); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// GetTOTPAccountName Имя аккаунта в приложении-аутентификаторе: почта, телефон или ник
+func (r *AuthRepository) GetTOTPAccountName(ctx context.Context, userID uuid.UUID) (string, error) {
+	const methodName = "[AuthRepository.GetTOTPAccountName]"
+
+	var name string
+	if err := r.db.QueryRowContext(ctx, getTOTPAccountNameCommand, userID).Scan(&name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errs.ErrNotFound
+		}
+		return "", errs.WrapDBError(methodName, err)
+	}
+
+	return name, nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, db execer, userID uuid.UUID, codeHashes []string) error {
+	if _, err := db.ExecContext(ctx, deleteRecoveryCodesCommand, userID); err != nil {
+		return err
+	}
+	for _, codeHash := range codeHashes {
+		if _, err := db.ExecContext(ctx, createRecoveryCodeCommand, userID, codeHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
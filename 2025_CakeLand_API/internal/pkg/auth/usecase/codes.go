@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/mailer"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"math/big"
+	"time"
+)
+
+const (
+	verificationCodeTTL  = 24 * time.Hour
+	passwordResetCodeTTL = 15 * time.Minute
+	maxCodeAttempts      = 5 // После стольких неверных попыток код сгорает
+	codeResendCooldown   = time.Minute
+	maxCodesPerDay       = 5 // Кодов с одной целью на аккаунт за сутки: вместе с maxCodeAttempts ограничивает перебор
+)
+
+// SendVerificationCode Отправляет код подтверждения почты. Для неизвестной или уже подтверждённой почты
+// ничего не делает: по ответу нельзя узнать, зарегистрирован ли адрес
+func (u *AuthUseсase) SendVerificationCode(ctx context.Context, in dto.SendVerificationCodeReq) error {
+	user, err := u.repo.GetUserByEmail(ctx, dto.GetUserByEmailReq{
+		Email: in.Email,
+	})
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return nil
+	}
+
+	return u.sendCode(ctx, user.ID, user.Email, models.CodePurposeEmailVerification, verificationCodeTTL)
+}
+
+// VerifyEmail Подтверждает почту одноразовым кодом из письма
+func (u *AuthUseсase) VerifyEmail(ctx context.Context, in dto.VerifyEmailReq) error {
+	user, err := u.repo.GetUserByEmail(ctx, dto.GetUserByEmailReq{
+		Email: in.Email,
+	})
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.ErrInvalidCode
+	} else if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return nil
+	}
+
+	if err = u.useCode(ctx, user.ID, models.CodePurposeEmailVerification, in.Code); err != nil {
+		return err
+	}
+
+	return u.repo.MarkEmailVerified(ctx, user.ID)
+}
+
+// RequestPasswordReset Отправляет код сброса пароля. Для неизвестной почты ничего не делает
+func (u *AuthUseсase) RequestPasswordReset(ctx context.Context, in dto.RequestPasswordResetReq) error {
+	user, err := u.repo.GetUserByEmail(ctx, dto.GetUserByEmailReq{
+		Email: in.Email,
+	})
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return u.sendCode(ctx, user.ID, user.Email, models.CodePurposePasswordReset, passwordResetCodeTTL)
+}
+
+// ResetPassword Задаёт новый пароль по коду из письма и завершает все сессии пользователя.
+// Код пришёл на почту, поэтому она заодно считается подтверждённой
+func (u *AuthUseсase) ResetPassword(ctx context.Context, in dto.ResetPasswordReq) error {
+	user, err := u.repo.GetUserByEmail(ctx, dto.GetUserByEmailReq{
+		Email: in.Email,
+	})
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.ErrInvalidCode
+	} else if err != nil {
+		return err
+	}
+
+	if err = u.useCode(ctx, user.ID, models.CodePurposePasswordReset, in.Code); err != nil {
+		return err
+	}
+
+	passwordHash, err := u.hasher.Hash(in.NewPassword)
+	if err != nil {
+		return err
+	}
+	if err = u.repo.UpdatePassword(ctx, dto.UpdatePasswordReq{
+		UserID:       user.ID,
+		PasswordHash: passwordHash,
+	}); err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return nil
+	}
+	return u.repo.MarkEmailVerified(ctx, user.ID)
+}
+
+// ChangePassword Меняет пароль по текущему и завершает сессии на остальных устройствах
+func (u *AuthUseсase) ChangePassword(ctx context.Context, in dto.ChangePasswordReq) error {
+	currentHash, err := u.repo.GetPasswordHash(ctx, in.UserID)
+	if err != nil {
+		return err
+	}
+	if ok, _ := u.hasher.Verify(in.CurrentPassword, currentHash); !ok {
+		return errs.ErrInvalidPassword
+	}
+
+	passwordHash, err := u.hasher.Hash(in.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdatePassword(ctx, dto.UpdatePasswordReq{
+		UserID:          in.UserID,
+		PasswordHash:    passwordHash,
+		KeepFingerprint: null.StringFrom(in.Fingerprint),
+	})
+}
+
+// sendCode Создаёт новый код (прежний с той же целью перестаёт действовать) и отправляет его письмом.
+// Чаще codeResendCooldown и больше maxCodesPerDay за сутки молча не отправляет: ошибка выдала бы, что адрес зарегистрирован
+func (u *AuthUseсase) sendCode(
+	ctx context.Context,
+	userID uuid.UUID,
+	email string,
+	purpose models.CodePurpose,
+	ttl time.Duration,
+) error {
+	code, err := generateCode()
+	if err != nil {
+		return err
+	}
+
+	created, err := u.repo.CreateAuthCode(ctx, dto.CreateAuthCodeReq{
+		ID:             uuid.New(),
+		UserID:         userID,
+		Purpose:        purpose,
+		CodeHash:       hashCode(userID, code),
+		ExpiresAt:      time.Now().Add(ttl),
+		ResendCooldown: codeResendCooldown,
+		MaxPerDay:      maxCodesPerDay,
+	})
+	if err != nil || !created {
+		return err
+	}
+
+	return u.mailer.Send(ctx, codeMessage(email, purpose, code, ttl))
+}
+
+// useCode Проверяет и гасит код. Каждая проверка расходует попытку, после maxCodeAttempts код сгорает
+func (u *AuthUseсase) useCode(ctx context.Context, userID uuid.UUID, purpose models.CodePurpose, code string) error {
+	authCode, err := u.repo.SpendAuthCodeAttempt(ctx, dto.SpendAuthCodeAttemptReq{
+		UserID:      userID,
+		Purpose:     purpose,
+		MaxAttempts: maxCodeAttempts,
+	})
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.ErrInvalidCode
+	} else if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(authCode.CodeHash), []byte(hashCode(userID, code))) != 1 {
+		return errs.ErrInvalidCode
+	}
+
+	// Код одноразовый: если его уже погасил параллельный запрос, второй не проходит
+	used, err := u.repo.DeleteAuthCode(ctx, authCode.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errs.ErrInvalidCode
+	}
+
+	return nil
+}
+
+// generateCode Шестизначный код из криптографически стойкого генератора
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashCode Код короткий, поэтому хэшируем вместе с userID: одинаковые коды разных пользователей не совпадают по хэшу
+func hashCode(userID uuid.UUID, code string) string {
+	sum := sha256.Sum256([]byte(userID.String() + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func codeMessage(email string, purpose models.CodePurpose, code string, ttl time.Duration) mailer.Message {
+	switch purpose {
+	case models.CodePurposePasswordReset:
+		return mailer.Message{
+			To:      email,
+			Subject: "Сброс пароля CakeLand",
+			Body: fmt.Sprintf(
+				"Код для сброса пароля: %s\n\nКод действует %d мин. Если вы не запрашивали сброс, просто проигнорируйте это письмо.\n",
+				code, int(ttl.Minutes()),
+			),
+		}
+	default:
+		return mailer.Message{
+			To:      email,
+			Subject: "Подтверждение почты CakeLand",
+			Body: fmt.Sprintf(
+				"Код подтверждения почты: %s\n\nКод действует %d ч.\n",
+				code, int(ttl.Hours()),
+			),
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/auth/mocks"
+	"2025_CakeLand_API/internal/pkg/mailer"
+	"2025_CakeLand_API/internal/pkg/utils/password"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+var codeRegexp = regexp.MustCompile(`\b\d{6}\b`)
+
+func TestAuthUsecase_VerifyEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Mailer: mail})
+
+	const email = "test@example.com"
+	userID := uuid.New()
+	user := &dto.GetUserByEmailRes{ID: userID, Email: email}
+	codeReq := dto.SpendAuthCodeAttemptReq{UserID: userID, Purpose: models.CodePurposeEmailVerification, MaxAttempts: maxCodeAttempts}
+
+	// Отправляем код и достаём его из письма
+	var codeHash string
+	mockRepo.EXPECT().GetUserByEmail(gomock.Any(), dto.GetUserByEmailReq{Email: email}).Return(user, nil)
+	mockRepo.EXPECT().
+		CreateAuthCode(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in dto.CreateAuthCodeReq) (bool, error) {
+			assert.Equal(t, models.CodePurposeEmailVerification, in.Purpose)
+			assert.Equal(t, codeResendCooldown, in.ResendCooldown)
+			assert.Equal(t, maxCodesPerDay, in.MaxPerDay)
+			codeHash = in.CodeHash
+			return true, nil
+		})
+
+	assert.NoError(t, uc.SendVerificationCode(context.Background(), dto.SendVerificationCodeReq{Email: email}))
+	msg, ok := mail.Last(email)
+	assert.True(t, ok)
+	code := codeRegexp.FindString(msg.Body)
+	assert.NotEmpty(t, code)
+	assert.NotEqual(t, code, codeHash)
+
+	t.Run("Wrong code spends an attempt", func(t *testing.T) {
+		codeID := uuid.New()
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(user, nil)
+		mockRepo.EXPECT().SpendAuthCodeAttempt(gomock.Any(), codeReq).Return(&dto.AuthCodeDB{ID: codeID, CodeHash: codeHash}, nil)
+
+		err := uc.VerifyEmail(context.Background(), dto.VerifyEmailReq{Email: email, Code: "not-a-code"})
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+
+	t.Run("Code burns after too many attempts", func(t *testing.T) {
+		// Попытки кончились: repo не отдаёт код даже для верного ввода
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(user, nil)
+		mockRepo.EXPECT().SpendAuthCodeAttempt(gomock.Any(), codeReq).Return(nil, errs.ErrNotFound)
+
+		err := uc.VerifyEmail(context.Background(), dto.VerifyEmailReq{Email: email, Code: code})
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+
+	t.Run("Correct code verifies email", func(t *testing.T) {
+		codeID := uuid.New()
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(user, nil)
+		mockRepo.EXPECT().SpendAuthCodeAttempt(gomock.Any(), codeReq).Return(&dto.AuthCodeDB{ID: codeID, CodeHash: codeHash}, nil)
+		mockRepo.EXPECT().DeleteAuthCode(gomock.Any(), codeID).Return(true, nil)
+		mockRepo.EXPECT().MarkEmailVerified(gomock.Any(), userID).Return(nil)
+
+		assert.NoError(t, uc.VerifyEmail(context.Background(), dto.VerifyEmailReq{Email: email, Code: code}))
+	})
+
+	t.Run("Code is single-use", func(t *testing.T) {
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(user, nil)
+		mockRepo.EXPECT().SpendAuthCodeAttempt(gomock.Any(), codeReq).Return(nil, errs.ErrNotFound)
+
+		err := uc.VerifyEmail(context.Background(), dto.VerifyEmailReq{Email: email, Code: code})
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+}
+
+func TestAuthUsecase_RequestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Mailer: mail})
+
+	t.Run("Unknown email sends nothing", func(t *testing.T) {
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(nil, errs.ErrNotFound)
+
+		assert.NoError(t, uc.RequestPasswordReset(context.Background(), dto.RequestPasswordResetReq{Email: "nobody@example.com"}))
+		assert.Empty(t, mail.Messages())
+	})
+
+	t.Run("Resend limit sends nothing", func(t *testing.T) {
+		// Отказ не отличается от ответа для неизвестной почты
+		mockRepo.EXPECT().
+			GetUserByEmail(gomock.Any(), gomock.Any()).
+			Return(&dto.GetUserByEmailRes{ID: uuid.New(), Email: "test@example.com"}, nil)
+		mockRepo.EXPECT().CreateAuthCode(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		assert.NoError(t, uc.RequestPasswordReset(context.Background(), dto.RequestPasswordResetReq{Email: "test@example.com"}))
+		assert.Empty(t, mail.Messages())
+	})
+}
+
+func TestAuthUsecase_Login_EmailNotVerified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	hasher := newTestHasher(t, password.AlgorithmBcrypt)
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Hasher: hasher})
+
+	passwordHash, err := hasher.Hash("Password1")
+	assert.NoError(t, err)
+	mockRepo.EXPECT().
+		GetUserByEmail(gomock.Any(), gomock.Any()).
+		Return(&dto.GetUserByEmailRes{ID: uuid.New(), PasswordHash: passwordHash}, nil)
+	mockRepo.EXPECT().
+		SaveLoginAudit(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in dto.LoginAuditReq) error {
+			assert.False(t, in.Success)
+			assert.Equal(t, models.LoginFailureEmailNotVerified, in.FailureReason)
+			return nil
+		})
+
+	res, err := uc.Login(context.Background(), dto.LoginReq{
+		Email:       "test@example.com",
+		Password:    "Password1",
+		Fingerprint: "some-fingerprint",
+	})
+
+	assert.ErrorIs(t, err, errs.ErrEmailNotVerified)
+	assert.Nil(t, res)
+}
+
+func TestAuthUsecase_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	hasher := newTestHasher(t, password.AlgorithmBcrypt)
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Hasher: hasher})
+
+	userID := uuid.New()
+	passwordHash, err := hasher.Hash("Password1")
+	assert.NoError(t, err)
+
+	t.Run("Wrong current password", func(t *testing.T) {
+		mockRepo.EXPECT().GetPasswordHash(gomock.Any(), userID).Return(passwordHash, nil)
+
+		err := uc.ChangePassword(context.Background(), dto.ChangePasswordReq{
+			UserID:          userID,
+			Fingerprint:     "some-fingerprint",
+			CurrentPassword: "Password2",
+			NewPassword:     "Password3",
+		})
+		assert.ErrorIs(t, err, errs.ErrInvalidPassword)
+	})
+
+	t.Run("Keeps only current session", func(t *testing.T) {
+		mockRepo.EXPECT().GetPasswordHash(gomock.Any(), userID).Return(passwordHash, nil)
+		mockRepo.EXPECT().
+			UpdatePassword(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.UpdatePasswordReq) error {
+				assert.Equal(t, userID, in.UserID)
+				assert.Equal(t, null.StringFrom("some-fingerprint"), in.KeepFingerprint)
+				ok, _ := hasher.Verify("Password3", in.PasswordHash)
+				assert.True(t, ok)
+				return nil
+			})
+
+		assert.NoError(t, uc.ChangePassword(context.Background(), dto.ChangePasswordReq{
+			UserID:          userID,
+			Fingerprint:     "some-fingerprint",
+			CurrentPassword: "Password1",
+			NewPassword:     "Password3",
+		}))
+	})
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/config"
+	"context"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// loginKey Счётчик неудачных входов и его лимиты
+type loginKey struct {
+	key   string
+	limit config.LoginLimitConfig
+}
+
+// loginLimiter Ограничивает перебор паролей отдельно по аккаунту и по IP адресу
+type loginLimiter struct {
+	store  auth.ILoginAttemptStore
+	limits config.LoginLimitsConfig
+}
+
+// keys Счётчики попытки входа. Без IP адреса (нет метаданных) считается только аккаунт
+func (l *loginLimiter) keys(email, ipAddress string) []loginKey {
+	return l.withIP(loginKey{
+		key:   "account:" + strings.ToLower(email),
+		limit: l.limits.Account,
+	}, ipAddress)
+}
+
+// twoFactorKeys Счётчики проверки второго фактора: коды перебираются так же, как пароли
+func (l *loginLimiter) twoFactorKeys(userID uuid.UUID, ipAddress string) []loginKey {
+	return l.withIP(loginKey{
+		key:   "2fa:" + userID.String(),
+		limit: l.limits.Account,
+	}, ipAddress)
+}
+
+func (l *loginLimiter) withIP(account loginKey, ipAddress string) []loginKey {
+	keys := []loginKey{account}
+	if ipAddress != "" {
+		keys = append(keys, loginKey{
+			key:   "ip:" + ipAddress,
+			limit: l.limits.IP,
+		})
+	}
+
+	return keys
+}
+
+// loginReservation Попытка, заранее засчитанная неудачей во всех счётчиках
+type loginReservation struct {
+	keys     []loginKey
+	attempts []models.LoginAttempts
+}
+
+// reserve Засчитывает попытку неудачей до проверки пароля или кода: параллельные попытки не проскочат мимо
+// блокировки, которую поставит предыдущая. Если хотя бы один счётчик заблокирован, возвращает TooManyAttemptsError,
+// а уже засчитанное в других счётчиках возвращает обратно
+func (l *loginLimiter) reserve(ctx context.Context, keys []loginKey) (*loginReservation, error) {
+	reservation := &loginReservation{}
+	for _, key := range keys {
+		attempts, ok, err := l.store.Reserve(ctx, key.key, func(failures int) time.Duration {
+			return blockDuration(key.limit, failures)
+		})
+		if err != nil {
+			_ = l.release(ctx, reservation)
+			return nil, err
+		}
+		if !ok {
+			if err = l.release(ctx, reservation); err != nil {
+				return nil, err
+			}
+			return nil, errs.NewTooManyAttemptsError(time.Until(attempts.BlockedUntil))
+		}
+
+		reservation.keys = append(reservation.keys, key)
+		reservation.attempts = append(reservation.attempts, attempts)
+	}
+
+	return reservation, nil
+}
+
+// release Возвращает попытку во все счётчики: проверка не состоялась или оказалась удачной
+func (l *loginLimiter) release(ctx context.Context, reservation *loginReservation) error {
+	for i, key := range reservation.keys {
+		if err := l.store.Refund(ctx, key.key, reservation.attempts[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// succeed Удачный вход обнуляет счётчик аккаунта. В счётчик IP попытка просто возвращается: иначе перебор чужих
+// паролей можно было бы чередовать со входом в свой аккаунт
+func (l *loginLimiter) succeed(ctx context.Context, reservation *loginReservation) error {
+	if err := l.store.Reset(ctx, reservation.keys[0].key); err != nil {
+		return err
+	}
+
+	return l.release(ctx, &loginReservation{
+		keys:     reservation.keys[1:],
+		attempts: reservation.attempts[1:],
+	})
+}
+
+// blockDuration Первые FreeAttempts неудач бесплатны, дальше задержка удваивается с каждой неудачей до MaxDelay.
+// С LockoutAttempts неудач — блокировка на LockoutDuration. Нулевые BaseDelay и LockoutAttempts отключают своё правило
+func blockDuration(limit config.LoginLimitConfig, failures int) time.Duration {
+	if limit.LockoutAttempts > 0 && failures >= limit.LockoutAttempts {
+		return limit.LockoutDuration
+	}
+	if limit.BaseDelay <= 0 || failures <= limit.FreeAttempts {
+		return 0
+	}
+
+	delay := limit.BaseDelay
+	for i := limit.FreeAttempts + 1; i < failures; i++ {
+		delay *= 2
+		if limit.MaxDelay > 0 && delay >= limit.MaxDelay {
+			return limit.MaxDelay
+		}
+	}
+	if limit.MaxDelay > 0 && delay > limit.MaxDelay {
+		return limit.MaxDelay
+	}
+
+	return delay
+}
+
+// PurgeLoginHistory Удаляет счётчики входа с истёкшим окном и записи журнала входов старше AuditRetention.
+// Вызывается по расписанию, чтобы таблицы не росли без ограничений
+func (u *AuthUseсase) PurgeLoginHistory(ctx context.Context) error {
+	if err := u.limiter.store.Purge(ctx); err != nil {
+		return err
+	}
+
+	return u.repo.PurgeLoginAudit(ctx, time.Now().Add(-u.limiter.limits.AuditRetention))
+}
+
+// auditLogin Запись в журнал входов. reason пуст для удачного входа
+func (u *AuthUseсase) auditLogin(ctx context.Context, in dto.LoginReq, userID uuid.NullUUID, reason models.LoginFailureReason) error {
+	return u.repo.SaveLoginAudit(ctx, dto.LoginAuditReq{
+		ID:            uuid.New(),
+		UserID:        userID,
+		Email:         in.Email,
+		IPAddress:     in.IPAddress,
+		Fingerprint:   in.Fingerprint,
+		DeviceName:    in.DeviceName,
+		Success:       reason == "",
+		FailureReason: reason,
+	})
+}
+
+// loginFailed Пишет неудачу в журнал и возвращает исходную ошибку входа. В счётчиках попытка уже засчитана в reserve
+func (u *AuthUseсase) loginFailed(
+	ctx context.Context,
+	in dto.LoginReq,
+	userID uuid.NullUUID,
+	reason models.LoginFailureReason,
+	loginErr error,
+) error {
+	if err := u.auditLogin(ctx, in, userID, reason); err != nil {
+		return err
+	}
+
+	return loginErr
+}
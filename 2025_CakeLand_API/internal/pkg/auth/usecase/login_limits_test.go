@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/auth/mocks"
+	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/utils/password"
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBlockDuration(t *testing.T) {
+	limit := config.LoginLimitConfig{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        10 * time.Second,
+		LockoutAttempts: 10,
+		LockoutDuration: time.Hour,
+	}
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{failures: 1, want: 0},
+		{failures: 3, want: 0},
+		{failures: 4, want: time.Second},
+		{failures: 5, want: 2 * time.Second},
+		{failures: 6, want: 4 * time.Second},
+		{failures: 8, want: 10 * time.Second},
+		{failures: 10, want: time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, blockDuration(limit, tt.failures), "failures: %d", tt.failures)
+	}
+}
+
+func TestAuthUsecase_Login_Throttling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	limits := config.LoginLimitsConfig{
+		Window:  time.Hour,
+		Account: config.LoginLimitConfig{FreeAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour},
+		IP:      config.LoginLimitConfig{FreeAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour},
+	}
+
+	hasher := newTestHasher(t, password.AlgorithmBcrypt)
+	passwordHash, err := hasher.Hash("Password1")
+	assert.NoError(t, err)
+
+	t.Run("Account is blocked after free attempts", func(t *testing.T) {
+		mockRepo := mocks.NewMockIAuthRepository(ctrl)
+		uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Hasher: hasher, LoginLimits: limits})
+
+		mockRepo.EXPECT().
+			GetUserByEmail(gomock.Any(), gomock.Any()).
+			Return(&dto.GetUserByEmailRes{ID: uuid.New(), PasswordHash: passwordHash, EmailVerified: true}, nil).
+			Times(3)
+		var reasons []models.LoginFailureReason
+		mockRepo.EXPECT().
+			SaveLoginAudit(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.LoginAuditReq) error {
+				reasons = append(reasons, in.FailureReason)
+				return nil
+			}).
+			Times(4)
+
+		in := dto.LoginReq{Email: "test@example.com", Password: "Password2", IPAddress: "10.0.0.1"}
+		for i := 0; i < 3; i++ {
+			_, err := uc.Login(context.Background(), in)
+			assert.ErrorIs(t, err, errs.ErrInvalidPassword)
+		}
+
+		// Даже верный пароль не проверяется, пока аккаунт заблокирован
+		in.Password = "Password1"
+		_, err := uc.Login(context.Background(), in)
+		assert.ErrorIs(t, err, errs.ErrTooManyAttempts)
+
+		var tooMany *errs.TooManyAttemptsError
+		assert.ErrorAs(t, err, &tooMany)
+		assert.InDelta(t, time.Minute.Seconds(), tooMany.RetryAfter.Seconds(), 5)
+
+		assert.Equal(t, []models.LoginFailureReason{
+			models.LoginFailureInvalidPassword,
+			models.LoginFailureInvalidPassword,
+			models.LoginFailureInvalidPassword,
+			models.LoginFailureThrottled,
+		}, reasons)
+	})
+
+	t.Run("IP is blocked across accounts", func(t *testing.T) {
+		mockRepo := mocks.NewMockIAuthRepository(ctrl)
+		uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Hasher: hasher, LoginLimits: limits})
+
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(nil, errs.ErrNotFound).Times(4)
+		mockRepo.EXPECT().SaveLoginAudit(gomock.Any(), gomock.Any()).Return(nil).Times(5)
+
+		// Каждый аккаунт пробуют один раз, но все попытки идут с одного адреса
+		for i := 0; i < 4; i++ {
+			_, err := uc.Login(context.Background(), dto.LoginReq{
+				Email:     uuid.NewString() + "@example.com",
+				Password:  "Password1",
+				IPAddress: "10.0.0.2",
+			})
+			assert.ErrorIs(t, err, errs.ErrNotFound)
+		}
+
+		_, err := uc.Login(context.Background(), dto.LoginReq{
+			Email:     "another@example.com",
+			Password:  "Password1",
+			IPAddress: "10.0.0.2",
+		})
+		assert.ErrorIs(t, err, errs.ErrTooManyAttempts)
+	})
+	t.Run("Parallel guesses do not bypass the delay", func(t *testing.T) {
+		mockRepo := mocks.NewMockIAuthRepository(ctrl)
+		uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Hasher: hasher, LoginLimits: limits})
+
+		// Пароль проверяется только в пределах бесплатных попыток и одной, после которой ставится задержка
+		var checked atomic.Int32
+		mockRepo.EXPECT().
+			GetUserByEmail(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, dto.GetUserByEmailReq) (*dto.GetUserByEmailRes, error) {
+				checked.Add(1)
+				return &dto.GetUserByEmailRes{ID: uuid.New(), PasswordHash: passwordHash, EmailVerified: true}, nil
+			}).
+			AnyTimes()
+		mockRepo.EXPECT().SaveLoginAudit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, _ = uc.Login(context.Background(), dto.LoginReq{
+					Email:     "parallel@example.com",
+					Password:  "Password2",
+					IPAddress: fmt.Sprintf("10.0.1.%d", i),
+				})
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(limits.Account.FreeAttempts+1), checked.Load())
+	})
+}
+
+func TestAuthUsecase_PurgeLoginHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	limits := testLoginLimits
+	limits.AuditRetention = 24 * time.Hour
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, LoginLimits: limits})
+
+	mockRepo.EXPECT().
+		PurgeLoginAudit(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, before time.Time) error {
+			assert.WithinDuration(t, time.Now().Add(-limits.AuditRetention), before, time.Minute)
+			return nil
+		})
+
+	assert.NoError(t, uc.PurgeLoginHistory(context.Background()))
+}
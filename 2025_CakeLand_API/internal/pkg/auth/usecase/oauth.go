@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+)
+
+const maxNicknameLength = 50 // VARCHAR(50) в "user"
+
+// OAuthLogin Вход по ID токену провайдера. Неизвестный аккаунт провайдера привязывается к пользователю
+// с той же почтой или становится новым пользователем без пароля
+func (u *AuthUseсase) OAuthLogin(ctx context.Context, in dto.OAuthLoginReq) (*dto.OAuthLoginRes, error) {
+	identity, err := u.oauth.Verify(ctx, in.Provider, in.IDToken, in.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	created := false
+	userID, err := u.repo.GetUserIDByOAuthIdentity(ctx, identity.Provider, identity.Subject)
+	if errors.Is(err, errs.ErrNotFound) {
+		userID, created, err = u.oauthSignUp(ctx, identity, in.Nickname)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Роли берём из бд: у привязанного аккаунта они могли измениться
+	roles, err := u.repo.GetUserRoles(ctx, dto.GetUserRolesReq{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if roles.TwoFactorEnabled {
+		challenge, err := u.twoFactorChallenge(userID)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.OAuthLoginRes{LoginRes: *challenge}, nil
+	}
+
+	tokens, err := u.startSession(ctx, userID, roles.Roles, in.Fingerprint, in.DeviceName, in.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.auditLogin(ctx, dto.LoginReq{
+		Email:       identity.Email,
+		Fingerprint: in.Fingerprint,
+		DeviceName:  in.DeviceName,
+		IPAddress:   in.IPAddress,
+	}, uuid.NullUUID{UUID: userID, Valid: true}, ""); err != nil {
+		return nil, err
+	}
+
+	return &dto.OAuthLoginRes{
+		LoginRes: *tokens,
+		Created:  created,
+	}, nil
+}
+
+// LinkProvider Привязывает аккаунт провайдера к авторизованному пользователю
+func (u *AuthUseсase) LinkProvider(ctx context.Context, in dto.LinkProviderReq) error {
+	identity, err := u.oauth.Verify(ctx, in.Provider, in.IDToken, in.Nonce)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.LinkOAuthIdentity(ctx, newOAuthIdentity(in.UserID, identity))
+}
+
+// UnlinkProvider Отвязывает провайдера, если у пользователя остаётся пароль или другой провайдер
+func (u *AuthUseсase) UnlinkProvider(ctx context.Context, in dto.UnlinkProviderReq) error {
+	return u.repo.UnlinkOAuthIdentity(ctx, in.UserID, in.Provider)
+}
+
+// oauthSignUp Привязывает аккаунт провайдера к пользователю с той же почтой или создаёт нового.
+// Автоматически привязываем, только если почту подтвердили и провайдер, и мы: иначе аккаунт с чужой почтой,
+// заранее созданный злоумышленником, получил бы вход владельца почты
+func (u *AuthUseсase) oauthSignUp(ctx context.Context, identity *models.OAuthIdentity, nickname string) (uuid.UUID, bool, error) {
+	if identity.Email == "" {
+		return uuid.Nil, false, fmt.Errorf("%w: provider did not share email", errs.ErrInvalidInput)
+	}
+	email := strings.ToLower(identity.Email)
+
+	user, err := u.repo.GetUserByEmail(ctx, dto.GetUserByEmailReq{
+		Email: email,
+	})
+	switch {
+	case err == nil:
+		if !identity.EmailVerified || !user.EmailVerified {
+			return uuid.Nil, false, fmt.Errorf("%w: sign in with password and link the provider", errs.ErrAlreadyExists)
+		}
+		if err = u.repo.LinkOAuthIdentity(ctx, newOAuthIdentity(user.ID, identity)); err != nil {
+			return uuid.Nil, false, err
+		}
+		return user.ID, false, nil
+	case !errors.Is(err, errs.ErrNotFound):
+		return uuid.Nil, false, err
+	}
+
+	if nickname == "" {
+		if nickname, err = nicknameFromEmail(email); err != nil {
+			return uuid.Nil, false, err
+		}
+	}
+
+	userID := uuid.New()
+	if err = u.repo.CreateOAuthUser(ctx, dto.CreateOAuthUserReq{
+		UUID:          userID,
+		Email:         email,
+		EmailVerified: identity.EmailVerified,
+		Nickname:      nickname,
+		Roles:         models.DefaultRoles,
+		Identity:      newOAuthIdentity(userID, identity),
+	}); err != nil {
+		return uuid.Nil, false, err
+	}
+
+	return userID, true, nil
+}
+
+func newOAuthIdentity(userID uuid.UUID, identity *models.OAuthIdentity) dto.LinkOAuthIdentityReq {
+	return dto.LinkOAuthIdentityReq{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    strings.ToLower(identity.Email),
+	}
+}
+
+// nicknameFromEmail Ник из имени ящика со случайным суффиксом: ники уникальны
+func nicknameFromEmail(email string) (string, error) {
+	name, _, _ := strings.Cut(email, "@")
+	return randomNickname(name)
+}
+
+// randomNickname Ник из name со случайным суффиксом, обрезанный до длины колонки
+func randomNickname(name string) (string, error) {
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+
+	if maxLength := maxNicknameLength - 1 - hex.EncodedLen(len(suffix)); len(name) > maxLength {
+		name = name[:maxLength]
+	}
+
+	return name + "_" + hex.EncodeToString(suffix), nil
+}
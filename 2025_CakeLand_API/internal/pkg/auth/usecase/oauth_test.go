@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/auth/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestAuthUsecase_OAuthLogin(t *testing.T) {
+	const (
+		idToken     = "id-token"
+		email       = "user@example.com"
+		fingerprint = "some-fingerprint"
+	)
+	identity := &models.OAuthIdentity{
+		Provider:      models.OAuthProviderGoogle,
+		Subject:       "google-subject",
+		Email:         "User@Example.com",
+		EmailVerified: true,
+	}
+	loginReq := dto.OAuthLoginReq{
+		Provider:    models.OAuthProviderGoogle,
+		IDToken:     idToken,
+		Fingerprint: fingerprint,
+	}
+
+	setup := func(t *testing.T) (*AuthUseсase, *mocks.MockIAuthRepository) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIAuthRepository(ctrl)
+		mockVerifier := mocks.NewMockIOAuthVerifier(ctrl)
+		uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, OAuth: mockVerifier})
+
+		mockVerifier.EXPECT().Verify(gomock.Any(), models.OAuthProviderGoogle, idToken, "").Return(identity, nil)
+		return uc, mockRepo
+	}
+	expectSession := func(mockRepo *mocks.MockIAuthRepository, userID uuid.UUID) {
+		mockRepo.EXPECT().
+			GetUserRoles(gomock.Any(), dto.GetUserRolesReq{UserID: userID.String()}).
+			Return(&dto.GetUserRolesRes{Roles: models.DefaultRoles}, nil)
+		mockRepo.EXPECT().
+			CreateSession(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.CreateSessionReq) error {
+				assert.Equal(t, userID.String(), in.UserID)
+				assert.Equal(t, fingerprint, in.Fingerprint)
+				return nil
+			})
+		mockRepo.EXPECT().SaveLoginAudit(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	t.Run("Linked identity", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+		userID := uuid.New()
+
+		mockRepo.EXPECT().GetUserIDByOAuthIdentity(gomock.Any(), identity.Provider, identity.Subject).Return(userID, nil)
+		expectSession(mockRepo, userID)
+
+		res, err := uc.OAuthLogin(context.Background(), loginReq)
+		assert.NoError(t, err)
+		assert.False(t, res.Created)
+		assert.NotEmpty(t, res.AccessToken)
+		assert.NotEmpty(t, res.RefreshToken)
+	})
+
+	t.Run("New user", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+		var userID uuid.UUID
+
+		mockRepo.EXPECT().GetUserIDByOAuthIdentity(gomock.Any(), identity.Provider, identity.Subject).Return(uuid.Nil, errs.ErrNotFound)
+		mockRepo.EXPECT().GetUserByEmail(gomock.Any(), dto.GetUserByEmailReq{Email: email}).Return(nil, errs.ErrNotFound)
+		mockRepo.EXPECT().
+			CreateOAuthUser(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.CreateOAuthUserReq) error {
+				userID = in.UUID
+				assert.Equal(t, email, in.Email)
+				assert.True(t, in.EmailVerified)
+				assert.True(t, strings.HasPrefix(in.Nickname, "user_"))
+				assert.Equal(t, in.UUID, in.Identity.UserID)
+				assert.Equal(t, identity.Subject, in.Identity.Subject)
+				return nil
+			})
+		mockRepo.EXPECT().
+			GetUserRoles(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.GetUserRolesReq) (*dto.GetUserRolesRes, error) {
+				assert.Equal(t, userID.String(), in.UserID)
+				return &dto.GetUserRolesRes{Roles: models.DefaultRoles}, nil
+			})
+		mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepo.EXPECT().SaveLoginAudit(gomock.Any(), gomock.Any()).Return(nil)
+
+		res, err := uc.OAuthLogin(context.Background(), loginReq)
+		assert.NoError(t, err)
+		assert.True(t, res.Created)
+	})
+
+	t.Run("Links account with verified email", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+		userID := uuid.New()
+
+		mockRepo.EXPECT().GetUserIDByOAuthIdentity(gomock.Any(), identity.Provider, identity.Subject).Return(uuid.Nil, errs.ErrNotFound)
+		mockRepo.EXPECT().
+			GetUserByEmail(gomock.Any(), dto.GetUserByEmailReq{Email: email}).
+			Return(&dto.GetUserByEmailRes{ID: userID, Email: email, EmailVerified: true}, nil)
+		mockRepo.EXPECT().
+			LinkOAuthIdentity(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.LinkOAuthIdentityReq) error {
+				assert.Equal(t, userID, in.UserID)
+				assert.Equal(t, models.OAuthProviderGoogle, in.Provider)
+				return nil
+			})
+		expectSession(mockRepo, userID)
+
+		res, err := uc.OAuthLogin(context.Background(), loginReq)
+		assert.NoError(t, err)
+		assert.False(t, res.Created)
+	})
+
+	t.Run("Does not link account with unverified email", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+
+		mockRepo.EXPECT().GetUserIDByOAuthIdentity(gomock.Any(), identity.Provider, identity.Subject).Return(uuid.Nil, errs.ErrNotFound)
+		mockRepo.EXPECT().
+			GetUserByEmail(gomock.Any(), dto.GetUserByEmailReq{Email: email}).
+			Return(&dto.GetUserByEmailRes{ID: uuid.New(), Email: email}, nil)
+
+		res, err := uc.OAuthLogin(context.Background(), loginReq)
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+		assert.Nil(t, res)
+	})
+}
+
+func TestNicknameFromEmail(t *testing.T) {
+	nickname, err := nicknameFromEmail(strings.Repeat("a", 80) + "@example.com")
+	assert.NoError(t, err)
+	assert.Len(t, nickname, maxNicknameLength)
+}
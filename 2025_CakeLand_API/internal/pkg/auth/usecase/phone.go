@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/sms"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// phoneNickname Основа ника пользователя, вошедшего по телефону: номер в нике не показываем
+const phoneNickname = "user"
+
+// RequestPhoneCode Отправляет код входа по SMS. Номер может быть незнакомым: по коду создаётся новый аккаунт.
+// Новый код на тот же номер — не раньше ResendCooldown и не больше MaxCodesPerDay за сутки,
+// число кодов с одного IP ограничено MaxCodesPerIP
+func (u *AuthUseсase) RequestPhoneCode(ctx context.Context, in dto.RequestPhoneCodeReq) error {
+	// Код с IP засчитывается заранее: параллельные запросы не превысят MaxCodesPerIP
+	var reservation *loginReservation
+	if in.IPAddress != "" && u.phoneAuth.MaxCodesPerIP > 0 {
+		var err error
+		if reservation, err = u.limiter.reserve(ctx, []loginKey{u.phoneCodeIPKey(in.IPAddress)}); err != nil {
+			return err
+		}
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return u.releasePhoneCode(ctx, reservation, err)
+	}
+
+	res, err := u.repo.CreatePhoneCode(ctx, dto.CreatePhoneCodeReq{
+		Phone:          in.Phone,
+		CodeHash:       hashPhoneCode(in.Phone, code),
+		ExpiresAt:      time.Now().Add(u.phoneAuth.CodeTTL),
+		ResendCooldown: u.phoneAuth.ResendCooldown,
+		MaxPerDay:      u.phoneAuth.MaxCodesPerDay,
+		MaxAttempts:    u.phoneAuth.MaxAttempts,
+	})
+	if err != nil {
+		return u.releasePhoneCode(ctx, reservation, err)
+	}
+	if !res.Created {
+		return u.releasePhoneCode(ctx, reservation, errs.NewTooManyAttemptsError(time.Until(res.RetryAt)))
+	}
+
+	return u.sms.Send(ctx, sms.Message{
+		To:   in.Phone,
+		Text: fmt.Sprintf("Код входа в CakeLand: %s. Никому его не сообщайте", code),
+	})
+}
+
+// VerifyPhoneCode Вход по коду из SMS. Для номера, который ещё никто не подтвердил, создаётся пользователь
+// без почты и пароля
+func (u *AuthUseсase) VerifyPhoneCode(ctx context.Context, in dto.VerifyPhoneCodeReq) (*dto.VerifyPhoneCodeRes, error) {
+	if err := u.usePhoneCode(ctx, in.Phone, in.Code); err != nil {
+		if errors.Is(err, errs.ErrInvalidCode) {
+			if auditErr := u.auditPhoneLogin(ctx, in, uuid.NullUUID{}, models.LoginFailureInvalidCode); auditErr != nil {
+				return nil, auditErr
+			}
+		}
+		return nil, err
+	}
+
+	created := false
+	var (
+		userID uuid.UUID
+		roles  models.Roles
+	)
+	user, err := u.repo.GetUserByPhone(ctx, in.Phone)
+	switch {
+	case err == nil:
+		// Код из SMS — не второй фактор: с включённой 2FA нужен ещё код из приложения
+		if user.TwoFactorEnabled {
+			challenge, err := u.twoFactorChallenge(user.ID)
+			if err != nil {
+				return nil, err
+			}
+			return &dto.VerifyPhoneCodeRes{LoginRes: *challenge}, nil
+		}
+		userID, roles = user.ID, user.Roles
+	case errors.Is(err, errs.ErrNotFound):
+		if userID, err = u.phoneSignUp(ctx, in.Phone, in.Nickname); err != nil {
+			return nil, err
+		}
+		roles, created = models.DefaultRoles, true
+	default:
+		return nil, err
+	}
+
+	tokens, err := u.startSession(ctx, userID, roles, in.Fingerprint, in.DeviceName, in.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.auditPhoneLogin(ctx, in, uuid.NullUUID{UUID: userID, Valid: true}, ""); err != nil {
+		return nil, err
+	}
+
+	return &dto.VerifyPhoneCodeRes{
+		LoginRes: *tokens,
+		Created:  created,
+	}, nil
+}
+
+// phoneSignUp Создаёт пользователя с подтверждённым номером. Ник берётся из запроса или генерируется
+func (u *AuthUseсase) phoneSignUp(ctx context.Context, phone, nickname string) (uuid.UUID, error) {
+	if nickname == "" {
+		var err error
+		if nickname, err = randomNickname(phoneNickname); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	userID := uuid.New()
+	if err := u.repo.CreatePhoneUser(ctx, dto.CreatePhoneUserReq{
+		UUID:     userID,
+		Phone:    phone,
+		Nickname: nickname,
+		Roles:    models.DefaultRoles,
+	}); err != nil {
+		return uuid.Nil, err
+	}
+
+	return userID, nil
+}
+
+// usePhoneCode Проверяет и гасит код из SMS. Каждая проверка расходует попытку, после MaxAttempts коды номера
+// не принимаются до конца суток
+func (u *AuthUseсase) usePhoneCode(ctx context.Context, phone, code string) error {
+	phoneCode, err := u.repo.SpendPhoneCodeAttempt(ctx, phone, u.phoneAuth.MaxAttempts)
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.ErrInvalidCode
+	} else if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(phoneCode.CodeHash), []byte(hashPhoneCode(phone, code))) != 1 {
+		return errs.ErrInvalidCode
+	}
+
+	// Код одноразовый: если его уже погасил параллельный запрос, второй не проходит
+	used, err := u.repo.DeletePhoneCode(ctx, phone, phoneCode.CodeHash)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errs.ErrInvalidCode
+	}
+
+	return nil
+}
+
+// auditPhoneLogin Запись входа по телефону в журнал входов. reason пуст для удачного входа
+func (u *AuthUseсase) auditPhoneLogin(ctx context.Context, in dto.VerifyPhoneCodeReq, userID uuid.NullUUID, reason models.LoginFailureReason) error {
+	return u.repo.SaveLoginAudit(ctx, dto.LoginAuditReq{
+		ID:            uuid.New(),
+		UserID:        userID,
+		Phone:         in.Phone,
+		IPAddress:     in.IPAddress,
+		Fingerprint:   in.Fingerprint,
+		DeviceName:    in.DeviceName,
+		Success:       reason == "",
+		FailureReason: reason,
+	})
+}
+
+// phoneCodeIPKey Счётчик отправленных кодов с IP адреса: после MaxCodesPerIP кодов IP блокируется до конца окна
+func (u *AuthUseсase) phoneCodeIPKey(ipAddress string) loginKey {
+	return loginKey{
+		key: "phone-code-ip:" + ipAddress,
+		limit: config.LoginLimitConfig{
+			LockoutAttempts: u.phoneAuth.MaxCodesPerIP,
+			LockoutDuration: u.limiter.limits.Window,
+		},
+	}
+}
+
+// releasePhoneCode Код не отправлен: возвращаем его в счётчик IP
+func (u *AuthUseсase) releasePhoneCode(ctx context.Context, reservation *loginReservation, err error) error {
+	if reservation != nil {
+		if releaseErr := u.limiter.release(ctx, reservation); releaseErr != nil {
+			return releaseErr
+		}
+	}
+
+	return err
+}
+
+// hashPhoneCode Код короткий, поэтому хэшируем вместе с номером: одинаковые коды разных номеров не совпадают по хэшу
+func hashPhoneCode(phone, code string) string {
+	sum := sha256.Sum256([]byte(phone + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/auth/mocks"
+	"2025_CakeLand_API/internal/pkg/sms"
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testPhone     = "79991234567"
+	testIPAddress = "10.0.0.1"
+)
+
+func newPhoneUsecase(t *testing.T) (*AuthUseсase, *mocks.MockIAuthRepository, *sms.MemorySender) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	sender := sms.NewMemorySender()
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, SMS: sender})
+
+	return uc, mockRepo, sender
+}
+
+func TestAuthUsecase_RequestPhoneCode(t *testing.T) {
+	req := dto.RequestPhoneCodeReq{Phone: testPhone, IPAddress: testIPAddress}
+
+	t.Run("Sends hashed code", func(t *testing.T) {
+		uc, mockRepo, sender := newPhoneUsecase(t)
+
+		var codeHash string
+		mockRepo.EXPECT().
+			CreatePhoneCode(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.CreatePhoneCodeReq) (*dto.CreatePhoneCodeRes, error) {
+				assert.Equal(t, testPhone, in.Phone)
+				assert.Equal(t, testPhoneAuth.ResendCooldown, in.ResendCooldown)
+				assert.Equal(t, testPhoneAuth.MaxCodesPerDay, in.MaxPerDay)
+				assert.Equal(t, testPhoneAuth.MaxAttempts, in.MaxAttempts)
+				assert.WithinDuration(t, time.Now().Add(testPhoneAuth.CodeTTL), in.ExpiresAt, time.Second)
+				codeHash = in.CodeHash
+				return &dto.CreatePhoneCodeRes{Created: true}, nil
+			})
+
+		assert.NoError(t, uc.RequestPhoneCode(context.Background(), req))
+		msg, ok := sender.Last(testPhone)
+		assert.True(t, ok)
+		code := codeRegexp.FindString(msg.Text)
+		assert.NotEmpty(t, code)
+		assert.Equal(t, hashPhoneCode(testPhone, code), codeHash)
+	})
+
+	t.Run("Resend cooldown", func(t *testing.T) {
+		uc, mockRepo, sender := newPhoneUsecase(t)
+
+		mockRepo.EXPECT().
+			CreatePhoneCode(gomock.Any(), gomock.Any()).
+			Return(&dto.CreatePhoneCodeRes{RetryAt: time.Now().Add(40 * time.Second)}, nil)
+
+		err := uc.RequestPhoneCode(context.Background(), req)
+		var tooManyAttempts *errs.TooManyAttemptsError
+		assert.ErrorAs(t, err, &tooManyAttempts)
+		assert.InDelta(t, 40*time.Second, tooManyAttempts.RetryAfter, float64(time.Second))
+		assert.Empty(t, sender.Messages())
+	})
+
+	t.Run("Codes per IP are limited", func(t *testing.T) {
+		uc, mockRepo, sender := newPhoneUsecase(t)
+
+		mockRepo.EXPECT().
+			CreatePhoneCode(gomock.Any(), gomock.Any()).
+			Return(&dto.CreatePhoneCodeRes{Created: true}, nil).
+			Times(testPhoneAuth.MaxCodesPerIP)
+
+		for i := 0; i < testPhoneAuth.MaxCodesPerIP; i++ {
+			assert.NoError(t, uc.RequestPhoneCode(context.Background(), dto.RequestPhoneCodeReq{
+				Phone:     fmt.Sprintf("7999000000%d", i),
+				IPAddress: testIPAddress,
+			}))
+		}
+
+		err := uc.RequestPhoneCode(context.Background(), req)
+		assert.ErrorIs(t, err, errs.ErrTooManyAttempts)
+		assert.Len(t, sender.Messages(), testPhoneAuth.MaxCodesPerIP)
+	})
+}
+
+func TestAuthUsecase_VerifyPhoneCode(t *testing.T) {
+	const (
+		code        = "123456"
+		fingerprint = "some-fingerprint"
+	)
+	codeHash := hashPhoneCode(testPhone, code)
+	req := dto.VerifyPhoneCodeReq{
+		Phone:       testPhone,
+		Code:        code,
+		Fingerprint: fingerprint,
+		IPAddress:   testIPAddress,
+	}
+
+	expectCode := func(mockRepo *mocks.MockIAuthRepository) {
+		mockRepo.EXPECT().SpendPhoneCodeAttempt(gomock.Any(), testPhone, testPhoneAuth.MaxAttempts).Return(&dto.PhoneCodeDB{CodeHash: codeHash}, nil)
+		mockRepo.EXPECT().DeletePhoneCode(gomock.Any(), testPhone, codeHash).Return(true, nil)
+	}
+	expectAudit := func(mockRepo *mocks.MockIAuthRepository, reason models.LoginFailureReason) {
+		mockRepo.EXPECT().
+			SaveLoginAudit(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.LoginAuditReq) error {
+				assert.Equal(t, testPhone, in.Phone)
+				assert.Empty(t, in.Email)
+				assert.Equal(t, reason, in.FailureReason)
+				return nil
+			})
+	}
+
+	t.Run("Existing user", func(t *testing.T) {
+		uc, mockRepo, _ := newPhoneUsecase(t)
+		userID := uuid.New()
+
+		expectCode(mockRepo)
+		mockRepo.EXPECT().
+			GetUserByPhone(gomock.Any(), testPhone).
+			Return(&dto.GetUserByPhoneRes{ID: userID, Roles: models.Roles{models.RoleAdmin}}, nil)
+		mockRepo.EXPECT().
+			CreateSession(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.CreateSessionReq) error {
+				assert.Equal(t, userID.String(), in.UserID)
+				assert.Equal(t, fingerprint, in.Fingerprint)
+				return nil
+			})
+		expectAudit(mockRepo, "")
+
+		res, err := uc.VerifyPhoneCode(context.Background(), req)
+		assert.NoError(t, err)
+		assert.False(t, res.Created)
+		assert.NotEmpty(t, res.AccessToken)
+		assert.NotEmpty(t, res.RefreshToken)
+	})
+
+	t.Run("New user", func(t *testing.T) {
+		uc, mockRepo, _ := newPhoneUsecase(t)
+
+		expectCode(mockRepo)
+		mockRepo.EXPECT().GetUserByPhone(gomock.Any(), testPhone).Return(nil, errs.ErrNotFound)
+		mockRepo.EXPECT().
+			CreatePhoneUser(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.CreatePhoneUserReq) error {
+				assert.Equal(t, testPhone, in.Phone)
+				assert.True(t, strings.HasPrefix(in.Nickname, "user_"))
+				assert.Equal(t, models.DefaultRoles, in.Roles)
+				return nil
+			})
+		mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+		expectAudit(mockRepo, "")
+
+		res, err := uc.VerifyPhoneCode(context.Background(), req)
+		assert.NoError(t, err)
+		assert.True(t, res.Created)
+	})
+
+	t.Run("Wrong code spends an attempt", func(t *testing.T) {
+		uc, mockRepo, _ := newPhoneUsecase(t)
+
+		mockRepo.EXPECT().SpendPhoneCodeAttempt(gomock.Any(), testPhone, testPhoneAuth.MaxAttempts).Return(&dto.PhoneCodeDB{CodeHash: codeHash}, nil)
+		expectAudit(mockRepo, models.LoginFailureInvalidCode)
+
+		wrong := req
+		wrong.Code = "654321"
+		res, err := uc.VerifyPhoneCode(context.Background(), wrong)
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Code burns after too many attempts", func(t *testing.T) {
+		uc, mockRepo, _ := newPhoneUsecase(t)
+
+		// Попытки кончились: repo не отдаёт код даже для верного ввода
+		mockRepo.EXPECT().SpendPhoneCodeAttempt(gomock.Any(), testPhone, testPhoneAuth.MaxAttempts).Return(nil, errs.ErrNotFound)
+		expectAudit(mockRepo, models.LoginFailureInvalidCode)
+
+		_, err := uc.VerifyPhoneCode(context.Background(), req)
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+
+	t.Run("Code is single use", func(t *testing.T) {
+		uc, mockRepo, _ := newPhoneUsecase(t)
+
+		mockRepo.EXPECT().SpendPhoneCodeAttempt(gomock.Any(), testPhone, testPhoneAuth.MaxAttempts).Return(&dto.PhoneCodeDB{CodeHash: codeHash}, nil)
+		mockRepo.EXPECT().DeletePhoneCode(gomock.Any(), testPhone, codeHash).Return(false, nil)
+		expectAudit(mockRepo, models.LoginFailureInvalidCode)
+
+		_, err := uc.VerifyPhoneCode(context.Background(), req)
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/utils/totp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+)
+
+const (
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 6 // 48 бит, в base32 — 10 символов
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// LoginTwoFactor Второй шаг входа с 2FA: challenge токен из Login и код из приложения или код восстановления
+func (u *AuthUseсase) LoginTwoFactor(ctx context.Context, in dto.LoginTwoFactorReq) (*dto.LoginRes, error) {
+	rawUserID, err := u.tokenator.ParseChallengeToken(in.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return nil, errs.ErrInvalidTokenOrClaims
+	}
+
+	if err = u.checkSecondFactor(ctx, userID, in.IPAddress, in.Code); err != nil {
+		// 2FA отключили между шагами: challenge больше не действует, входим заново
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.ErrInvalidTokenOrClaims
+		}
+		if errors.Is(err, errs.ErrInvalidCode) || errors.Is(err, errs.ErrTooManyAttempts) {
+			reason := models.LoginFailureInvalidCode
+			if errors.Is(err, errs.ErrTooManyAttempts) {
+				reason = models.LoginFailureThrottled
+			}
+			if auditErr := u.auditTwoFactor(ctx, in, userID, reason); auditErr != nil {
+				return nil, auditErr
+			}
+		}
+		return nil, err
+	}
+
+	// Роли берём из бд: за время второго шага они могли измениться
+	roles, err := u.repo.GetUserRoles(ctx, dto.GetUserRolesReq{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := u.startSession(ctx, userID, roles.Roles, in.Fingerprint, in.DeviceName, in.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.auditTwoFactor(ctx, in, userID, ""); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// EnrollTOTP Начинает подключение 2FA: новый секрет заменяет неподтверждённый. 2FA включится после VerifyTOTP
+func (u *AuthUseсase) EnrollTOTP(ctx context.Context, userID uuid.UUID) (*dto.EnrollTOTPRes, error) {
+	account, err := u.repo.GetTOTPAccountName(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := u.totp.NewSecret(account)
+	if err != nil {
+		return nil, err
+	}
+	if err = u.repo.SaveTOTPSecret(ctx, userID, secret.Sealed); err != nil {
+		return nil, err
+	}
+
+	return &dto.EnrollTOTPRes{
+		Secret: secret.Plain,
+		URI:    secret.URI,
+	}, nil
+}
+
+// VerifyTOTP Подтверждает подключение кодом из приложения, включает 2FA и выдаёт коды восстановления
+func (u *AuthUseсase) VerifyTOTP(ctx context.Context, in dto.VerifyTOTPReq) (*dto.VerifyTOTPRes, error) {
+	secret, err := u.repo.GetTOTP(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if secret.Enabled {
+		return nil, errs.ErrAlreadyExists
+	}
+
+	step, ok, err := u.totp.Validate(secret.Secret, in.Code, secret.LastUsedStep)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errs.ErrInvalidCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes(in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err = u.repo.EnableTOTP(ctx, dto.EnableTOTPReq{
+		UserID:             in.UserID,
+		Secret:             secret.Secret,
+		Step:               step,
+		RecoveryCodeHashes: hashes,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &dto.VerifyTOTPRes{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// DisableTOTP Отключает 2FA. Одного access токена мало: нужен код из приложения или код восстановления
+func (u *AuthUseсase) DisableTOTP(ctx context.Context, in dto.DisableTOTPReq) error {
+	if err := u.checkSecondFactor(ctx, in.UserID, in.IPAddress, in.Code); err != nil {
+		return err
+	}
+
+	return u.repo.DeleteTOTP(ctx, in.UserID)
+}
+
+// twoFactorChallenge Вместо пары токенов выдаёт challenge токен для LoginTwoFactor
+func (u *AuthUseсase) twoFactorChallenge(userID uuid.UUID) (*dto.LoginRes, error) {
+	challenge, err := u.tokenator.GenerateChallengeToken(userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LoginRes{
+		ChallengeToken: challenge.Token,
+		ExpiresIn:      challenge.ExpiresIn,
+	}, nil
+}
+
+// checkSecondFactor Проверяет и гасит код из приложения или код восстановления.
+// Попытки считаются в счётчиках входа: перебор кодов блокируется так же, как перебор паролей
+func (u *AuthUseсase) checkSecondFactor(ctx context.Context, userID uuid.UUID, ipAddress, code string) error {
+	reservation, err := u.limiter.reserve(ctx, u.limiter.twoFactorKeys(userID, ipAddress))
+	if err != nil {
+		return err
+	}
+
+	secret, err := u.repo.GetTOTP(ctx, userID)
+	if errors.Is(err, errs.ErrNotFound) || (err == nil && !secret.Enabled) {
+		_ = u.limiter.release(ctx, reservation)
+		return errs.ErrNotFound
+	} else if err != nil {
+		_ = u.limiter.release(ctx, reservation)
+		return err
+	}
+
+	ok, err := u.useSecondFactor(ctx, userID, secret, code)
+	if err != nil {
+		_ = u.limiter.release(ctx, reservation)
+		return err
+	}
+	if !ok {
+		return errs.ErrInvalidCode
+	}
+
+	return u.limiter.succeed(ctx, reservation)
+}
+
+// useSecondFactor Код из шести цифр проверяется как TOTP, остальное — как код восстановления
+func (u *AuthUseсase) useSecondFactor(ctx context.Context, userID uuid.UUID, secret *dto.TOTPDB, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if !isTOTPCode(code) {
+		return u.repo.UseRecoveryCode(ctx, userID, hashRecoveryCode(userID, code))
+	}
+
+	step, ok, err := u.totp.Validate(secret.Secret, code, secret.LastUsedStep)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// Тот же код мог только что принять параллельный запрос
+	return u.repo.UseTOTPStep(ctx, userID, step)
+}
+
+// auditTwoFactor Запись второго шага входа в журнал входов. reason пуст для удачного входа
+func (u *AuthUseсase) auditTwoFactor(ctx context.Context, in dto.LoginTwoFactorReq, userID uuid.UUID, reason models.LoginFailureReason) error {
+	return u.repo.SaveLoginAudit(ctx, dto.LoginAuditReq{
+		ID:            uuid.New(),
+		UserID:        uuid.NullUUID{UUID: userID, Valid: true},
+		IPAddress:     in.IPAddress,
+		Fingerprint:   in.Fingerprint,
+		DeviceName:    in.DeviceName,
+		Success:       reason == "",
+		FailureReason: reason,
+	})
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateRecoveryCodes Коды вида abcde-fghij для пользователя и их хэши для бд
+func generateRecoveryCodes(userID uuid.UUID) ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		raw := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(raw))
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashRecoveryCode(userID, code))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode Регистр, пробелы и дефис в коде не важны. Хэшируем вместе с userID, как и коды из писем
+func hashRecoveryCode(userID uuid.UUID, code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(userID.String() + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,346 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/auth/mocks"
+	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/password"
+	"2025_CakeLand_API/internal/pkg/utils/totp"
+	"context"
+	"encoding/base32"
+	"encoding/base64"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+type twoFactorTest struct {
+	uc            *AuthUseсase
+	repo          *mocks.MockIAuthRepository
+	tokenator     *jwt.Tokenator
+	authenticator *totp.Authenticator
+	hasher        *password.Hasher
+}
+
+func newTwoFactorUsecase(t *testing.T) *twoFactorTest {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	tokenator := jwt.NewTokenator()
+	hasher := newTestHasher(t, password.AlgorithmBcrypt)
+
+	authenticator, err := totp.NewAuthenticator(&config.TwoFactorConfig{
+		Issuer:        "CakeLand",
+		EncryptionKey: base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))),
+	})
+	require.NoError(t, err)
+
+	return &twoFactorTest{
+		uc:            newTestUsecase(t, AuthDeps{Tokenator: tokenator, Repo: mockRepo, Hasher: hasher, TOTP: authenticator}),
+		repo:          mockRepo,
+		tokenator:     tokenator,
+		authenticator: authenticator,
+		hasher:        hasher,
+	}
+}
+
+// enrolledSecret Включённый секрет пользователя: зашифрованный для бд и открытый для генерации кодов
+func (tt *twoFactorTest) enrolledSecret(t *testing.T) (*dto.TOTPDB, []byte) {
+	secret, err := tt.authenticator.NewSecret("user@example.com")
+	require.NoError(t, err)
+	plain, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret.Plain)
+	require.NoError(t, err)
+
+	return &dto.TOTPDB{Secret: secret.Sealed, Enabled: true}, plain
+}
+
+func TestAuthUsecase_Login_TwoFactor(t *testing.T) {
+	tt := newTwoFactorUsecase(t)
+	userID := uuid.New()
+
+	passwordHash, err := tt.hasher.Hash("Password1")
+	require.NoError(t, err)
+	tt.repo.EXPECT().
+		GetUserByEmail(gomock.Any(), gomock.Any()).
+		Return(&dto.GetUserByEmailRes{
+			ID:               userID,
+			PasswordHash:     passwordHash,
+			EmailVerified:    true,
+			TwoFactorEnabled: true,
+		}, nil)
+
+	// Ни сессии, ни записи об успешном входе до второго шага
+	res, err := tt.uc.Login(context.Background(), dto.LoginReq{
+		Email:       "test@example.com",
+		Password:    "Password1",
+		Fingerprint: "some-fingerprint",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.AccessToken)
+	assert.Empty(t, res.RefreshToken)
+	assert.NotEmpty(t, res.ChallengeToken)
+
+	challengeUserID, err := tt.tokenator.ParseChallengeToken(res.ChallengeToken)
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), challengeUserID)
+}
+
+func TestAuthUsecase_LoginTwoFactor(t *testing.T) {
+	const fingerprint = "some-fingerprint"
+	userID := uuid.New()
+
+	newReq := func(t *testing.T, tt *twoFactorTest, code string) dto.LoginTwoFactorReq {
+		challenge, err := tt.tokenator.GenerateChallengeToken(userID.String())
+		require.NoError(t, err)
+		return dto.LoginTwoFactorReq{
+			ChallengeToken: challenge.Token,
+			Code:           code,
+			Fingerprint:    fingerprint,
+			IPAddress:      testIPAddress,
+		}
+	}
+	expectAudit := func(mockRepo *mocks.MockIAuthRepository, reason models.LoginFailureReason) {
+		mockRepo.EXPECT().
+			SaveLoginAudit(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.LoginAuditReq) error {
+				assert.Equal(t, userID, in.UserID.UUID)
+				assert.Equal(t, reason == "", in.Success)
+				assert.Equal(t, reason, in.FailureReason)
+				return nil
+			})
+	}
+	expectSession := func(mockRepo *mocks.MockIAuthRepository) {
+		mockRepo.EXPECT().
+			GetUserRoles(gomock.Any(), dto.GetUserRolesReq{UserID: userID.String()}).
+			Return(&dto.GetUserRolesRes{Roles: models.DefaultRoles, TwoFactorEnabled: true}, nil)
+		mockRepo.EXPECT().
+			CreateSession(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.CreateSessionReq) error {
+				assert.Equal(t, userID.String(), in.UserID)
+				assert.Equal(t, fingerprint, in.Fingerprint)
+				return nil
+			})
+	}
+
+	t.Run("TOTP code", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, plain := tt.enrolledSecret(t)
+		step := totp.Step(time.Now())
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil)
+		tt.repo.EXPECT().UseTOTPStep(gomock.Any(), userID, step).Return(true, nil)
+		expectSession(tt.repo)
+		expectAudit(tt.repo, "")
+
+		res, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, totp.Code(plain, step)))
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.AccessToken)
+		assert.NotEmpty(t, res.RefreshToken)
+		assert.Empty(t, res.ChallengeToken)
+	})
+
+	t.Run("Recovery code", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, _ := tt.enrolledSecret(t)
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil)
+		tt.repo.EXPECT().
+			UseRecoveryCode(gomock.Any(), userID, hashRecoveryCode(userID, "abcdefghij")).
+			Return(true, nil)
+		expectSession(tt.repo)
+		expectAudit(tt.repo, "")
+
+		_, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, "ABCDE-FGHIJ"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Wrong code", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, plain := tt.enrolledSecret(t)
+		code := totp.Code(plain, totp.Step(time.Now())-5)
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil)
+		expectAudit(tt.repo, models.LoginFailureInvalidCode)
+
+		res, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, code))
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Code already used", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, plain := tt.enrolledSecret(t)
+		step := totp.Step(time.Now())
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil)
+		tt.repo.EXPECT().UseTOTPStep(gomock.Any(), userID, step).Return(false, nil)
+		expectAudit(tt.repo, models.LoginFailureInvalidCode)
+
+		_, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, totp.Code(plain, step)))
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+
+	t.Run("Too many wrong codes", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, _ := tt.enrolledSecret(t)
+
+		// Первые FreeAttempts неудач бесплатны, следующая включает задержку
+		failures := testLoginLimits.Account.FreeAttempts + 1
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil).Times(failures)
+		tt.repo.EXPECT().UseRecoveryCode(gomock.Any(), userID, gomock.Any()).Return(false, nil).Times(failures)
+		tt.repo.EXPECT().SaveLoginAudit(gomock.Any(), gomock.Any()).Return(nil).Times(failures)
+		for i := 0; i < failures; i++ {
+			_, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, "wrong-code"))
+			assert.ErrorIs(t, err, errs.ErrInvalidCode)
+		}
+
+		// Дальше код даже не проверяется
+		expectAudit(tt.repo, models.LoginFailureThrottled)
+		_, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, "wrong-code"))
+		assert.ErrorIs(t, err, errs.ErrTooManyAttempts)
+	})
+
+	t.Run("Two factor disabled", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(nil, errs.ErrNotFound)
+
+		_, err := tt.uc.LoginTwoFactor(context.Background(), newReq(t, tt, "123456"))
+		assert.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+	})
+
+	t.Run("Not a challenge token", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+
+		refreshToken, err := tt.tokenator.GenerateRefreshToken(userID.String(), uuid.NewString())
+		require.NoError(t, err)
+
+		_, err = tt.uc.LoginTwoFactor(context.Background(), dto.LoginTwoFactorReq{
+			ChallengeToken: refreshToken.Token,
+			Code:           "123456",
+		})
+		assert.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+	})
+}
+
+func TestAuthUsecase_EnrollAndVerifyTOTP(t *testing.T) {
+	tt := newTwoFactorUsecase(t)
+	userID := uuid.New()
+
+	var sealed []byte
+	tt.repo.EXPECT().GetTOTPAccountName(gomock.Any(), userID).Return("user@example.com", nil)
+	tt.repo.EXPECT().
+		SaveTOTPSecret(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, secret []byte) error {
+			sealed = secret
+			return nil
+		})
+
+	enrolled, err := tt.uc.EnrollTOTP(context.Background(), userID)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(enrolled.URI, "otpauth://totp/CakeLand:user@example.com?"))
+	assert.Contains(t, enrolled.URI, "secret="+enrolled.Secret)
+
+	plain, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrolled.Secret)
+	require.NoError(t, err)
+	step := totp.Step(time.Now())
+
+	t.Run("Wrong code", func(t *testing.T) {
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(&dto.TOTPDB{Secret: sealed}, nil)
+
+		_, err := tt.uc.VerifyTOTP(context.Background(), dto.VerifyTOTPReq{
+			UserID: userID,
+			Code:   totp.Code(plain, step-5),
+		})
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+
+	t.Run("Valid code", func(t *testing.T) {
+		var hashes []string
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(&dto.TOTPDB{Secret: sealed}, nil)
+		tt.repo.EXPECT().
+			EnableTOTP(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.EnableTOTPReq) error {
+				assert.Equal(t, userID, in.UserID)
+				assert.Equal(t, sealed, in.Secret)
+				assert.Equal(t, step, in.Step)
+				hashes = in.RecoveryCodeHashes
+				return nil
+			})
+
+		res, err := tt.uc.VerifyTOTP(context.Background(), dto.VerifyTOTPReq{
+			UserID: userID,
+			Code:   totp.Code(plain, step),
+		})
+		require.NoError(t, err)
+		require.Len(t, res.RecoveryCodes, recoveryCodeCount)
+
+		// В бд только хэши, и по ним находится каждый выданный код
+		for i, code := range res.RecoveryCodes {
+			assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+			assert.Equal(t, hashRecoveryCode(userID, code), hashes[i])
+			assert.NotContains(t, hashes, code)
+		}
+	})
+
+	t.Run("Already enabled", func(t *testing.T) {
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(&dto.TOTPDB{Secret: sealed, Enabled: true}, nil)
+
+		_, err := tt.uc.VerifyTOTP(context.Background(), dto.VerifyTOTPReq{
+			UserID: userID,
+			Code:   totp.Code(plain, step),
+		})
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+}
+
+func TestAuthUsecase_DisableTOTP(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("Valid code", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, plain := tt.enrolledSecret(t)
+		step := totp.Step(time.Now())
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil)
+		tt.repo.EXPECT().UseTOTPStep(gomock.Any(), userID, step).Return(true, nil)
+		tt.repo.EXPECT().DeleteTOTP(gomock.Any(), userID).Return(nil)
+
+		assert.NoError(t, tt.uc.DisableTOTP(context.Background(), dto.DisableTOTPReq{
+			UserID: userID,
+			Code:   totp.Code(plain, step),
+		}))
+	})
+
+	t.Run("Wrong code", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+		secret, _ := tt.enrolledSecret(t)
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(secret, nil)
+		tt.repo.EXPECT().UseRecoveryCode(gomock.Any(), userID, gomock.Any()).Return(false, nil)
+
+		err := tt.uc.DisableTOTP(context.Background(), dto.DisableTOTPReq{
+			UserID: userID,
+			Code:   "abcde-fghij",
+		})
+		assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	})
+
+	t.Run("Not enabled", func(t *testing.T) {
+		tt := newTwoFactorUsecase(t)
+
+		tt.repo.EXPECT().GetTOTP(gomock.Any(), userID).Return(&dto.TOTPDB{}, nil)
+
+		err := tt.uc.DisableTOTP(context.Background(), dto.DisableTOTPReq{
+			UserID: userID,
+			Code:   "123456",
+		})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
@@ -1,115 +1,190 @@
 package usecase
 
 import (
+	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/auth"
 	"2025_CakeLand_API/internal/pkg/auth/dto"
+	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/mailer"
+	"2025_CakeLand_API/internal/pkg/sms"
 	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/password"
+	"2025_CakeLand_API/internal/pkg/utils/totp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 	"strings"
 )
 
 type AuthUseсase struct {
 	tokenator *jwt.Tokenator
 	repo      auth.IAuthRepository
+	mailer    mailer.Mailer
+	hasher    *password.Hasher
+	limiter   *loginLimiter
+	oauth     auth.IOAuthVerifier
+	sms       sms.Sender
+	phoneAuth config.PhoneAuthConfig
+	totp      *totp.Authenticator
 }
 
-func NewAuthUsecase(
-	tokenator *jwt.Tokenator,
-	repo auth.IAuthRepository,
-) *AuthUseсase {
+// AuthDeps Зависимости AuthUseсase. OAuth, SMS и TOTP нужны только своим способам входа и могут быть nil
+type AuthDeps struct {
+	Tokenator     *jwt.Tokenator
+	Repo          auth.IAuthRepository
+	Mailer        mailer.Mailer
+	Hasher        *password.Hasher
+	LoginAttempts auth.ILoginAttemptStore
+	LoginLimits   config.LoginLimitsConfig
+	OAuth         auth.IOAuthVerifier
+	SMS           sms.Sender
+	PhoneAuth     config.PhoneAuthConfig
+	TOTP          *totp.Authenticator
+}
+
+func NewAuthUsecase(deps AuthDeps) *AuthUseсase {
 	return &AuthUseсase{
-		tokenator: tokenator,
-		repo:      repo,
+		tokenator: deps.Tokenator,
+		repo:      deps.Repo,
+		mailer:    deps.Mailer,
+		hasher:    deps.Hasher,
+		limiter: &loginLimiter{
+			store:  deps.LoginAttempts,
+			limits: deps.LoginLimits,
+		},
+		oauth:     deps.OAuth,
+		sms:       deps.SMS,
+		phoneAuth: deps.PhoneAuth,
+		totp:      deps.TOTP,
 	}
 }
 
 func (u *AuthUseсase) Login(ctx context.Context, in dto.LoginReq) (*dto.LoginRes, error) {
+	// Пока аккаунт или IP заблокированы, пароль даже не проверяем
+	reservation, err := u.limiter.reserve(ctx, u.limiter.keys(in.Email, in.IPAddress))
+	if err != nil {
+		if errors.Is(err, errs.ErrTooManyAttempts) {
+			if auditErr := u.auditLogin(ctx, in, uuid.NullUUID{}, models.LoginFailureThrottled); auditErr != nil {
+				return nil, auditErr
+			}
+		}
+		return nil, err
+	}
+
 	// Получаем данные пользователя
 	res, err := u.repo.GetUserByEmail(ctx, dto.GetUserByEmailReq{
 		Email: in.Email,
 	})
-	if err != nil {
+	if errors.Is(err, errs.ErrNotFound) {
+		u.hasher.VerifyDummy(in.Password)
+		return nil, u.loginFailed(ctx, in, uuid.NullUUID{}, models.LoginFailureUnknownEmail, err)
+	} else if err != nil {
+		_ = u.limiter.release(ctx, reservation)
 		return nil, err
 	}
+	userID := uuid.NullUUID{UUID: res.ID, Valid: true}
 
 	// Проверяем пароль пользователя
-	if !checkPassword(in.Password, res.PasswordHash) {
-		return nil, errs.ErrInvalidPassword
+	ok, needsRehash := u.hasher.Verify(in.Password, res.PasswordHash)
+	if !ok {
+		return nil, u.loginFailed(ctx, in, userID, models.LoginFailureInvalidPassword, errs.ErrInvalidPassword)
 	}
 
-	// Создаём новый access токен
-	accessToken, err := u.tokenator.GenerateAccessToken(res.ID.String())
-	if err != nil {
+	// Пароль верный: счётчик аккаунта обнуляется, даже если войти пока нельзя
+	if err = u.limiter.succeed(ctx, reservation); err != nil {
 		return nil, err
 	}
 
-	oldRefreshToken, exists := res.RefreshTokensMap[in.Fingerprint]
-	// Если токен уже существует, проверим его срок годности. Если ещё валиден, тогда генерируем только access token
-	if exists {
-		isExpired, expErr := u.tokenator.IsTokenExpired(oldRefreshToken, true)
-		if expErr != nil {
-			// Если не вышло декодировать токен, создадим новый токен
-		} else if !isExpired {
-			// Если токен не устарел, создаём только access токен
-			return &dto.LoginRes{
-				AccessToken:  accessToken.Token,
-				RefreshToken: oldRefreshToken,
-				ExpiresIn:    accessToken.ExpiresIn,
-			}, nil
+	// Войти на другом устройстве можно только с подтверждённой почтой
+	if !res.EmailVerified {
+		if err = u.auditLogin(ctx, in, userID, models.LoginFailureEmailNotVerified); err != nil {
+			return nil, err
 		}
+		return nil, errs.ErrEmailNotVerified
 	}
 
-	// Создаём новый refresh токен
-	newRefreshToken, err := u.tokenator.GenerateRefreshToken(res.ID.String())
+	// Хэш устаревшего алгоритма или стоимости пересчитываем, пока знаем пароль
+	if needsRehash {
+		u.rehashPassword(ctx, res.ID, in.Password, res.PasswordHash)
+	}
+
+	// С включённой 2FA пароля мало: токены выдаст LoginTwoFactor по коду
+	if res.TwoFactorEnabled {
+		return u.twoFactorChallenge(res.ID)
+	}
+
+	tokens, err := u.startSession(ctx, res.ID, res.Roles, in.Fingerprint, in.DeviceName, in.IPAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	// Сохраняем или обновляем токены в бд
-	res.RefreshTokensMap[in.Fingerprint] = newRefreshToken.Token
-	err = u.repo.UpdateUserRefreshTokens(ctx, dto.UpdateUserRefreshTokensReq{
-		UserID:           res.ID.String(),
-		RefreshTokensMap: res.RefreshTokensMap,
-	})
+	if err = u.auditLogin(ctx, in, userID, ""); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// startSession Выдаёт пару токенов. Каждый вход начинает новое семейство refresh токенов,
+// прежнее для этого fingerprint перестаёт действовать
+func (u *AuthUseсase) startSession(
+	ctx context.Context,
+	userID uuid.UUID,
+	roles models.Roles,
+	fingerprint, deviceName, ipAddress string,
+) (*dto.LoginRes, error) {
+	// Создаём новый access токен
+	accessToken, err := u.tokenator.GenerateAccessToken(userID.String(), roles)
 	if err != nil {
 		return nil, err
 	}
 
+	familyID := uuid.New()
+	refreshToken, err := u.tokenator.GenerateRefreshToken(userID.String(), familyID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Создаём или заменяем сессию устройства
+	if err = u.repo.CreateSession(ctx, newSession(userID.String(), familyID, accessToken, refreshToken, fingerprint, deviceName, ipAddress)); err != nil {
+		return nil, err
+	}
+
 	return &dto.LoginRes{
 		AccessToken:  accessToken.Token,
-		RefreshToken: newRefreshToken.Token,
+		RefreshToken: refreshToken.Token,
 		ExpiresIn:    accessToken.ExpiresIn,
 	}, nil
 }
 
 func (u *AuthUseсase) Register(ctx context.Context, in dto.RegisterReq) (*dto.RegisterRes, error) {
-	hashedPassword, err := generatePasswordHash(in.Password)
+	hashedPassword, err := u.hasher.Hash(in.Password)
 	if err != nil {
 		return nil, err
 	}
 
 	// Создаём токены
 	userID := uuid.New()
-	accessToken, errAccess := u.tokenator.GenerateAccessToken(userID.String())
-	refreshToken, errRefresh := u.tokenator.GenerateRefreshToken(userID.String())
+	familyID := uuid.New()
+	accessToken, errAccess := u.tokenator.GenerateAccessToken(userID.String(), models.DefaultRoles)
+	refreshToken, errRefresh := u.tokenator.GenerateRefreshToken(userID.String(), familyID.String())
 	if errAccess != nil {
 		return nil, errAccess
 	} else if errRefresh != nil {
 		return nil, errRefresh
 	}
 
-	// Создаём пользователя
+	// Создаём пользователя вместе с сессией устройства
 	if err = u.repo.CreateUser(ctx, dto.CreateUserReq{
 		UUID:         userID,
 		Email:        strings.TrimSpace(in.Email),
 		Nickname:     strings.TrimSpace(in.Nickname),
 		PasswordHash: hashedPassword,
-		RefreshTokensMap: map[string]string{
-			in.Fingerprint: refreshToken.Token,
-		},
+		Roles:        models.DefaultRoles,
+		Session:      newSession(userID.String(), familyID, accessToken, refreshToken, in.Fingerprint, in.DeviceName, in.IPAddress),
 	}); err != nil {
 		return nil, err
 	}
@@ -121,71 +196,122 @@ func (u *AuthUseсase) Register(ctx context.Context, in dto.RegisterReq) (*dto.R
 	}, nil
 }
 
+// UpdateAccessToken Выдаёт новую пару токенов, старый refresh токен становится недействительным.
+// Повторное использование уже заменённого токена отзывает всё семейство для этого fingerprint
 func (u *AuthUseсase) UpdateAccessToken(ctx context.Context, in dto.UpdateAccessTokenReq) (*dto.UpdateAccessTokenRes, error) {
-	// Получаем userID пользователя из refresh токена
-	userID, err := u.tokenator.GetUserIDFromToken(in.RefreshToken, true)
+	// Получаем userID пользователя и семейство из refresh токена
+	userID, rawFamilyID, err := u.tokenator.ParseRefreshToken(in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	familyID, err := uuid.Parse(rawFamilyID)
 	if err != nil {
+		return nil, errs.ErrInvalidRefreshToken
+	}
+
+	// Получаем сессию устройства
+	session, err := u.repo.GetSession(ctx, dto.GetSessionReq{
+		UserID:      userID,
+		Fingerprint: in.Fingerprint,
+	})
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrNoToken
+	} else if err != nil {
 		return nil, err
 	}
 
-	// Получаем все refresh токены пользователя
-	res, err := u.repo.GetUserRefreshTokens(ctx, dto.GetUserRefreshTokensReq{
+	// Проверяем схожи ли токены. Токен из того же семейства уже был заменён — это повторное использование
+	if session.RefreshTokenHash != hashRefreshToken(in.RefreshToken) {
+		if session.FamilyID.Valid && session.FamilyID.UUID == familyID {
+			return nil, u.revokeFamily(ctx, userID, in.Fingerprint)
+		}
+		return nil, errs.ErrInvalidRefreshToken
+	}
+
+	// Роли берём из бд, чтобы выданные и отозванные роли попали в новый токен
+	roles, err := u.repo.GetUserRoles(ctx, dto.GetUserRolesReq{
 		UserID: userID,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Ищем refresh токен для заданного fingerprint
-	oldRefreshToken, exists := res.RefreshTokensMap[in.Fingerprint]
-	if !exists {
-		return nil, errs.ErrNoToken
+	// Генерируем новую пару токенов, refresh остаётся в том же семействе
+	accessToken, err := u.tokenator.GenerateAccessToken(userID, roles.Roles)
+	if err != nil {
+		return nil, err
 	}
-
-	// Проверяем схожи ли токены
-	if oldRefreshToken != in.RefreshToken {
-		return nil, errs.ErrInvalidRefreshToken
+	refreshToken, err := u.tokenator.GenerateRefreshToken(userID, familyID.String())
+	if err != nil {
+		return nil, err
 	}
 
-	// Генерируем новый access токен
-	accessToken, err := u.tokenator.GenerateAccessToken(userID)
+	// Заменяем токен. Если его уже заменили параллельным запросом, считаем это повторным использованием
+	rotated, err := u.repo.RotateSession(ctx, dto.RotateSessionReq{
+		UserID:              userID,
+		Fingerprint:         in.Fingerprint,
+		FamilyID:            familyID,
+		OldRefreshTokenHash: session.RefreshTokenHash,
+		NewRefreshTokenHash: hashRefreshToken(refreshToken.Token),
+		IPAddress:           in.IPAddress,
+		ExpiresAt:           refreshToken.ExpiresIn,
+		AccessTokenID:       accessToken.TokenID,
+		AccessExpiresAt:     accessToken.ExpiresIn,
+	})
 	if err != nil {
 		return nil, err
 	}
+	if !rotated {
+		return nil, u.revokeFamily(ctx, userID, in.Fingerprint)
+	}
 
 	return &dto.UpdateAccessTokenRes{
-		AccessToken: accessToken.Token,
-		ExpiresIn:   accessToken.ExpiresIn,
+		AccessToken:  accessToken.Token,
+		RefreshToken: refreshToken.Token,
+		ExpiresIn:    accessToken.ExpiresIn,
 	}, nil
 }
 
+// revokeFamily Отзывает семейство refresh токенов для fingerprint вместе с сессией: дальше нужен новый вход
+func (u *AuthUseсase) revokeFamily(ctx context.Context, userID, fingerprint string) error {
+	if err := u.repo.DeleteSession(ctx, dto.DeleteSessionReq{
+		UserID:      userID,
+		Fingerprint: fingerprint,
+	}); err != nil {
+		return err
+	}
+
+	return errs.ErrRefreshTokenReused
+}
+
+// Logout Завершает сессию устройства. Последний выданный ей access токен отзывается до истечения
 func (u *AuthUseсase) Logout(ctx context.Context, in dto.LogoutReq) (*dto.LogoutRes, error) {
 	// Получение userID из refresh токена
-	userID, err := u.tokenator.GetUserIDFromToken(in.RefreshToken, true)
+	userID, _, err := u.tokenator.ParseRefreshToken(in.RefreshToken)
 	if err != nil {
 		return nil, err
 	}
 
-	// Получаем токены пользователя
-	res, err := u.repo.GetUserRefreshTokens(ctx, dto.GetUserRefreshTokensReq{
-		UserID: userID,
+	// Получаем сессию устройства
+	session, err := u.repo.GetSession(ctx, dto.GetSessionReq{
+		UserID:      userID,
+		Fingerprint: in.Fingerprint,
 	})
-	if err != nil {
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrInvalidRefreshToken
+	} else if err != nil {
 		return nil, err
 	}
 
 	// Проверяем, верный ли refresh токен
-	dbRefreshToken := res.RefreshTokensMap[in.Fingerprint]
-	if dbRefreshToken != in.RefreshToken {
+	if session.RefreshTokenHash != hashRefreshToken(in.RefreshToken) {
 		return nil, errs.ErrInvalidRefreshToken
 	}
 
-	delete(res.RefreshTokensMap, in.Fingerprint)
-	err = u.repo.UpdateUserRefreshTokens(ctx, dto.UpdateUserRefreshTokensReq{
-		UserID:           userID,
-		RefreshTokensMap: res.RefreshTokensMap,
-	})
-	if err != nil {
+	if err = u.repo.DeleteSession(ctx, dto.DeleteSessionReq{
+		UserID:      userID,
+		Fingerprint: in.Fingerprint,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -194,11 +320,70 @@ func (u *AuthUseсase) Logout(ctx context.Context, in dto.LogoutReq) (*dto.Logou
 	}, nil
 }
 
-func generatePasswordHash(password string) ([]byte, error) {
-	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+// ListSessions Действующие сессии пользователя
+func (u *AuthUseсase) ListSessions(ctx context.Context, in dto.ListSessionsReq) ([]models.Session, error) {
+	return u.repo.Sessions(ctx, in.UserID)
+}
+
+// RevokeSession Завершает сессию пользователя на другом устройстве
+func (u *AuthUseсase) RevokeSession(ctx context.Context, in dto.RevokeSessionReq) error {
+	return u.repo.DeleteSessionByID(ctx, in.UserID, in.SessionID)
+}
+
+// RevokeAllOtherSessions Завершает все сессии пользователя, кроме текущего устройства
+func (u *AuthUseсase) RevokeAllOtherSessions(ctx context.Context, in dto.RevokeAllOtherSessionsReq) (*dto.RevokeAllOtherSessionsRes, error) {
+	revoked, err := u.repo.DeleteOtherSessions(ctx, in.UserID, in.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RevokeAllOtherSessionsRes{
+		RevokedCount: revoked,
+	}, nil
+}
+
+// JWKS Публичные ключи проверки access токенов
+func (u *AuthUseсase) JWKS(_ context.Context) []models.JWK {
+	return u.tokenator.JWKS()
+}
+
+// newSession Сессия устройства с новым семейством refresh токенов. jti access токена нужен, чтобы отозвать его вместе с сессией
+func newSession(
+	userID string,
+	familyID uuid.UUID,
+	accessToken, refreshToken *models.JWTTokenPayload,
+	fingerprint, deviceName, ipAddress string,
+) dto.CreateSessionReq {
+	return dto.CreateSessionReq{
+		ID:               uuid.New(),
+		UserID:           userID,
+		Fingerprint:      fingerprint,
+		FamilyID:         familyID,
+		RefreshTokenHash: hashRefreshToken(refreshToken.Token),
+		DeviceName:       deviceName,
+		IPAddress:        ipAddress,
+		ExpiresAt:        refreshToken.ExpiresIn,
+		AccessTokenID:    accessToken.TokenID,
+		AccessExpiresAt:  accessToken.ExpiresIn,
+	}
 }
 
-func checkPassword(inputPassword string, realPassword []byte) bool {
-	err := bcrypt.CompareHashAndPassword(realPassword, []byte(inputPassword))
-	return err == nil
+// hashRefreshToken В бд лежит только хэш: утечка таблицы сессий не даёт рабочих токенов
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// rehashPassword Ошибка не мешает входу: хэш пересчитается при следующем входе
+func (u *AuthUseсase) rehashPassword(ctx context.Context, userID uuid.UUID, pass string, oldHash []byte) {
+	newHash, err := u.hasher.Hash(pass)
+	if err != nil {
+		return
+	}
+
+	_ = u.repo.RehashPassword(ctx, dto.RehashPasswordReq{
+		UserID:          userID,
+		OldPasswordHash: oldHash,
+		NewPasswordHash: newHash,
+	})
 }
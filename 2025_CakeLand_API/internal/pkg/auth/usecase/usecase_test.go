@@ -1,22 +1,88 @@
 package usecase
 
 import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/auth/dto"
 	"2025_CakeLand_API/internal/pkg/auth/mocks"
+	"2025_CakeLand_API/internal/pkg/auth/repo"
+	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/mailer"
 	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"2025_CakeLand_API/internal/pkg/utils/password"
 	"context"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
+// newTestHasher Минимальные параметры, чтобы тесты не тратили время на хэширование
+func newTestHasher(t *testing.T, algorithm string) *password.Hasher {
+	hasher, err := password.NewHasher(&config.PasswordHashConfig{
+		Algorithm:         algorithm,
+		BcryptCost:        4,
+		Argon2Memory:      64,
+		Argon2Iterations:  1,
+		Argon2Parallelism: 1,
+	})
+	assert.NoError(t, err)
+
+	return hasher
+}
+
+// testLoginLimits Лимиты входа, которые не мешают тестам без перебора
+var testLoginLimits = config.LoginLimitsConfig{
+	Window:  time.Hour,
+	Account: config.LoginLimitConfig{FreeAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Minute},
+	IP:      config.LoginLimitConfig{FreeAttempts: 20, BaseDelay: time.Second, MaxDelay: time.Minute},
+}
+
+var testPhoneAuth = config.PhoneAuthConfig{
+	CodeTTL:        5 * time.Minute,
+	MaxAttempts:    5,
+	ResendCooldown: time.Minute,
+	MaxCodesPerDay: 5,
+	MaxCodesPerIP:  3,
+}
+
+func newTestLoginAttempts() *repo.MemoryLoginAttemptStore {
+	return repo.NewMemoryLoginAttemptStore(time.Hour)
+}
+
+// newTestUsecase Usecase с тестовыми зависимостями. В deps задаются только те, что важны тесту, остальные
+// заполняются по умолчанию: HS256 токены, письма в памяти, быстрый bcrypt, счётчики входа в памяти
+func newTestUsecase(t *testing.T, deps AuthDeps) *AuthUseсase {
+	if deps.Tokenator == nil {
+		deps.Tokenator = jwt.NewTokenator()
+	}
+	if deps.Mailer == nil {
+		deps.Mailer = mailer.NewMemoryMailer()
+	}
+	if deps.Hasher == nil {
+		deps.Hasher = newTestHasher(t, password.AlgorithmBcrypt)
+	}
+	if deps.LoginAttempts == nil {
+		deps.LoginAttempts = newTestLoginAttempts()
+	}
+	if deps.LoginLimits == (config.LoginLimitsConfig{}) {
+		deps.LoginLimits = testLoginLimits
+	}
+	if deps.PhoneAuth == (config.PhoneAuthConfig{}) {
+		deps.PhoneAuth = testPhoneAuth
+	}
+
+	return NewAuthUsecase(deps)
+}
+
 func TestAuthUsecase_Register(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIAuthRepository(ctrl)
 	tokenator := jwt.NewTokenator()
-	uc := NewAuthUsecase(tokenator, mockRepo)
+	uc := newTestUsecase(t, AuthDeps{Tokenator: tokenator, Repo: mockRepo})
 
 	mockRepo.EXPECT().
 		CreateUser(gomock.Any(), gomock.Any()).
@@ -34,5 +100,160 @@ func TestAuthUsecase_Register(t *testing.T) {
 		assert.NotEmpty(t, res.AccessToken)
 		assert.NotEmpty(t, res.RefreshToken)
 		assert.NotEmpty(t, res.ExpiresIn)
+
+		roles, err := tokenator.GetRolesFromToken(res.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, models.DefaultRoles, roles)
+	})
+}
+
+func TestAuthUsecase_UpdateAccessToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	tokenator := jwt.NewTokenator()
+	uc := newTestUsecase(t, AuthDeps{Tokenator: tokenator, Repo: mockRepo})
+
+	const (
+		userID      = "6f1c1d3e-8a5b-4b7e-9a53-2c7f1f0e9b11"
+		fingerprint = "some-fingerprint"
+	)
+	familyID := uuid.New()
+	sessionReq := dto.GetSessionReq{UserID: userID, Fingerprint: fingerprint}
+
+	t.Run("Rotates refresh token", func(t *testing.T) {
+		current, err := tokenator.GenerateRefreshToken(userID, familyID.String())
+		assert.NoError(t, err)
+
+		mockRepo.EXPECT().
+			GetSession(gomock.Any(), sessionReq).
+			Return(&dto.SessionDB{
+				FamilyID:         uuid.NullUUID{UUID: familyID, Valid: true},
+				RefreshTokenHash: hashRefreshToken(current.Token),
+			}, nil)
+		mockRepo.EXPECT().
+			GetUserRoles(gomock.Any(), dto.GetUserRolesReq{UserID: userID}).
+			Return(&dto.GetUserRolesRes{Roles: models.DefaultRoles}, nil)
+		var rotatedAccessTokenID string
+		mockRepo.EXPECT().
+			RotateSession(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.RotateSessionReq) (bool, error) {
+				rotatedAccessTokenID = in.AccessTokenID
+				assert.Equal(t, hashRefreshToken(current.Token), in.OldRefreshTokenHash)
+				assert.NotEqual(t, in.OldRefreshTokenHash, in.NewRefreshTokenHash)
+				assert.Equal(t, familyID, in.FamilyID)
+				return true, nil
+			})
+
+		res, err := uc.UpdateAccessToken(context.Background(), dto.UpdateAccessTokenReq{
+			RefreshToken: current.Token,
+			Fingerprint:  fingerprint,
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.AccessToken)
+		assert.NotEqual(t, current.Token, res.RefreshToken)
+
+		_, newFamilyID, err := tokenator.ParseRefreshToken(res.RefreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, familyID.String(), newFamilyID)
+
+		// В сессии лежит jti нового access токена: при выходе он попадёт в denylist
+		accessClaims, err := tokenator.ParseAccessToken(res.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, accessClaims.TokenID, rotatedAccessTokenID)
+	})
+
+	t.Run("Reuse of rotated token revokes family", func(t *testing.T) {
+		rotated, err := tokenator.GenerateRefreshToken(userID, familyID.String())
+		assert.NoError(t, err)
+		current, err := tokenator.GenerateRefreshToken(userID, familyID.String())
+		assert.NoError(t, err)
+
+		mockRepo.EXPECT().
+			GetSession(gomock.Any(), sessionReq).
+			Return(&dto.SessionDB{
+				FamilyID:         uuid.NullUUID{UUID: familyID, Valid: true},
+				RefreshTokenHash: hashRefreshToken(current.Token),
+			}, nil)
+		mockRepo.EXPECT().
+			DeleteSession(gomock.Any(), dto.DeleteSessionReq{UserID: userID, Fingerprint: fingerprint}).
+			Return(nil)
+
+		res, err := uc.UpdateAccessToken(context.Background(), dto.UpdateAccessTokenReq{
+			RefreshToken: rotated.Token,
+			Fingerprint:  fingerprint,
+		})
+
+		assert.ErrorIs(t, err, errs.ErrRefreshTokenReused)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Token from another family is invalid", func(t *testing.T) {
+		stale, err := tokenator.GenerateRefreshToken(userID, uuid.NewString())
+		assert.NoError(t, err)
+		current, err := tokenator.GenerateRefreshToken(userID, familyID.String())
+		assert.NoError(t, err)
+
+		mockRepo.EXPECT().
+			GetSession(gomock.Any(), sessionReq).
+			Return(&dto.SessionDB{
+				FamilyID:         uuid.NullUUID{UUID: familyID, Valid: true},
+				RefreshTokenHash: hashRefreshToken(current.Token),
+			}, nil)
+
+		res, err := uc.UpdateAccessToken(context.Background(), dto.UpdateAccessTokenReq{
+			RefreshToken: stale.Token,
+			Fingerprint:  fingerprint,
+		})
+
+		assert.ErrorIs(t, err, errs.ErrInvalidRefreshToken)
+		assert.Nil(t, res)
 	})
 }
+
+func TestAuthUsecase_Login_RehashesPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAuthRepository(ctrl)
+	hasher := newTestHasher(t, password.AlgorithmArgon2id)
+	uc := newTestUsecase(t, AuthDeps{Repo: mockRepo, Hasher: hasher})
+
+	// Старый хэш bcrypt должен смениться на argon2id
+	userID := uuid.New()
+	oldHash, err := newTestHasher(t, password.AlgorithmBcrypt).Hash("Password1")
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().
+		GetUserByEmail(gomock.Any(), gomock.Any()).
+		Return(&dto.GetUserByEmailRes{ID: userID, PasswordHash: oldHash, EmailVerified: true}, nil)
+	mockRepo.EXPECT().
+		RehashPassword(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in dto.RehashPasswordReq) error {
+			assert.Equal(t, userID, in.UserID)
+			assert.Equal(t, oldHash, in.OldPasswordHash)
+			ok, needsRehash := hasher.Verify("Password1", in.NewPasswordHash)
+			assert.True(t, ok)
+			assert.False(t, needsRehash)
+			return nil
+		})
+	mockRepo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().
+		SaveLoginAudit(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in dto.LoginAuditReq) error {
+			assert.True(t, in.Success)
+			assert.Equal(t, uuid.NullUUID{UUID: userID, Valid: true}, in.UserID)
+			return nil
+		})
+
+	res, err := uc.Login(context.Background(), dto.LoginReq{
+		Email:       "test@example.com",
+		Password:    "Password1",
+		Fingerprint: "some-fingerprint",
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.AccessToken)
+}
@@ -24,6 +24,212 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ############### SearchCakes ###############
+type CakeSort int32
+
+const (
+	CakeSort_CAKE_SORT_RELEVANCE  CakeSort = 0 // По релевантности запросу (без запроса — как NEWEST)
+	CakeSort_CAKE_SORT_PRICE_ASC  CakeSort = 1 // Сначала дешёвые (цена за кг с учётом скидки)
+	CakeSort_CAKE_SORT_PRICE_DESC CakeSort = 2 // Сначала дорогие
+	CakeSort_CAKE_SORT_RATING     CakeSort = 3 // По среднему рейтингу
+	CakeSort_CAKE_SORT_NEWEST     CakeSort = 4 // Сначала новые
+	CakeSort_CAKE_SORT_POPULARITY CakeSort = 5 // По числу заказов
+	CakeSort_CAKE_SORT_DISTANCE   CakeSort = 6 // Сначала ближайшие к адресу доставки (нужен delivery_address_id)
+)
+
+// Enum value maps for CakeSort.
+var (
+	CakeSort_name = map[int32]string{
+		0: "CAKE_SORT_RELEVANCE",
+		1: "CAKE_SORT_PRICE_ASC",
+		2: "CAKE_SORT_PRICE_DESC",
+		3: "CAKE_SORT_RATING",
+		4: "CAKE_SORT_NEWEST",
+		5: "CAKE_SORT_POPULARITY",
+		6: "CAKE_SORT_DISTANCE",
+	}
+	CakeSort_value = map[string]int32{
+		"CAKE_SORT_RELEVANCE":  0,
+		"CAKE_SORT_PRICE_ASC":  1,
+		"CAKE_SORT_PRICE_DESC": 2,
+		"CAKE_SORT_RATING":     3,
+		"CAKE_SORT_NEWEST":     4,
+		"CAKE_SORT_POPULARITY": 5,
+		"CAKE_SORT_DISTANCE":   6,
+	}
+)
+
+func (x CakeSort) Enum() *CakeSort {
+	p := new(CakeSort)
+	*p = x
+	return p
+}
+
+func (x CakeSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CakeSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_cake_proto_enumTypes[0].Descriptor()
+}
+
+func (CakeSort) Type() protoreflect.EnumType {
+	return &file_cake_proto_enumTypes[0]
+}
+
+func (x CakeSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CakeSort.Descriptor instead.
+func (CakeSort) EnumDescriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{0}
+}
+
+// ############### Favorites ###############
+type FavoriteTarget int32
+
+const (
+	FavoriteTarget_FAVORITE_TARGET_CAKE   FavoriteTarget = 0 // Торт
+	FavoriteTarget_FAVORITE_TARGET_SELLER FavoriteTarget = 1 // Продавец
+)
+
+// Enum value maps for FavoriteTarget.
+var (
+	FavoriteTarget_name = map[int32]string{
+		0: "FAVORITE_TARGET_CAKE",
+		1: "FAVORITE_TARGET_SELLER",
+	}
+	FavoriteTarget_value = map[string]int32{
+		"FAVORITE_TARGET_CAKE":   0,
+		"FAVORITE_TARGET_SELLER": 1,
+	}
+)
+
+func (x FavoriteTarget) Enum() *FavoriteTarget {
+	p := new(FavoriteTarget)
+	*p = x
+	return p
+}
+
+func (x FavoriteTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FavoriteTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_cake_proto_enumTypes[1].Descriptor()
+}
+
+func (FavoriteTarget) Type() protoreflect.EnumType {
+	return &file_cake_proto_enumTypes[1]
+}
+
+func (x FavoriteTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FavoriteTarget.Descriptor instead.
+func (FavoriteTarget) EnumDescriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{1}
+}
+
+// ############### Promotions ###############
+type DiscountKind int32
+
+const (
+	DiscountKind_DISCOUNT_KIND_PERCENT DiscountKind = 0 // Процент от цены
+	DiscountKind_DISCOUNT_KIND_FIXED   DiscountKind = 1 // Фиксированная сумма (акция — с цены за кг, промокод — с суммы заказа)
+)
+
+// Enum value maps for DiscountKind.
+var (
+	DiscountKind_name = map[int32]string{
+		0: "DISCOUNT_KIND_PERCENT",
+		1: "DISCOUNT_KIND_FIXED",
+	}
+	DiscountKind_value = map[string]int32{
+		"DISCOUNT_KIND_PERCENT": 0,
+		"DISCOUNT_KIND_FIXED":   1,
+	}
+)
+
+func (x DiscountKind) Enum() *DiscountKind {
+	p := new(DiscountKind)
+	*p = x
+	return p
+}
+
+func (x DiscountKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cake_proto_enumTypes[2].Descriptor()
+}
+
+func (DiscountKind) Type() protoreflect.EnumType {
+	return &file_cake_proto_enumTypes[2]
+}
+
+func (x DiscountKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountKind.Descriptor instead.
+func (DiscountKind) EnumDescriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{2}
+}
+
+// ############### CakeOptions ###############
+type CakeOptionKind int32
+
+const (
+	CakeOptionKind_CAKE_OPTION_KIND_SINGLE   CakeOptionKind = 0 // Ровно одно значение (например, число ярусов)
+	CakeOptionKind_CAKE_OPTION_KIND_MULTIPLE CakeOptionKind = 1 // Одно или несколько значений (например, фигурки)
+	CakeOptionKind_CAKE_OPTION_KIND_TEXT     CakeOptionKind = 2 // Текст покупателя (например, надпись)
+)
+
+// Enum value maps for CakeOptionKind.
+var (
+	CakeOptionKind_name = map[int32]string{
+		0: "CAKE_OPTION_KIND_SINGLE",
+		1: "CAKE_OPTION_KIND_MULTIPLE",
+		2: "CAKE_OPTION_KIND_TEXT",
+	}
+	CakeOptionKind_value = map[string]int32{
+		"CAKE_OPTION_KIND_SINGLE":   0,
+		"CAKE_OPTION_KIND_MULTIPLE": 1,
+		"CAKE_OPTION_KIND_TEXT":     2,
+	}
+)
+
+func (x CakeOptionKind) Enum() *CakeOptionKind {
+	p := new(CakeOptionKind)
+	*p = x
+	return p
+}
+
+func (x CakeOptionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CakeOptionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cake_proto_enumTypes[3].Descriptor()
+}
+
+func (CakeOptionKind) Type() protoreflect.EnumType {
+	return &file_cake_proto_enumTypes[3]
+}
+
+func (x CakeOptionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CakeOptionKind.Descriptor instead.
+func (CakeOptionKind) EnumDescriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{3}
+}
+
 type CategoryGender int32
 
 const (
@@ -60,11 +266,11 @@ func (x CategoryGender) String() string {
 }
 
 func (CategoryGender) Descriptor() protoreflect.EnumDescriptor {
-	return file_cake_proto_enumTypes[0].Descriptor()
+	return file_cake_proto_enumTypes[4].Descriptor()
 }
 
 func (CategoryGender) Type() protoreflect.EnumType {
-	return &file_cake_proto_enumTypes[0]
+	return &file_cake_proto_enumTypes[4]
 }
 
 func (x CategoryGender) Number() protoreflect.EnumNumber {
@@ -73,7 +279,7 @@ func (x CategoryGender) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CategoryGender.Descriptor instead.
 func (CategoryGender) EnumDescriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{0}
+	return file_cake_proto_rawDescGZIP(), []int{4}
 }
 
 // ############### Cake ###############
@@ -334,32 +540,36 @@ func (x *CreateCakeResponse) GetCakeId() string {
 	return ""
 }
 
-// ############### CreateFilling ###############
-type CreateFillingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // Название начинки
-	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"` // Данные изображения начинки
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                      // Состав начинки
-	KgPrice       float64                `protobuf:"fixed64,4,opt,name=kg_price,json=kgPrice,proto3" json:"kg_price,omitempty"`     // Цена за кг
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`              // Описание начинки
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ############### UpdateCake ###############
+type UpdateCakeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CakeId           string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`                                       // ID торта
+	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                                   // Название торта
+	PreviewImageData []byte                 `protobuf:"bytes,3,opt,name=preview_image_data,json=previewImageData,proto3,oneof" json:"preview_image_data,omitempty"` // Новое preview изображение
+	KgPrice          *float64               `protobuf:"fixed64,4,opt,name=kg_price,json=kgPrice,proto3,oneof" json:"kg_price,omitempty"`                            // Цена за кг
+	Description      *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`                                     // Описание торта
+	Mass             *float64               `protobuf:"fixed64,6,opt,name=mass,proto3,oneof" json:"mass,omitempty"`                                                 // Масса торта
+	DiscountKgPrice  *float64               `protobuf:"fixed64,7,opt,name=discount_kg_price,json=discountKgPrice,proto3,oneof" json:"discount_kg_price,omitempty"`  // Скидочная цена за кг
+	DiscountEndTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=discount_end_time,json=discountEndTime,proto3,oneof" json:"discount_end_time,omitempty"`    // Время окончания скидки
+	RemoveDiscount   bool                   `protobuf:"varint,9,opt,name=remove_discount,json=removeDiscount,proto3" json:"remove_discount,omitempty"`              // Убрать скидку (приоритетнее полей скидки)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateFillingRequest) Reset() {
-	*x = CreateFillingRequest{}
+func (x *UpdateCakeRequest) Reset() {
+	*x = UpdateCakeRequest{}
 	mi := &file_cake_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFillingRequest) String() string {
+func (x *UpdateCakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFillingRequest) ProtoMessage() {}
+func (*UpdateCakeRequest) ProtoMessage() {}
 
-func (x *CreateFillingRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateCakeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cake_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,67 +581,95 @@ func (x *CreateFillingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFillingRequest.ProtoReflect.Descriptor instead.
-func (*CreateFillingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCakeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCakeRequest) Descriptor() ([]byte, []int) {
 	return file_cake_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateFillingRequest) GetName() string {
+func (x *UpdateCakeRequest) GetCakeId() string {
 	if x != nil {
-		return x.Name
+		return x.CakeId
 	}
 	return ""
 }
 
-func (x *CreateFillingRequest) GetImageData() []byte {
+func (x *UpdateCakeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCakeRequest) GetPreviewImageData() []byte {
 	if x != nil {
-		return x.ImageData
+		return x.PreviewImageData
 	}
 	return nil
 }
 
-func (x *CreateFillingRequest) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *UpdateCakeRequest) GetKgPrice() float64 {
+	if x != nil && x.KgPrice != nil {
+		return *x.KgPrice
+	}
+	return 0
+}
+
+func (x *UpdateCakeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *CreateFillingRequest) GetKgPrice() float64 {
-	if x != nil {
-		return x.KgPrice
+func (x *UpdateCakeRequest) GetMass() float64 {
+	if x != nil && x.Mass != nil {
+		return *x.Mass
 	}
 	return 0
 }
 
-func (x *CreateFillingRequest) GetDescription() string {
+func (x *UpdateCakeRequest) GetDiscountKgPrice() float64 {
+	if x != nil && x.DiscountKgPrice != nil {
+		return *x.DiscountKgPrice
+	}
+	return 0
+}
+
+func (x *UpdateCakeRequest) GetDiscountEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.DiscountEndTime
 	}
-	return ""
+	return nil
 }
 
-type CreateFillingResponse struct {
+func (x *UpdateCakeRequest) GetRemoveDiscount() bool {
+	if x != nil {
+		return x.RemoveDiscount
+	}
+	return false
+}
+
+type UpdateCakeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filling       *Filling               `protobuf:"bytes,1,opt,name=filling,proto3" json:"filling,omitempty"` // Созданная начинка
+	Cake          *Cake                  `protobuf:"bytes,1,opt,name=cake,proto3" json:"cake,omitempty"` // Обновлённый торт
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateFillingResponse) Reset() {
-	*x = CreateFillingResponse{}
+func (x *UpdateCakeResponse) Reset() {
+	*x = UpdateCakeResponse{}
 	mi := &file_cake_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFillingResponse) String() string {
+func (x *UpdateCakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFillingResponse) ProtoMessage() {}
+func (*UpdateCakeResponse) ProtoMessage() {}
 
-func (x *CreateFillingResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateCakeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cake_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,41 +681,41 @@ func (x *CreateFillingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFillingResponse.ProtoReflect.Descriptor instead.
-func (*CreateFillingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCakeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCakeResponse) Descriptor() ([]byte, []int) {
 	return file_cake_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateFillingResponse) GetFilling() *Filling {
+func (x *UpdateCakeResponse) GetCake() *Cake {
 	if x != nil {
-		return x.Filling
+		return x.Cake
 	}
 	return nil
 }
 
-// ############### CreateCategory ###############
-type CreateCategoryRequest struct {
+// ############### SetCakeSaleStatus ###############
+type SetCakeSaleStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
+	CakeId        string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
+	IsOpenForSale bool                   `protobuf:"varint,2,opt,name=is_open_for_sale,json=isOpenForSale,proto3" json:"is_open_for_sale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
+func (x *SetCakeSaleStatusRequest) Reset() {
+	*x = SetCakeSaleStatusRequest{}
 	mi := &file_cake_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *SetCakeSaleStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*SetCakeSaleStatusRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+func (x *SetCakeSaleStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cake_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -489,92 +727,2911 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCakeSaleStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetCakeSaleStatusRequest) Descriptor() ([]byte, []int) {
 	return file_cake_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *SetCakeSaleStatusRequest) GetCakeId() string {
 	if x != nil {
-		return x.Name
+		return x.CakeId
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetImageData() []byte {
+func (x *SetCakeSaleStatusRequest) GetIsOpenForSale() bool {
 	if x != nil {
-		return x.ImageData
+		return x.IsOpenForSale
 	}
-	return nil
+	return false
 }
 
-type CreateCategoryResponse struct {
+// ############### AddCakeImages ###############
+type AddCakeImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	CakeId        string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
+	Images        [][]byte               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"` // Фотографии добавляются в конец в переданном порядке
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryResponse) Reset() {
-	*x = CreateCategoryResponse{}
+func (x *AddCakeImagesRequest) Reset() {
+	*x = AddCakeImagesRequest{}
 	mi := &file_cake_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryResponse) String() string {
+func (x *AddCakeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCakeImagesRequest) ProtoMessage() {}
+
+func (x *AddCakeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCakeImagesRequest.ProtoReflect.Descriptor instead.
+func (*AddCakeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddCakeImagesRequest) GetCakeId() string {
+	if x != nil {
+		return x.CakeId
+	}
+	return ""
+}
+
+func (x *AddCakeImagesRequest) GetImages() [][]byte {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// ############### RemoveCakeImage ###############
+type RemoveCakeImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CakeId        string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
+	ImageId       string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCakeImageRequest) Reset() {
+	*x = RemoveCakeImageRequest{}
+	mi := &file_cake_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCakeImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryResponse) ProtoMessage() {}
-
-func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[7]
+func (*RemoveCakeImageRequest) ProtoMessage() {}
+
+func (x *RemoveCakeImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCakeImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCakeImageRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveCakeImageRequest) GetCakeId() string {
+	if x != nil {
+		return x.CakeId
+	}
+	return ""
+}
+
+func (x *RemoveCakeImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+// ############### ReorderCakeImages ###############
+type ReorderCakeImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CakeId        string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"` // Все ID фотографий торта в новом порядке
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCakeImagesRequest) Reset() {
+	*x = ReorderCakeImagesRequest{}
+	mi := &file_cake_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCakeImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCakeImagesRequest) ProtoMessage() {}
+
+func (x *ReorderCakeImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCakeImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCakeImagesRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReorderCakeImagesRequest) GetCakeId() string {
+	if x != nil {
+		return x.CakeId
+	}
+	return ""
+}
+
+func (x *ReorderCakeImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type CakeImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*Cake_CakeImage      `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"` // Фотографии торта по порядку
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CakeImagesResponse) Reset() {
+	*x = CakeImagesResponse{}
+	mi := &file_cake_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CakeImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CakeImagesResponse) ProtoMessage() {}
+
+func (x *CakeImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CakeImagesResponse.ProtoReflect.Descriptor instead.
+func (*CakeImagesResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CakeImagesResponse) GetImages() []*Cake_CakeImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// ############### CreateFilling ###############
+type CreateFillingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // Название начинки
+	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`     // Данные изображения начинки
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                          // Состав начинки
+	KgPrice       float64                `protobuf:"fixed64,4,opt,name=kg_price,json=kgPrice,proto3" json:"kg_price,omitempty"`         // Цена за кг
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                  // Описание начинки
+	IsPlatform    bool                   `protobuf:"varint,6,opt,name=is_platform,json=isPlatform,proto3" json:"is_platform,omitempty"` // Общая начинка платформы (только для администраторов), иначе — начинка пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFillingRequest) Reset() {
+	*x = CreateFillingRequest{}
+	mi := &file_cake_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFillingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFillingRequest) ProtoMessage() {}
+
+func (x *CreateFillingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFillingRequest.ProtoReflect.Descriptor instead.
+func (*CreateFillingRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateFillingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFillingRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *CreateFillingRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateFillingRequest) GetKgPrice() float64 {
+	if x != nil {
+		return x.KgPrice
+	}
+	return 0
+}
+
+func (x *CreateFillingRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateFillingRequest) GetIsPlatform() bool {
+	if x != nil {
+		return x.IsPlatform
+	}
+	return false
+}
+
+type CreateFillingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filling       *Filling               `protobuf:"bytes,1,opt,name=filling,proto3" json:"filling,omitempty"` // Созданная начинка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFillingResponse) Reset() {
+	*x = CreateFillingResponse{}
+	mi := &file_cake_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFillingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFillingResponse) ProtoMessage() {}
+
+func (x *CreateFillingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFillingResponse.ProtoReflect.Descriptor instead.
+func (*CreateFillingResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateFillingResponse) GetFilling() *Filling {
+	if x != nil {
+		return x.Filling
+	}
+	return nil
+}
+
+// Свою начинку меняет владелец, общую — администратор
+type UpdateFillingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FillingId     string                 `protobuf:"bytes,1,opt,name=filling_id,json=fillingId,proto3" json:"filling_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ImageData     []byte                 `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3,oneof" json:"image_data,omitempty"`
+	Content       *string                `protobuf:"bytes,4,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	KgPrice       *float64               `protobuf:"fixed64,5,opt,name=kg_price,json=kgPrice,proto3,oneof" json:"kg_price,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFillingRequest) Reset() {
+	*x = UpdateFillingRequest{}
+	mi := &file_cake_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFillingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFillingRequest) ProtoMessage() {}
+
+func (x *UpdateFillingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFillingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFillingRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateFillingRequest) GetFillingId() string {
+	if x != nil {
+		return x.FillingId
+	}
+	return ""
+}
+
+func (x *UpdateFillingRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFillingRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *UpdateFillingRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateFillingRequest) GetKgPrice() float64 {
+	if x != nil && x.KgPrice != nil {
+		return *x.KgPrice
+	}
+	return 0
+}
+
+func (x *UpdateFillingRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateFillingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filling       *Filling               `protobuf:"bytes,1,opt,name=filling,proto3" json:"filling,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFillingResponse) Reset() {
+	*x = UpdateFillingResponse{}
+	mi := &file_cake_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFillingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFillingResponse) ProtoMessage() {}
+
+func (x *UpdateFillingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFillingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFillingResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateFillingResponse) GetFilling() *Filling {
+	if x != nil {
+		return x.Filling
+	}
+	return nil
+}
+
+// Начинка пропадает из списков и тортов, но остаётся в оформленных заказах
+type FillingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FillingId     string                 `protobuf:"bytes,1,opt,name=filling_id,json=fillingId,proto3" json:"filling_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillingRequest) Reset() {
+	*x = FillingRequest{}
+	mi := &file_cake_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillingRequest) ProtoMessage() {}
+
+func (x *FillingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillingRequest.ProtoReflect.Descriptor instead.
+func (*FillingRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FillingRequest) GetFillingId() string {
+	if x != nil {
+		return x.FillingId
+	}
+	return ""
+}
+
+// Категории создают только администраторы
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
+	GenderTags    []CategoryGender       `protobuf:"varint,3,rep,packed,name=gender_tags,json=genderTags,proto3,enum=cake.CategoryGender" json:"gender_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_cake_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *CreateCategoryRequest) GetGenderTags() []CategoryGender {
+	if x != nil {
+		return x.GenderTags
+	}
+	return nil
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_cake_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// ############### UpdateCategory ###############
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ImageData     []byte                 `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3,oneof" json:"image_data,omitempty"`
+	GenderTags    *CategoryGenderTags    `protobuf:"bytes,4,opt,name=gender_tags,json=genderTags,proto3" json:"gender_tags,omitempty"` // Нет — теги не меняются
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_cake_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetGenderTags() *CategoryGenderTags {
+	if x != nil {
+		return x.GenderTags
+	}
+	return nil
+}
+
+type CategoryGenderTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []CategoryGender       `protobuf:"varint,1,rep,packed,name=tags,proto3,enum=cake.CategoryGender" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryGenderTags) Reset() {
+	*x = CategoryGenderTags{}
+	mi := &file_cake_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryGenderTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGenderTags) ProtoMessage() {}
+
+func (x *CategoryGenderTags) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGenderTags.ProtoReflect.Descriptor instead.
+func (*CategoryGenderTags) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CategoryGenderTags) GetTags() []CategoryGender {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_cake_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Торты категории остаются, но теряют её
+type CategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRequest) Reset() {
+	*x = CategoryRequest{}
+	mi := &file_cake_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRequest) ProtoMessage() {}
+
+func (x *CategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRequest.ProtoReflect.Descriptor instead.
+func (*CategoryRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+// ############### Categories ###############
+type CategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoriesRequest) Reset() {
+	*x = CategoriesRequest{}
+	mi := &file_cake_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoriesRequest) ProtoMessage() {}
+
+func (x *CategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoriesRequest.ProtoReflect.Descriptor instead.
+func (*CategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type CategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoriesResponse) Reset() {
+	*x = CategoriesResponse{}
+	mi := &file_cake_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoriesResponse) ProtoMessage() {}
+
+func (x *CategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoriesResponse.ProtoReflect.Descriptor instead.
+func (*CategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Общие начинки платформы и, для авторизованного пользователя, его собственные
+type FillingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillingsRequest) Reset() {
+	*x = FillingsRequest{}
+	mi := &file_cake_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillingsRequest) ProtoMessage() {}
+
+func (x *FillingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillingsRequest.ProtoReflect.Descriptor instead.
+func (*FillingsRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FillingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FillingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type FillingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fillings      []*Filling             `protobuf:"bytes,1,rep,name=fillings,proto3" json:"fillings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillingsResponse) Reset() {
+	*x = FillingsResponse{}
+	mi := &file_cake_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillingsResponse) ProtoMessage() {}
+
+func (x *FillingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillingsResponse.ProtoReflect.Descriptor instead.
+func (*FillingsResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FillingsResponse) GetFillings() []*Filling {
+	if x != nil {
+		return x.Fillings
+	}
+	return nil
+}
+
+func (x *FillingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ############### CakesResponse ###############
+type CakesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CakesRequest) Reset() {
+	*x = CakesRequest{}
+	mi := &file_cake_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CakesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CakesRequest) ProtoMessage() {}
+
+func (x *CakesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CakesRequest.ProtoReflect.Descriptor instead.
+func (*CakesRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CakesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CakesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type CakesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cakes         []*PreviewCake         `protobuf:"bytes,1,rep,name=cakes,proto3" json:"cakes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CakesResponse) Reset() {
+	*x = CakesResponse{}
+	mi := &file_cake_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CakesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CakesResponse) ProtoMessage() {}
+
+func (x *CakesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CakesResponse.ProtoReflect.Descriptor instead.
+func (*CakesResponse) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CakesResponse) GetCakes() []*PreviewCake {
+	if x != nil {
+		return x.Cakes
+	}
+	return nil
+}
+
+func (x *CakesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ############### GetCategoriesByGenderName ###############
+type GetCategoriesByGenderNameReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CategoryGender CategoryGender         `protobuf:"varint,1,opt,name=categoryGender,proto3,enum=cake.CategoryGender" json:"categoryGender,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCategoriesByGenderNameReq) Reset() {
+	*x = GetCategoriesByGenderNameReq{}
+	mi := &file_cake_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesByGenderNameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesByGenderNameReq) ProtoMessage() {}
+
+func (x *GetCategoriesByGenderNameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesByGenderNameReq.ProtoReflect.Descriptor instead.
+func (*GetCategoriesByGenderNameReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCategoriesByGenderNameReq) GetCategoryGender() CategoryGender {
+	if x != nil {
+		return x.CategoryGender
+	}
+	return CategoryGender_CATEGORY_GENDER_UNSPECIFIED
+}
+
+type GetCategoriesByGenderNameRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesByGenderNameRes) Reset() {
+	*x = GetCategoriesByGenderNameRes{}
+	mi := &file_cake_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesByGenderNameRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesByGenderNameRes) ProtoMessage() {}
+
+func (x *GetCategoriesByGenderNameRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesByGenderNameRes.ProtoReflect.Descriptor instead.
+func (*GetCategoriesByGenderNameRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCategoriesByGenderNameRes) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// ############### CategoryPreviewCakes ###############
+type CategoryPreviewCakesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryID    string                 `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPreviewCakesReq) Reset() {
+	*x = CategoryPreviewCakesReq{}
+	mi := &file_cake_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPreviewCakesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPreviewCakesReq) ProtoMessage() {}
+
+func (x *CategoryPreviewCakesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPreviewCakesReq.ProtoReflect.Descriptor instead.
+func (*CategoryPreviewCakesReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CategoryPreviewCakesReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *CategoryPreviewCakesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CategoryPreviewCakesReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type CategoryPreviewCakesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviewCakes  []*PreviewCake         `protobuf:"bytes,1,rep,name=previewCakes,proto3" json:"previewCakes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPreviewCakesRes) Reset() {
+	*x = CategoryPreviewCakesRes{}
+	mi := &file_cake_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPreviewCakesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPreviewCakesRes) ProtoMessage() {}
+
+func (x *CategoryPreviewCakesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPreviewCakesRes.ProtoReflect.Descriptor instead.
+func (*CategoryPreviewCakesRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CategoryPreviewCakesRes) GetPreviewCakes() []*PreviewCake {
+	if x != nil {
+		return x.PreviewCakes
+	}
+	return nil
+}
+
+func (x *CategoryPreviewCakesRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchCakesReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Query             string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                                           // Поисковая строка (пусто — без полнотекстового поиска)
+	MinPrice          *float64               `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`                             // Минимальная цена за кг (с учётом скидки)
+	MaxPrice          *float64               `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`                             // Максимальная цена за кг (с учётом скидки)
+	MinMass           *float64               `protobuf:"fixed64,4,opt,name=min_mass,json=minMass,proto3,oneof" json:"min_mass,omitempty"`                                // Минимальная масса
+	MaxMass           *float64               `protobuf:"fixed64,5,opt,name=max_mass,json=maxMass,proto3,oneof" json:"max_mass,omitempty"`                                // Максимальная масса
+	CategoryIds       []string               `protobuf:"bytes,6,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`                            // Торт входит хотя бы в одну из категорий
+	FillingIds        []string               `protobuf:"bytes,7,rep,name=filling_ids,json=fillingIds,proto3" json:"filling_ids,omitempty"`                               // Торт содержит хотя бы одну из начинок
+	ColorsHex         []string               `protobuf:"bytes,8,rep,name=colors_hex,json=colorsHex,proto3" json:"colors_hex,omitempty"`                                  // Торт содержит хотя бы один из цветов
+	OnlyOpenForSale   bool                   `protobuf:"varint,9,opt,name=only_open_for_sale,json=onlyOpenForSale,proto3" json:"only_open_for_sale,omitempty"`           // Только доступные для продажи
+	OnlyDiscounted    bool                   `protobuf:"varint,10,opt,name=only_discounted,json=onlyDiscounted,proto3" json:"only_discounted,omitempty"`                 // Только с действующей скидкой
+	Sort              CakeSort               `protobuf:"varint,11,opt,name=sort,proto3,enum=cake.CakeSort" json:"sort,omitempty"`                                        // Сортировка
+	PageSize          int32                  `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                   // Размер страницы (по умолчанию 20, максимум 100)
+	PageToken         string                 `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                 // next_page_token из предыдущего ответа
+	DeliveryAddressId *string                `protobuf:"bytes,14,opt,name=delivery_address_id,json=deliveryAddressId,proto3,oneof" json:"delivery_address_id,omitempty"` // Только продавцы, доставляющие на этот адрес пользователя (нужен токен)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchCakesReq) Reset() {
+	*x = SearchCakesReq{}
+	mi := &file_cake_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCakesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCakesReq) ProtoMessage() {}
+
+func (x *SearchCakesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCakesReq.ProtoReflect.Descriptor instead.
+func (*SearchCakesReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchCakesReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCakesReq) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchCakesReq) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchCakesReq) GetMinMass() float64 {
+	if x != nil && x.MinMass != nil {
+		return *x.MinMass
+	}
+	return 0
+}
+
+func (x *SearchCakesReq) GetMaxMass() float64 {
+	if x != nil && x.MaxMass != nil {
+		return *x.MaxMass
+	}
+	return 0
+}
+
+func (x *SearchCakesReq) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *SearchCakesReq) GetFillingIds() []string {
+	if x != nil {
+		return x.FillingIds
+	}
+	return nil
+}
+
+func (x *SearchCakesReq) GetColorsHex() []string {
+	if x != nil {
+		return x.ColorsHex
+	}
+	return nil
+}
+
+func (x *SearchCakesReq) GetOnlyOpenForSale() bool {
+	if x != nil {
+		return x.OnlyOpenForSale
+	}
+	return false
+}
+
+func (x *SearchCakesReq) GetOnlyDiscounted() bool {
+	if x != nil {
+		return x.OnlyDiscounted
+	}
+	return false
+}
+
+func (x *SearchCakesReq) GetSort() CakeSort {
+	if x != nil {
+		return x.Sort
+	}
+	return CakeSort_CAKE_SORT_RELEVANCE
+}
+
+func (x *SearchCakesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchCakesReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchCakesReq) GetDeliveryAddressId() string {
+	if x != nil && x.DeliveryAddressId != nil {
+		return *x.DeliveryAddressId
+	}
+	return ""
+}
+
+type SearchCakesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cakes         []*PreviewCake         `protobuf:"bytes,1,rep,name=cakes,proto3" json:"cakes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Пусто, если это последняя страница
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCakesRes) Reset() {
+	*x = SearchCakesRes{}
+	mi := &file_cake_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCakesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCakesRes) ProtoMessage() {}
+
+func (x *SearchCakesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCakesRes.ProtoReflect.Descriptor instead.
+func (*SearchCakesRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchCakesRes) GetCakes() []*PreviewCake {
+	if x != nil {
+		return x.Cakes
+	}
+	return nil
+}
+
+func (x *SearchCakesRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ############### NearbyCakes ###############
+type NearbyCakesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`                       // Широта точки доставки
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`                     // Долгота точки доставки
+	RadiusKm      *float64               `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3,oneof" json:"radius_km,omitempty"` // Радиус поиска продавцов; без него — продавцы, доставляющие в точку
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`        // Размер страницы (по умолчанию 20, максимум 100)
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`      // next_page_token из предыдущего ответа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyCakesReq) Reset() {
+	*x = NearbyCakesReq{}
+	mi := &file_cake_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyCakesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyCakesReq) ProtoMessage() {}
+
+func (x *NearbyCakesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyCakesReq.ProtoReflect.Descriptor instead.
+func (*NearbyCakesReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NearbyCakesReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *NearbyCakesReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *NearbyCakesReq) GetRadiusKm() float64 {
+	if x != nil && x.RadiusKm != nil {
+		return *x.RadiusKm
+	}
+	return 0
+}
+
+func (x *NearbyCakesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *NearbyCakesReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type NearbyCakesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cakes         []*PreviewCake         `protobuf:"bytes,1,rep,name=cakes,proto3" json:"cakes,omitempty"`                                        // Отсортированы по расстоянию до продавца
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Пусто, если это последняя страница
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyCakesRes) Reset() {
+	*x = NearbyCakesRes{}
+	mi := &file_cake_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyCakesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyCakesRes) ProtoMessage() {}
+
+func (x *NearbyCakesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyCakesRes.ProtoReflect.Descriptor instead.
+func (*NearbyCakesRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NearbyCakesRes) GetCakes() []*PreviewCake {
+	if x != nil {
+		return x.Cakes
+	}
+	return nil
+}
+
+func (x *NearbyCakesRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FavoriteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        FavoriteTarget         `protobuf:"varint,1,opt,name=target,proto3,enum=cake.FavoriteTarget" json:"target,omitempty"` // Что добавляем/удаляем
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                   // ID торта или продавца
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteReq) Reset() {
+	*x = FavoriteReq{}
+	mi := &file_cake_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteReq) ProtoMessage() {}
+
+func (x *FavoriteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteReq.ProtoReflect.Descriptor instead.
+func (*FavoriteReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FavoriteReq) GetTarget() FavoriteTarget {
+	if x != nil {
+		return x.Target
+	}
+	return FavoriteTarget_FAVORITE_TARGET_CAKE
+}
+
+func (x *FavoriteReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FavoritesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        FavoriteTarget         `protobuf:"varint,1,opt,name=target,proto3,enum=cake.FavoriteTarget" json:"target,omitempty"` // Какой список избранного нужен
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`      // Размер страницы (по умолчанию 20, максимум 100)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`    // next_page_token из предыдущего ответа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoritesReq) Reset() {
+	*x = FavoritesReq{}
+	mi := &file_cake_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoritesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoritesReq) ProtoMessage() {}
+
+func (x *FavoritesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoritesReq.ProtoReflect.Descriptor instead.
+func (*FavoritesReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FavoritesReq) GetTarget() FavoriteTarget {
+	if x != nil {
+		return x.Target
+	}
+	return FavoriteTarget_FAVORITE_TARGET_CAKE
+}
+
+func (x *FavoritesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FavoritesReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type FavoritesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cakes         []*PreviewCake         `protobuf:"bytes,1,rep,name=cakes,proto3" json:"cakes,omitempty"`                                        // Избранные торты (для FAVORITE_TARGET_CAKE), сначала добавленные последними
+	Sellers       []*User                `protobuf:"bytes,2,rep,name=sellers,proto3" json:"sellers,omitempty"`                                    // Избранные продавцы (для FAVORITE_TARGET_SELLER), сначала добавленные последними
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Пусто, если это последняя страница
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoritesRes) Reset() {
+	*x = FavoritesRes{}
+	mi := &file_cake_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoritesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoritesRes) ProtoMessage() {}
+
+func (x *FavoritesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoritesRes.ProtoReflect.Descriptor instead.
+func (*FavoritesRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FavoritesRes) GetCakes() []*PreviewCake {
+	if x != nil {
+		return x.Cakes
+	}
+	return nil
+}
+
+func (x *FavoritesRes) GetSellers() []*User {
+	if x != nil {
+		return x.Sellers
+	}
+	return nil
+}
+
+func (x *FavoritesRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Акция продавца. Без cake_id и category_id действует на весь ассортимент продавца
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CakeId        *string                `protobuf:"bytes,2,opt,name=cake_id,json=cakeId,proto3,oneof" json:"cake_id,omitempty"`             // Торт продавца
+	CategoryId    *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"` // Категория: торты продавца из неё
+	Kind          DiscountKind           `protobuf:"varint,4,opt,name=kind,proto3,enum=cake.DiscountKind" json:"kind,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`                     // Процент (0-100) или сумма
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // Начало действия
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`       // Окончание действия
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=cancelled_at,json=cancelledAt,proto3,oneof" json:"cancelled_at,omitempty"` // Время отмены
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_cake_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Promotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Promotion) GetCakeId() string {
+	if x != nil && x.CakeId != nil {
+		return *x.CakeId
+	}
+	return ""
+}
+
+func (x *Promotion) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *Promotion) GetKind() DiscountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscountKind_DISCOUNT_KIND_PERCENT
+}
+
+func (x *Promotion) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Promotion) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type CreatePromotionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CakeId        *string                `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3,oneof" json:"cake_id,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Kind          DiscountKind           `protobuf:"varint,3,opt,name=kind,proto3,enum=cake.DiscountKind" json:"kind,omitempty"`
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // Нет — с текущего момента
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionReq) Reset() {
+	*x = CreatePromotionReq{}
+	mi := &file_cake_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionReq) ProtoMessage() {}
+
+func (x *CreatePromotionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionReq.ProtoReflect.Descriptor instead.
+func (*CreatePromotionReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreatePromotionReq) GetCakeId() string {
+	if x != nil && x.CakeId != nil {
+		return *x.CakeId
+	}
+	return ""
+}
+
+func (x *CreatePromotionReq) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreatePromotionReq) GetKind() DiscountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscountKind_DISCOUNT_KIND_PERCENT
+}
+
+func (x *CreatePromotionReq) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreatePromotionReq) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotionReq) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type CreatePromotionRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRes) Reset() {
+	*x = CreatePromotionRes{}
+	mi := &file_cake_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRes) ProtoMessage() {}
+
+func (x *CreatePromotionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRes.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreatePromotionRes) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type PromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionRequest) Reset() {
+	*x = PromotionRequest{}
+	mi := &file_cake_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRequest) ProtoMessage() {}
+
+func (x *PromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRequest.ProtoReflect.Descriptor instead.
+func (*PromotionRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PromotionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PromotionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Размер страницы (по умолчанию 20, максимум 100)
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token из предыдущего ответа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionsReq) Reset() {
+	*x = PromotionsReq{}
+	mi := &file_cake_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionsReq) ProtoMessage() {}
+
+func (x *PromotionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionsReq.ProtoReflect.Descriptor instead.
+func (*PromotionsReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PromotionsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PromotionsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type PromotionsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"` // Акции продавца, сначала новые (включая завершённые и отменённые)
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionsRes) Reset() {
+	*x = PromotionsRes{}
+	mi := &file_cake_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionsRes) ProtoMessage() {}
+
+func (x *PromotionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionsRes.ProtoReflect.Descriptor instead.
+func (*PromotionsRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PromotionsRes) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *PromotionsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ############### PromoCodes ###############
+type PromoCode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // Код (регистр не важен)
+	Kind           DiscountKind           `protobuf:"varint,3,opt,name=kind,proto3,enum=cake.DiscountKind" json:"kind,omitempty"`
+	Value          float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"` // Процент (0-100) или сумма скидки на заказ
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxUses        *int32                 `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`                    // Общий лимит применений (нет — без ограничения)
+	MaxUsesPerUser int32                  `protobuf:"varint,8,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"` // Лимит применений одним пользователем
+	UsesCount      int32                  `protobuf:"varint,9,opt,name=uses_count,json=usesCount,proto3" json:"uses_count,omitempty"`                    // Сколько раз применён
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CancelledAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=cancelled_at,json=cancelledAt,proto3,oneof" json:"cancelled_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_cake_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PromoCode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetKind() DiscountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscountKind_DISCOUNT_KIND_PERCENT
+}
+
+func (x *PromoCode) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PromoCode) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *PromoCode) GetUsesCount() int32 {
+	if x != nil {
+		return x.UsesCount
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type CreatePromoCodeReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // 3-32 символа: латиница, цифры, '-' и '_'
+	Kind           DiscountKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=cake.DiscountKind" json:"kind,omitempty"`
+	Value          float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // Нет — с текущего момента
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxUses        *int32                 `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`
+	MaxUsesPerUser *int32                 `protobuf:"varint,7,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3,oneof" json:"max_uses_per_user,omitempty"` // По умолчанию 1
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeReq) Reset() {
+	*x = CreatePromoCodeReq{}
+	mi := &file_cake_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeReq) ProtoMessage() {}
+
+func (x *CreatePromoCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeReq.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreatePromoCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeReq) GetKind() DiscountKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiscountKind_DISCOUNT_KIND_PERCENT
+}
+
+func (x *CreatePromoCodeReq) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeReq) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeReq) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeReq) GetMaxUsesPerUser() int32 {
+	if x != nil && x.MaxUsesPerUser != nil {
+		return *x.MaxUsesPerUser
+	}
+	return 0
+}
+
+type CreatePromoCodeRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRes) Reset() {
+	*x = CreatePromoCodeRes{}
+	mi := &file_cake_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRes) ProtoMessage() {}
+
+func (x *CreatePromoCodeRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRes.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreatePromoCodeRes) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type PromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCodeRequest) Reset() {
+	*x = PromoCodeRequest{}
+	mi := &file_cake_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodeRequest) ProtoMessage() {}
+
+func (x *PromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*PromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PromoCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PromoCodesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCodesReq) Reset() {
+	*x = PromoCodesReq{}
+	mi := &file_cake_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodesReq) ProtoMessage() {}
+
+func (x *PromoCodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodesReq.ProtoReflect.Descriptor instead.
+func (*PromoCodesReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PromoCodesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PromoCodesReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type PromoCodesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodes    []*PromoCode           `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"` // Промокоды продавца, сначала новые
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCodesRes) Reset() {
+	*x = PromoCodesRes{}
+	mi := &file_cake_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCodesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodesRes) ProtoMessage() {}
+
+func (x *PromoCodesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodesRes.ProtoReflect.Descriptor instead.
+func (*PromoCodesRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PromoCodesRes) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+func (x *PromoCodesRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Группа опций торта. Наценки — фиксированные суммы к стоимости заказа
+type CakeOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          CakeOptionKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=cake.CakeOptionKind" json:"kind,omitempty"`
+	IsRequired    bool                   `protobuf:"varint,4,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`                  // Покупатель обязан выбрать опцию
+	PriceModifier float64                `protobuf:"fixed64,5,opt,name=price_modifier,json=priceModifier,proto3" json:"price_modifier,omitempty"`        // Наценка за текст (только для CAKE_OPTION_KIND_TEXT)
+	MaxTextLength *int32                 `protobuf:"varint,6,opt,name=max_text_length,json=maxTextLength,proto3,oneof" json:"max_text_length,omitempty"` // Максимальная длина текста (только для CAKE_OPTION_KIND_TEXT)
+	Values        []*CakeOptionValue     `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`                                             // Значения (кроме CAKE_OPTION_KIND_TEXT)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CakeOption) Reset() {
+	*x = CakeOption{}
+	mi := &file_cake_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CakeOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CakeOption) ProtoMessage() {}
+
+func (x *CakeOption) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CakeOption.ProtoReflect.Descriptor instead.
+func (*CakeOption) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CakeOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CakeOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CakeOption) GetKind() CakeOptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CakeOptionKind_CAKE_OPTION_KIND_SINGLE
+}
+
+func (x *CakeOption) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *CakeOption) GetPriceModifier() float64 {
+	if x != nil {
+		return x.PriceModifier
+	}
+	return 0
+}
+
+func (x *CakeOption) GetMaxTextLength() int32 {
+	if x != nil && x.MaxTextLength != nil {
+		return *x.MaxTextLength
+	}
+	return 0
+}
+
+func (x *CakeOption) GetValues() []*CakeOptionValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CakeOptionValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PriceModifier float64                `protobuf:"fixed64,3,opt,name=price_modifier,json=priceModifier,proto3" json:"price_modifier,omitempty"` // Наценка за значение
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CakeOptionValue) Reset() {
+	*x = CakeOptionValue{}
+	mi := &file_cake_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CakeOptionValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CakeOptionValue) ProtoMessage() {}
+
+func (x *CakeOptionValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CakeOptionValue.ProtoReflect.Descriptor instead.
+func (*CakeOptionValue) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CakeOptionValue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CakeOptionValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CakeOptionValue) GetPriceModifier() float64 {
+	if x != nil {
+		return x.PriceModifier
+	}
+	return 0
+}
+
+type CakeOptionInput struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          CakeOptionKind          `protobuf:"varint,2,opt,name=kind,proto3,enum=cake.CakeOptionKind" json:"kind,omitempty"`
+	IsRequired    bool                    `protobuf:"varint,3,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	PriceModifier float64                 `protobuf:"fixed64,4,opt,name=price_modifier,json=priceModifier,proto3" json:"price_modifier,omitempty"`
+	MaxTextLength *int32                  `protobuf:"varint,5,opt,name=max_text_length,json=maxTextLength,proto3,oneof" json:"max_text_length,omitempty"`
+	Values        []*CakeOptionValueInput `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"` // Порядок значений сохраняется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CakeOptionInput) Reset() {
+	*x = CakeOptionInput{}
+	mi := &file_cake_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CakeOptionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CakeOptionInput) ProtoMessage() {}
+
+func (x *CakeOptionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CakeOptionInput.ProtoReflect.Descriptor instead.
+func (*CakeOptionInput) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CakeOptionInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CakeOptionInput) GetKind() CakeOptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CakeOptionKind_CAKE_OPTION_KIND_SINGLE
+}
+
+func (x *CakeOptionInput) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *CakeOptionInput) GetPriceModifier() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PriceModifier
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{7}
+func (x *CakeOptionInput) GetMaxTextLength() int32 {
+	if x != nil && x.MaxTextLength != nil {
+		return *x.MaxTextLength
+	}
+	return 0
 }
 
-func (x *CreateCategoryResponse) GetCategory() *Category {
+func (x *CakeOptionInput) GetValues() []*CakeOptionValueInput {
 	if x != nil {
-		return x.Category
+		return x.Values
 	}
 	return nil
 }
 
-// ############### Categories ###############
-type CategoriesResponse struct {
+type CakeOptionValueInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"` // При обновлении: ID существующего значения (нет — новое значение)
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PriceModifier float64                `protobuf:"fixed64,3,opt,name=price_modifier,json=priceModifier,proto3" json:"price_modifier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoriesResponse) Reset() {
-	*x = CategoriesResponse{}
-	mi := &file_cake_proto_msgTypes[8]
+func (x *CakeOptionValueInput) Reset() {
+	*x = CakeOptionValueInput{}
+	mi := &file_cake_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoriesResponse) String() string {
+func (x *CakeOptionValueInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoriesResponse) ProtoMessage() {}
+func (*CakeOptionValueInput) ProtoMessage() {}
 
-func (x *CategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[8]
+func (x *CakeOptionValueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,41 +3642,55 @@ func (x *CategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoriesResponse.ProtoReflect.Descriptor instead.
-func (*CategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CakeOptionValueInput.ProtoReflect.Descriptor instead.
+func (*CakeOptionValueInput) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CategoriesResponse) GetCategories() []*Category {
+func (x *CakeOptionValueInput) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CakeOptionValueInput) GetName() string {
 	if x != nil {
-		return x.Categories
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// ############### Fillings ###############
-type FillingsResponse struct {
+func (x *CakeOptionValueInput) GetPriceModifier() float64 {
+	if x != nil {
+		return x.PriceModifier
+	}
+	return 0
+}
+
+type CreateCakeOptionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fillings      []*Filling             `protobuf:"bytes,1,rep,name=fillings,proto3" json:"fillings,omitempty"`
+	CakeId        string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
+	Option        *CakeOptionInput       `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"` // Добавляется в конец списка опций торта
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FillingsResponse) Reset() {
-	*x = FillingsResponse{}
-	mi := &file_cake_proto_msgTypes[9]
+func (x *CreateCakeOptionReq) Reset() {
+	*x = CreateCakeOptionReq{}
+	mi := &file_cake_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FillingsResponse) String() string {
+func (x *CreateCakeOptionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FillingsResponse) ProtoMessage() {}
+func (*CreateCakeOptionReq) ProtoMessage() {}
 
-func (x *FillingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[9]
+func (x *CreateCakeOptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,41 +3701,49 @@ func (x *FillingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FillingsResponse.ProtoReflect.Descriptor instead.
-func (*FillingsResponse) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateCakeOptionReq.ProtoReflect.Descriptor instead.
+func (*CreateCakeOptionReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *FillingsResponse) GetFillings() []*Filling {
+func (x *CreateCakeOptionReq) GetCakeId() string {
 	if x != nil {
-		return x.Fillings
+		return x.CakeId
+	}
+	return ""
+}
+
+func (x *CreateCakeOptionReq) GetOption() *CakeOptionInput {
+	if x != nil {
+		return x.Option
 	}
 	return nil
 }
 
-// ############### CakesResponse ###############
-type CakesResponse struct {
+// Обновление заменяет группу целиком: значения, которых нет в запросе, удаляются
+type UpdateCakeOptionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cakes         []*PreviewCake         `protobuf:"bytes,1,rep,name=cakes,proto3" json:"cakes,omitempty"`
+	OptionId      string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Option        *CakeOptionInput       `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CakesResponse) Reset() {
-	*x = CakesResponse{}
-	mi := &file_cake_proto_msgTypes[10]
+func (x *UpdateCakeOptionReq) Reset() {
+	*x = UpdateCakeOptionReq{}
+	mi := &file_cake_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CakesResponse) String() string {
+func (x *UpdateCakeOptionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CakesResponse) ProtoMessage() {}
+func (*UpdateCakeOptionReq) ProtoMessage() {}
 
-func (x *CakesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[10]
+func (x *UpdateCakeOptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,41 +3754,47 @@ func (x *CakesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CakesResponse.ProtoReflect.Descriptor instead.
-func (*CakesResponse) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateCakeOptionReq.ProtoReflect.Descriptor instead.
+func (*UpdateCakeOptionReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CakesResponse) GetCakes() []*PreviewCake {
+func (x *UpdateCakeOptionReq) GetOptionId() string {
 	if x != nil {
-		return x.Cakes
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *UpdateCakeOptionReq) GetOption() *CakeOptionInput {
+	if x != nil {
+		return x.Option
 	}
 	return nil
 }
 
-// ############### GetCategoriesByGenderName ###############
-type GetCategoriesByGenderNameReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CategoryGender CategoryGender         `protobuf:"varint,1,opt,name=categoryGender,proto3,enum=cake.CategoryGender" json:"categoryGender,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CakeOptionRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        *CakeOption            `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoriesByGenderNameReq) Reset() {
-	*x = GetCategoriesByGenderNameReq{}
-	mi := &file_cake_proto_msgTypes[11]
+func (x *CakeOptionRes) Reset() {
+	*x = CakeOptionRes{}
+	mi := &file_cake_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoriesByGenderNameReq) String() string {
+func (x *CakeOptionRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesByGenderNameReq) ProtoMessage() {}
+func (*CakeOptionRes) ProtoMessage() {}
 
-func (x *GetCategoriesByGenderNameReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[11]
+func (x *CakeOptionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,40 +3805,40 @@ func (x *GetCategoriesByGenderNameReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesByGenderNameReq.ProtoReflect.Descriptor instead.
-func (*GetCategoriesByGenderNameReq) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CakeOptionRes.ProtoReflect.Descriptor instead.
+func (*CakeOptionRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetCategoriesByGenderNameReq) GetCategoryGender() CategoryGender {
+func (x *CakeOptionRes) GetOption() *CakeOption {
 	if x != nil {
-		return x.CategoryGender
+		return x.Option
 	}
-	return CategoryGender_CATEGORY_GENDER_UNSPECIFIED
+	return nil
 }
 
-type GetCategoriesByGenderNameRes struct {
+type CakeOptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	OptionId      string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoriesByGenderNameRes) Reset() {
-	*x = GetCategoriesByGenderNameRes{}
-	mi := &file_cake_proto_msgTypes[12]
+func (x *CakeOptionRequest) Reset() {
+	*x = CakeOptionRequest{}
+	mi := &file_cake_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoriesByGenderNameRes) String() string {
+func (x *CakeOptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesByGenderNameRes) ProtoMessage() {}
+func (*CakeOptionRequest) ProtoMessage() {}
 
-func (x *GetCategoriesByGenderNameRes) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[12]
+func (x *CakeOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,41 +3849,40 @@ func (x *GetCategoriesByGenderNameRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesByGenderNameRes.ProtoReflect.Descriptor instead.
-func (*GetCategoriesByGenderNameRes) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CakeOptionRequest.ProtoReflect.Descriptor instead.
+func (*CakeOptionRequest) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetCategoriesByGenderNameRes) GetCategories() []*Category {
+func (x *CakeOptionRequest) GetOptionId() string {
 	if x != nil {
-		return x.Categories
+		return x.OptionId
 	}
-	return nil
+	return ""
 }
 
-// ############### CategoryPreviewCakes ###############
-type CategoryPreviewCakesReq struct {
+type CakeOptionsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryID    string                 `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	CakeId        string                 `protobuf:"bytes,1,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryPreviewCakesReq) Reset() {
-	*x = CategoryPreviewCakesReq{}
-	mi := &file_cake_proto_msgTypes[13]
+func (x *CakeOptionsReq) Reset() {
+	*x = CakeOptionsReq{}
+	mi := &file_cake_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryPreviewCakesReq) String() string {
+func (x *CakeOptionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryPreviewCakesReq) ProtoMessage() {}
+func (*CakeOptionsReq) ProtoMessage() {}
 
-func (x *CategoryPreviewCakesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[13]
+func (x *CakeOptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,40 +3893,40 @@ func (x *CategoryPreviewCakesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryPreviewCakesReq.ProtoReflect.Descriptor instead.
-func (*CategoryPreviewCakesReq) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CakeOptionsReq.ProtoReflect.Descriptor instead.
+func (*CakeOptionsReq) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CategoryPreviewCakesReq) GetCategoryID() string {
+func (x *CakeOptionsReq) GetCakeId() string {
 	if x != nil {
-		return x.CategoryID
+		return x.CakeId
 	}
 	return ""
 }
 
-type CategoryPreviewCakesRes struct {
+type CakeOptionsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PreviewCakes  []*PreviewCake         `protobuf:"bytes,1,rep,name=previewCakes,proto3" json:"previewCakes,omitempty"`
+	Options       []*CakeOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"` // Опции торта по порядку
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryPreviewCakesRes) Reset() {
-	*x = CategoryPreviewCakesRes{}
-	mi := &file_cake_proto_msgTypes[14]
+func (x *CakeOptionsRes) Reset() {
+	*x = CakeOptionsRes{}
+	mi := &file_cake_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryPreviewCakesRes) String() string {
+func (x *CakeOptionsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryPreviewCakesRes) ProtoMessage() {}
+func (*CakeOptionsRes) ProtoMessage() {}
 
-func (x *CategoryPreviewCakesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[14]
+func (x *CakeOptionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_cake_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,14 +3937,14 @@ func (x *CategoryPreviewCakesRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryPreviewCakesRes.ProtoReflect.Descriptor instead.
-func (*CategoryPreviewCakesRes) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CakeOptionsRes.ProtoReflect.Descriptor instead.
+func (*CakeOptionsRes) Descriptor() ([]byte, []int) {
+	return file_cake_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *CategoryPreviewCakesRes) GetPreviewCakes() []*PreviewCake {
+func (x *CakeOptionsRes) GetOptions() []*CakeOption {
 	if x != nil {
-		return x.PreviewCakes
+		return x.Options
 	}
 	return nil
 }
@@ -875,7 +3959,7 @@ type AddCakeColorsReq struct {
 
 func (x *AddCakeColorsReq) Reset() {
 	*x = AddCakeColorsReq{}
-	mi := &file_cake_proto_msgTypes[15]
+	mi := &file_cake_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +3971,7 @@ func (x *AddCakeColorsReq) String() string {
 func (*AddCakeColorsReq) ProtoMessage() {}
 
 func (x *AddCakeColorsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[15]
+	mi := &file_cake_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +3984,7 @@ func (x *AddCakeColorsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCakeColorsReq.ProtoReflect.Descriptor instead.
 func (*AddCakeColorsReq) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{15}
+	return file_cake_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *AddCakeColorsReq) GetCakeID() string {
@@ -926,7 +4010,7 @@ type CakeColorsRes struct {
 
 func (x *CakeColorsRes) Reset() {
 	*x = CakeColorsRes{}
-	mi := &file_cake_proto_msgTypes[16]
+	mi := &file_cake_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -938,7 +4022,7 @@ func (x *CakeColorsRes) String() string {
 func (*CakeColorsRes) ProtoMessage() {}
 
 func (x *CakeColorsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[16]
+	mi := &file_cake_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +4035,7 @@ func (x *CakeColorsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CakeColorsRes.ProtoReflect.Descriptor instead.
 func (*CakeColorsRes) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{16}
+	return file_cake_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *CakeColorsRes) GetColorsHex() []string {
@@ -968,7 +4052,6 @@ type Cake struct {
 	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                         // Название торта
 	ImageUrl        string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                                 // URL изображения торта
 	KgPrice         float64                `protobuf:"fixed64,4,opt,name=kg_price,json=kgPrice,proto3" json:"kg_price,omitempty"`                                  // Цена за кг
-	Rating          int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`                                                    // Рейтинг (0-5)
 	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                           // Описание торта
 	Mass            float64                `protobuf:"fixed64,7,opt,name=mass,proto3" json:"mass,omitempty"`                                                       // Масса торта
 	IsOpenForSale   bool                   `protobuf:"varint,8,opt,name=is_open_for_sale,json=isOpenForSale,proto3" json:"is_open_for_sale,omitempty"`             // Доступен ли для продажи
@@ -980,13 +4063,16 @@ type Cake struct {
 	DateCreation    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=date_creation,json=dateCreation,proto3" json:"date_creation,omitempty"`                    // Дата создания торта (ISO 8601)
 	Images          []*Cake_CakeImage      `protobuf:"bytes,15,rep,name=images,proto3" json:"images,omitempty"`                                                    // Фотографии торта
 	ReviewsCount    int32                  `protobuf:"varint,16,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`                                       // Число отзывов
+	Rating          float64                `protobuf:"fixed64,17,opt,name=rating,proto3" json:"rating,omitempty"`                                                  // Средний рейтинг (0-5)
+	FavoritesCount  int32                  `protobuf:"varint,18,opt,name=favorites_count,json=favoritesCount,proto3" json:"favorites_count,omitempty"`             // Сколько пользователей добавили торт в избранное
+	Options         []*CakeOption          `protobuf:"bytes,19,rep,name=options,proto3" json:"options,omitempty"`                                                  // Опции торта (ярусы, надпись, декор)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Cake) Reset() {
 	*x = Cake{}
-	mi := &file_cake_proto_msgTypes[17]
+	mi := &file_cake_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +4084,7 @@ func (x *Cake) String() string {
 func (*Cake) ProtoMessage() {}
 
 func (x *Cake) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[17]
+	mi := &file_cake_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +4097,7 @@ func (x *Cake) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cake.ProtoReflect.Descriptor instead.
 func (*Cake) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{17}
+	return file_cake_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *Cake) GetId() string {
@@ -1042,13 +4128,6 @@ func (x *Cake) GetKgPrice() float64 {
 	return 0
 }
 
-func (x *Cake) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
 func (x *Cake) GetDescription() string {
 	if x != nil {
 		return x.Description
@@ -1126,6 +4205,27 @@ func (x *Cake) GetReviewsCount() int32 {
 	return 0
 }
 
+func (x *Cake) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Cake) GetFavoritesCount() int32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *Cake) GetOptions() []*CakeOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 // Информация о владельце
 type User struct {
 	state          protoimpl.MessageState  `protogen:"open.v1"`
@@ -1143,7 +4243,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_cake_proto_msgTypes[18]
+	mi := &file_cake_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1155,7 +4255,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[18]
+	mi := &file_cake_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +4268,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{18}
+	return file_cake_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *User) GetId() string {
@@ -1230,19 +4330,20 @@ func (x *User) GetHeaderImageURL() *wrapperspb.StringValue {
 // Информация о начинке
 type Filling struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID начинки
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                         // Название начинки
-	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // URL изображения начинки
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                   // Состав начинки
-	KgPrice       float64                `protobuf:"fixed64,5,opt,name=kg_price,json=kgPrice,proto3" json:"kg_price,omitempty"`  // Цена за кг
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`           // Описание начинки
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID начинки
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // Название начинки
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`    // URL изображения начинки
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                      // Состав начинки
+	KgPrice       float64                `protobuf:"fixed64,5,opt,name=kg_price,json=kgPrice,proto3" json:"kg_price,omitempty"`     // Цена за кг
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`              // Описание начинки
+	OwnerId       *string                `protobuf:"bytes,7,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"` // Владелец (нет — общая начинка платформы)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Filling) Reset() {
 	*x = Filling{}
-	mi := &file_cake_proto_msgTypes[19]
+	mi := &file_cake_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1254,7 +4355,7 @@ func (x *Filling) String() string {
 func (*Filling) ProtoMessage() {}
 
 func (x *Filling) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[19]
+	mi := &file_cake_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +4368,7 @@ func (x *Filling) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filling.ProtoReflect.Descriptor instead.
 func (*Filling) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{19}
+	return file_cake_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *Filling) GetId() string {
@@ -1312,6 +4413,13 @@ func (x *Filling) GetDescription() string {
 	return ""
 }
 
+func (x *Filling) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
 // Информация о категории
 type Category struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1325,7 +4433,7 @@ type Category struct {
 
 func (x *Category) Reset() {
 	*x = Category{}
-	mi := &file_cake_proto_msgTypes[20]
+	mi := &file_cake_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1337,7 +4445,7 @@ func (x *Category) String() string {
 func (*Category) ProtoMessage() {}
 
 func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[20]
+	mi := &file_cake_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,7 +4458,7 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Category.ProtoReflect.Descriptor instead.
 func (*Category) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{20}
+	return file_cake_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *Category) GetId() string {
@@ -1387,7 +4495,6 @@ type PreviewCake struct {
 	Name            string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                // Название
 	PreviewImageUrl string                  `protobuf:"bytes,3,opt,name=preview_image_url,json=previewImageUrl,proto3" json:"preview_image_url,omitempty"` // URL изображения
 	KgPrice         float64                 `protobuf:"fixed64,4,opt,name=kg_price,json=kgPrice,proto3" json:"kg_price,omitempty"`                         // Цена за килограмм
-	Rating          uint32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`                                           // Рейтинг
 	Description     *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                  // Описание (nullable)
 	Mass            float64                 `protobuf:"fixed64,7,opt,name=mass,proto3" json:"mass,omitempty"`                                              // Масса
 	DiscountKgPrice *wrapperspb.DoubleValue `protobuf:"bytes,8,opt,name=discount_kg_price,json=discountKgPrice,proto3" json:"discount_kg_price,omitempty"` // Скидочная цена за кг (nullable)
@@ -1397,13 +4504,17 @@ type PreviewCake struct {
 	Owner           *User                   `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`                                             // Владелец
 	ReviewsCount    int32                   `protobuf:"varint,13,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`                              // Число отзывов
 	ColorsHex       []string                `protobuf:"bytes,14,rep,name=colorsHex,proto3" json:"colorsHex,omitempty"`                                     // Hex цвета торта
+	Rating          float64                 `protobuf:"fixed64,15,opt,name=rating,proto3" json:"rating,omitempty"`                                         // Средний рейтинг (0-5)
+	DistanceKm      *float64                `protobuf:"fixed64,16,opt,name=distance_km,json=distanceKm,proto3,oneof" json:"distance_km,omitempty"`         // Расстояние до продавца (только в гео-поиске)
+	IsFavorite      bool                    `protobuf:"varint,17,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`                // Торт в избранном у пользователя (false без авторизации)
+	FavoritesCount  int32                   `protobuf:"varint,18,opt,name=favorites_count,json=favoritesCount,proto3" json:"favorites_count,omitempty"`    // Сколько пользователей добавили торт в избранное
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PreviewCake) Reset() {
 	*x = PreviewCake{}
-	mi := &file_cake_proto_msgTypes[21]
+	mi := &file_cake_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1415,7 +4526,7 @@ func (x *PreviewCake) String() string {
 func (*PreviewCake) ProtoMessage() {}
 
 func (x *PreviewCake) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[21]
+	mi := &file_cake_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +4539,7 @@ func (x *PreviewCake) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreviewCake.ProtoReflect.Descriptor instead.
 func (*PreviewCake) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{21}
+	return file_cake_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *PreviewCake) GetId() string {
@@ -1459,13 +4570,6 @@ func (x *PreviewCake) GetKgPrice() float64 {
 	return 0
 }
 
-func (x *PreviewCake) GetRating() uint32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
 func (x *PreviewCake) GetDescription() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Description
@@ -1529,6 +4633,34 @@ func (x *PreviewCake) GetColorsHex() []string {
 	return nil
 }
 
+func (x *PreviewCake) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PreviewCake) GetDistanceKm() float64 {
+	if x != nil && x.DistanceKm != nil {
+		return *x.DistanceKm
+	}
+	return 0
+}
+
+func (x *PreviewCake) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *PreviewCake) GetFavoritesCount() int32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
 type Cake_CakeImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1539,7 +4671,7 @@ type Cake_CakeImage struct {
 
 func (x *Cake_CakeImage) Reset() {
 	*x = Cake_CakeImage{}
-	mi := &file_cake_proto_msgTypes[22]
+	mi := &file_cake_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1551,7 +4683,7 @@ func (x *Cake_CakeImage) String() string {
 func (*Cake_CakeImage) ProtoMessage() {}
 
 func (x *Cake_CakeImage) ProtoReflect() protoreflect.Message {
-	mi := &file_cake_proto_msgTypes[22]
+	mi := &file_cake_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,7 +4696,7 @@ func (x *Cake_CakeImage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cake_CakeImage.ProtoReflect.Descriptor instead.
 func (*Cake_CakeImage) Descriptor() ([]byte, []int) {
-	return file_cake_proto_rawDescGZIP(), []int{17, 0}
+	return file_cake_proto_rawDescGZIP(), []int{63, 0}
 }
 
 func (x *Cake_CakeImage) GetId() string {
@@ -1630,250 +4762,826 @@ var file_cake_proto_rawDesc = string([]byte{
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2d,
 	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x22, 0xa0, 0x01,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x40, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x66, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x61, 0x6b,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x22, 0x4a, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x22, 0xf1, 0x03,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x01, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x6b, 0x67,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
+	0x04, 0x6d, 0x61, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b,
+	0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x11, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x06, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x6d, 0x61, 0x73, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x34, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x61, 0x6b, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b,
+	0x65, 0x52, 0x04, 0x63, 0x61, 0x6b, 0x65, 0x22, 0x5c, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x61,
+	0x6b, 0x65, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10,
+	0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6f,
+	0x72, 0x53, 0x61, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6b, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x4c,
+	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x18,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x42,
+	0x0a, 0x12, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65,
+	0x2e, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x44,
-	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b,
-	0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x10, 0x46, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
-	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x40, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0d, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52,
-	0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x0d, 0x43, 0x61, 0x6b,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61,
-	0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65,
-	0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x63, 0x61,
-	0x6b, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61,
-	0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x22, 0x4e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x39, 0x0a, 0x17, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x17,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
-	0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65,
-	0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x48,
-	0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
-	0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x22, 0x2d, 0x0a, 0x0d, 0x43, 0x61, 0x6b, 0x65,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
-	0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x22, 0xcd, 0x05, 0x0a, 0x04, 0x43, 0x61, 0x6b, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
-	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x69, 0x73,
-	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x53,
-	0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x2f, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x67, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x4b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f,
-	0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x38, 0x0a, 0x09, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x2e, 0x0a, 0x03, 0x66, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x66, 0x69, 0x6f,
-	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x36, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x08,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x07, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x99, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08,
+	0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03,
+	0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6b, 0x67, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x2f, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52,
+	0x0a, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x22, 0xc8, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x09, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x67, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x54, 0x61, 0x67, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a, 0x12,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x22, 0x32, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x11, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x65, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4a, 0x0a, 0x0c, 0x43,
+	0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x0d, 0x43, 0x61, 0x6b, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x6b, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x63, 0x61, 0x6b, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x17, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78,
+	0x0a, 0x17, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61,
+	0x6b, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc6, 0x04, 0x0a, 0x0e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x4d, 0x61,
+	0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4d, 0x61,
+	0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x73, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x12, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6f,
+	0x72, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x6f, 0x6e, 0x6c, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63,
+	0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33,
+	0x0a, 0x13, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x11, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x22, 0x61, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x6b, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x63, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x43,
+	0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x22, 0x61, 0x0a,
+	0x0e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x05, 0x63, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b,
+	0x65, 0x52, 0x05, 0x63, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x4b, 0x0a, 0x0b, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x2c, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x78, 0x0a,
+	0x0c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x6b, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x63, 0x61, 0x6b, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xb7, 0x03, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x44, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0b, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x22, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x68, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe8, 0x03, 0x0a,
+	0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63,
+	0x61, 0x6b, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x22, 0xa1, 0x01, 0x0a,
-	0x07, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65,
+	0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x4b, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x69,
+	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x92, 0x02, 0x0a, 0x0a, 0x43, 0x61,
+	0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x82, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x35,
-	0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x54, 0x61, 0x67, 0x73, 0x22, 0xc4, 0x04, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54, 0x65,
+	0x78, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x5c,
+	0x0a, 0x0f, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x8c, 0x02, 0x0a,
+	0x0f, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54, 0x65, 0x78, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x6d, 0x0a, 0x14, 0x43,
+	0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x0d,
+	0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x11, 0x43, 0x61, 0x6b, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0e, 0x43, 0x61, 0x6b,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61,
+	0x6b, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x0e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x48, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x22, 0x2d, 0x0a, 0x0d,
+	0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x22, 0xa8, 0x06, 0x0a, 0x04,
+	0x43, 0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
 	0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63,
 	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x11,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x11,
 	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b,
-	0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a,
+	0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f,
-	0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x38, 0x0a, 0x09, 0x43, 0x61,
+	0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xe2, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2e, 0x0a, 0x03, 0x66, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x66, 0x69, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x36, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x44, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x22, 0xce, 0x01, 0x0a, 0x07,
+	0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x82, 0x01, 0x0a,
+	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x35, 0x0a, 0x0b, 0x67, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x73, 0x22, 0xca, 0x05, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73,
+	0x12, 0x48, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x67, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4b, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x27, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73,
-	0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4f, 0x70, 0x65,
-	0x6e, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x2a, 0x52, 0x0a, 0x0e,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1f,
-	0x0a, 0x1b, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x47, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x4d,
-	0x41, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x03,
-	0x32, 0xfc, 0x05, 0x0a, 0x0b, 0x43, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x17,
-	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x04, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65,
-	0x2e, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63,
-	0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x05, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x70, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69,
+	0x73, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x48, 0x65, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x2a, 0xb4,
+	0x01, 0x0a, 0x08, 0x43, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x43,
+	0x41, 0x4b, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e,
+	0x43, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x41, 0x4b, 0x45, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a,
+	0x10, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53,
+	0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x50, 0x4f, 0x50, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x05, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x41,
+	0x4e, 0x43, 0x45, 0x10, 0x06, 0x2a, 0x46, 0x0a, 0x0e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x41, 0x56, 0x4f, 0x52,
+	0x49, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x4b, 0x45, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x42, 0x0a,
+	0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x15, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10,
+	0x01, 0x2a, 0x67, 0x0a, 0x0e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x43, 0x41, 0x4b, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x2a, 0x52, 0x0a, 0x0e, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x1b,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x4d, 0x41, 0x4c,
+	0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x03, 0x32, 0xe5,
+	0x12, 0x0a, 0x0b, 0x43, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x17, 0x2e, 0x63,
+	0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x04, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x14, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61,
+	0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x0b, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x43, 0x61, 0x6b, 0x65, 0x73,
+	0x12, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x43, 0x61,
+	0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x4e, 0x65,
+	0x61, 0x72, 0x62, 0x79, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6b, 0x65, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6b,
+	0x65, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
+	0x43, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x43,
+	0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e,
+	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b,
+	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x11, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63,
+	0x61, 0x6b, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x45, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
+	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x63,
+	0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x61, 0x6b,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x63,
+	0x61, 0x6b, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x14, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x1d,
-	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e,
-	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d,
+	0x79, 0x12, 0x39, 0x0a, 0x0b, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61,
+	0x6b, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e,
 	0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69,
 	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6b, 0x65,
 	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x6b,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61,
-	0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e,
-	0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x4b, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
-	0x1b, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63,
-	0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x39, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x41, 0x64,
+	0x64, 0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x61,
+	0x6b, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x61,
-	0x6b, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x42,
-	0x3d, 0x5a, 0x3b, 0x32, 0x30, 0x32, 0x35, 0x5f, 0x43, 0x61, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64,
-	0x5f, 0x41, 0x50, 0x49, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x63, 0x61, 0x6b, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x13, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x15, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x17,
+	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x63, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x6b, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x42, 0x3d, 0x5a, 0x3b, 0x32, 0x30, 0x32, 0x35, 0x5f, 0x43,
+	0x61, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x5f, 0x41, 0x50, 0x49, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x61, 0x6b, 0x65, 0x2f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1888,94 +5596,238 @@ func file_cake_proto_rawDescGZIP() []byte {
 	return file_cake_proto_rawDescData
 }
 
-var file_cake_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cake_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_cake_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_cake_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_cake_proto_goTypes = []any{
-	(CategoryGender)(0),                  // 0: cake.CategoryGender
-	(*CakeRequest)(nil),                  // 1: cake.CakeRequest
-	(*CakeResponse)(nil),                 // 2: cake.CakeResponse
-	(*CreateCakeRequest)(nil),            // 3: cake.CreateCakeRequest
-	(*CreateCakeResponse)(nil),           // 4: cake.CreateCakeResponse
-	(*CreateFillingRequest)(nil),         // 5: cake.CreateFillingRequest
-	(*CreateFillingResponse)(nil),        // 6: cake.CreateFillingResponse
-	(*CreateCategoryRequest)(nil),        // 7: cake.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),       // 8: cake.CreateCategoryResponse
-	(*CategoriesResponse)(nil),           // 9: cake.CategoriesResponse
-	(*FillingsResponse)(nil),             // 10: cake.FillingsResponse
-	(*CakesResponse)(nil),                // 11: cake.CakesResponse
-	(*GetCategoriesByGenderNameReq)(nil), // 12: cake.GetCategoriesByGenderNameReq
-	(*GetCategoriesByGenderNameRes)(nil), // 13: cake.GetCategoriesByGenderNameRes
-	(*CategoryPreviewCakesReq)(nil),      // 14: cake.CategoryPreviewCakesReq
-	(*CategoryPreviewCakesRes)(nil),      // 15: cake.CategoryPreviewCakesRes
-	(*AddCakeColorsReq)(nil),             // 16: cake.AddCakeColorsReq
-	(*CakeColorsRes)(nil),                // 17: cake.CakeColorsRes
-	(*Cake)(nil),                         // 18: cake.Cake
-	(*User)(nil),                         // 19: cake.User
-	(*Filling)(nil),                      // 20: cake.Filling
-	(*Category)(nil),                     // 21: cake.Category
-	(*PreviewCake)(nil),                  // 22: cake.PreviewCake
-	(*Cake_CakeImage)(nil),               // 23: cake.Cake.CakeImage
-	(*wrapperspb.DoubleValue)(nil),       // 24: google.protobuf.DoubleValue
-	(*timestamppb.Timestamp)(nil),        // 25: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil),       // 26: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),                // 27: google.protobuf.Empty
+	(CakeSort)(0),                        // 0: cake.CakeSort
+	(FavoriteTarget)(0),                  // 1: cake.FavoriteTarget
+	(DiscountKind)(0),                    // 2: cake.DiscountKind
+	(CakeOptionKind)(0),                  // 3: cake.CakeOptionKind
+	(CategoryGender)(0),                  // 4: cake.CategoryGender
+	(*CakeRequest)(nil),                  // 5: cake.CakeRequest
+	(*CakeResponse)(nil),                 // 6: cake.CakeResponse
+	(*CreateCakeRequest)(nil),            // 7: cake.CreateCakeRequest
+	(*CreateCakeResponse)(nil),           // 8: cake.CreateCakeResponse
+	(*UpdateCakeRequest)(nil),            // 9: cake.UpdateCakeRequest
+	(*UpdateCakeResponse)(nil),           // 10: cake.UpdateCakeResponse
+	(*SetCakeSaleStatusRequest)(nil),     // 11: cake.SetCakeSaleStatusRequest
+	(*AddCakeImagesRequest)(nil),         // 12: cake.AddCakeImagesRequest
+	(*RemoveCakeImageRequest)(nil),       // 13: cake.RemoveCakeImageRequest
+	(*ReorderCakeImagesRequest)(nil),     // 14: cake.ReorderCakeImagesRequest
+	(*CakeImagesResponse)(nil),           // 15: cake.CakeImagesResponse
+	(*CreateFillingRequest)(nil),         // 16: cake.CreateFillingRequest
+	(*CreateFillingResponse)(nil),        // 17: cake.CreateFillingResponse
+	(*UpdateFillingRequest)(nil),         // 18: cake.UpdateFillingRequest
+	(*UpdateFillingResponse)(nil),        // 19: cake.UpdateFillingResponse
+	(*FillingRequest)(nil),               // 20: cake.FillingRequest
+	(*CreateCategoryRequest)(nil),        // 21: cake.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),       // 22: cake.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),        // 23: cake.UpdateCategoryRequest
+	(*CategoryGenderTags)(nil),           // 24: cake.CategoryGenderTags
+	(*UpdateCategoryResponse)(nil),       // 25: cake.UpdateCategoryResponse
+	(*CategoryRequest)(nil),              // 26: cake.CategoryRequest
+	(*CategoriesRequest)(nil),            // 27: cake.CategoriesRequest
+	(*CategoriesResponse)(nil),           // 28: cake.CategoriesResponse
+	(*FillingsRequest)(nil),              // 29: cake.FillingsRequest
+	(*FillingsResponse)(nil),             // 30: cake.FillingsResponse
+	(*CakesRequest)(nil),                 // 31: cake.CakesRequest
+	(*CakesResponse)(nil),                // 32: cake.CakesResponse
+	(*GetCategoriesByGenderNameReq)(nil), // 33: cake.GetCategoriesByGenderNameReq
+	(*GetCategoriesByGenderNameRes)(nil), // 34: cake.GetCategoriesByGenderNameRes
+	(*CategoryPreviewCakesReq)(nil),      // 35: cake.CategoryPreviewCakesReq
+	(*CategoryPreviewCakesRes)(nil),      // 36: cake.CategoryPreviewCakesRes
+	(*SearchCakesReq)(nil),               // 37: cake.SearchCakesReq
+	(*SearchCakesRes)(nil),               // 38: cake.SearchCakesRes
+	(*NearbyCakesReq)(nil),               // 39: cake.NearbyCakesReq
+	(*NearbyCakesRes)(nil),               // 40: cake.NearbyCakesRes
+	(*FavoriteReq)(nil),                  // 41: cake.FavoriteReq
+	(*FavoritesReq)(nil),                 // 42: cake.FavoritesReq
+	(*FavoritesRes)(nil),                 // 43: cake.FavoritesRes
+	(*Promotion)(nil),                    // 44: cake.Promotion
+	(*CreatePromotionReq)(nil),           // 45: cake.CreatePromotionReq
+	(*CreatePromotionRes)(nil),           // 46: cake.CreatePromotionRes
+	(*PromotionRequest)(nil),             // 47: cake.PromotionRequest
+	(*PromotionsReq)(nil),                // 48: cake.PromotionsReq
+	(*PromotionsRes)(nil),                // 49: cake.PromotionsRes
+	(*PromoCode)(nil),                    // 50: cake.PromoCode
+	(*CreatePromoCodeReq)(nil),           // 51: cake.CreatePromoCodeReq
+	(*CreatePromoCodeRes)(nil),           // 52: cake.CreatePromoCodeRes
+	(*PromoCodeRequest)(nil),             // 53: cake.PromoCodeRequest
+	(*PromoCodesReq)(nil),                // 54: cake.PromoCodesReq
+	(*PromoCodesRes)(nil),                // 55: cake.PromoCodesRes
+	(*CakeOption)(nil),                   // 56: cake.CakeOption
+	(*CakeOptionValue)(nil),              // 57: cake.CakeOptionValue
+	(*CakeOptionInput)(nil),              // 58: cake.CakeOptionInput
+	(*CakeOptionValueInput)(nil),         // 59: cake.CakeOptionValueInput
+	(*CreateCakeOptionReq)(nil),          // 60: cake.CreateCakeOptionReq
+	(*UpdateCakeOptionReq)(nil),          // 61: cake.UpdateCakeOptionReq
+	(*CakeOptionRes)(nil),                // 62: cake.CakeOptionRes
+	(*CakeOptionRequest)(nil),            // 63: cake.CakeOptionRequest
+	(*CakeOptionsReq)(nil),               // 64: cake.CakeOptionsReq
+	(*CakeOptionsRes)(nil),               // 65: cake.CakeOptionsRes
+	(*AddCakeColorsReq)(nil),             // 66: cake.AddCakeColorsReq
+	(*CakeColorsRes)(nil),                // 67: cake.CakeColorsRes
+	(*Cake)(nil),                         // 68: cake.Cake
+	(*User)(nil),                         // 69: cake.User
+	(*Filling)(nil),                      // 70: cake.Filling
+	(*Category)(nil),                     // 71: cake.Category
+	(*PreviewCake)(nil),                  // 72: cake.PreviewCake
+	(*Cake_CakeImage)(nil),               // 73: cake.Cake.CakeImage
+	(*wrapperspb.DoubleValue)(nil),       // 74: google.protobuf.DoubleValue
+	(*timestamppb.Timestamp)(nil),        // 75: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),       // 76: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),                // 77: google.protobuf.Empty
 }
 var file_cake_proto_depIdxs = []int32{
-	18, // 0: cake.CakeResponse.cake:type_name -> cake.Cake
-	24, // 1: cake.CreateCakeRequest.discount_kg_price:type_name -> google.protobuf.DoubleValue
-	25, // 2: cake.CreateCakeRequest.discount_end_time:type_name -> google.protobuf.Timestamp
-	20, // 3: cake.CreateFillingResponse.filling:type_name -> cake.Filling
-	21, // 4: cake.CreateCategoryResponse.category:type_name -> cake.Category
-	21, // 5: cake.CategoriesResponse.categories:type_name -> cake.Category
-	20, // 6: cake.FillingsResponse.fillings:type_name -> cake.Filling
-	22, // 7: cake.CakesResponse.cakes:type_name -> cake.PreviewCake
-	0,  // 8: cake.GetCategoriesByGenderNameReq.categoryGender:type_name -> cake.CategoryGender
-	21, // 9: cake.GetCategoriesByGenderNameRes.categories:type_name -> cake.Category
-	22, // 10: cake.CategoryPreviewCakesRes.previewCakes:type_name -> cake.PreviewCake
-	19, // 11: cake.Cake.owner:type_name -> cake.User
-	20, // 12: cake.Cake.fillings:type_name -> cake.Filling
-	21, // 13: cake.Cake.categories:type_name -> cake.Category
-	25, // 14: cake.Cake.discount_end_time:type_name -> google.protobuf.Timestamp
-	25, // 15: cake.Cake.date_creation:type_name -> google.protobuf.Timestamp
-	23, // 16: cake.Cake.images:type_name -> cake.Cake.CakeImage
-	26, // 17: cake.User.fio:type_name -> google.protobuf.StringValue
-	26, // 18: cake.User.address:type_name -> google.protobuf.StringValue
-	26, // 19: cake.User.phone:type_name -> google.protobuf.StringValue
-	26, // 20: cake.User.imageURL:type_name -> google.protobuf.StringValue
-	26, // 21: cake.User.headerImageURL:type_name -> google.protobuf.StringValue
-	0,  // 22: cake.Category.gender_tags:type_name -> cake.CategoryGender
-	26, // 23: cake.PreviewCake.description:type_name -> google.protobuf.StringValue
-	24, // 24: cake.PreviewCake.discount_kg_price:type_name -> google.protobuf.DoubleValue
-	25, // 25: cake.PreviewCake.discount_end_time:type_name -> google.protobuf.Timestamp
-	25, // 26: cake.PreviewCake.date_creation:type_name -> google.protobuf.Timestamp
-	19, // 27: cake.PreviewCake.owner:type_name -> cake.User
-	3,  // 28: cake.CakeService.CreateCake:input_type -> cake.CreateCakeRequest
-	1,  // 29: cake.CakeService.Cake:input_type -> cake.CakeRequest
-	27, // 30: cake.CakeService.Cakes:input_type -> google.protobuf.Empty
-	14, // 31: cake.CakeService.CategoryPreviewCakes:input_type -> cake.CategoryPreviewCakesReq
-	5,  // 32: cake.CakeService.CreateFilling:input_type -> cake.CreateFillingRequest
-	27, // 33: cake.CakeService.Fillings:input_type -> google.protobuf.Empty
-	16, // 34: cake.CakeService.AddCakeColors:input_type -> cake.AddCakeColorsReq
-	27, // 35: cake.CakeService.GetColors:input_type -> google.protobuf.Empty
-	7,  // 36: cake.CakeService.CreateCategory:input_type -> cake.CreateCategoryRequest
-	27, // 37: cake.CakeService.Categories:input_type -> google.protobuf.Empty
-	12, // 38: cake.CakeService.GetCategoriesByGenderName:input_type -> cake.GetCategoriesByGenderNameReq
-	4,  // 39: cake.CakeService.CreateCake:output_type -> cake.CreateCakeResponse
-	2,  // 40: cake.CakeService.Cake:output_type -> cake.CakeResponse
-	11, // 41: cake.CakeService.Cakes:output_type -> cake.CakesResponse
-	15, // 42: cake.CakeService.CategoryPreviewCakes:output_type -> cake.CategoryPreviewCakesRes
-	6,  // 43: cake.CakeService.CreateFilling:output_type -> cake.CreateFillingResponse
-	10, // 44: cake.CakeService.Fillings:output_type -> cake.FillingsResponse
-	27, // 45: cake.CakeService.AddCakeColors:output_type -> google.protobuf.Empty
-	17, // 46: cake.CakeService.GetColors:output_type -> cake.CakeColorsRes
-	8,  // 47: cake.CakeService.CreateCategory:output_type -> cake.CreateCategoryResponse
-	9,  // 48: cake.CakeService.Categories:output_type -> cake.CategoriesResponse
-	13, // 49: cake.CakeService.GetCategoriesByGenderName:output_type -> cake.GetCategoriesByGenderNameRes
-	39, // [39:50] is the sub-list for method output_type
-	28, // [28:39] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	68,  // 0: cake.CakeResponse.cake:type_name -> cake.Cake
+	74,  // 1: cake.CreateCakeRequest.discount_kg_price:type_name -> google.protobuf.DoubleValue
+	75,  // 2: cake.CreateCakeRequest.discount_end_time:type_name -> google.protobuf.Timestamp
+	75,  // 3: cake.UpdateCakeRequest.discount_end_time:type_name -> google.protobuf.Timestamp
+	68,  // 4: cake.UpdateCakeResponse.cake:type_name -> cake.Cake
+	73,  // 5: cake.CakeImagesResponse.images:type_name -> cake.Cake.CakeImage
+	70,  // 6: cake.CreateFillingResponse.filling:type_name -> cake.Filling
+	70,  // 7: cake.UpdateFillingResponse.filling:type_name -> cake.Filling
+	4,   // 8: cake.CreateCategoryRequest.gender_tags:type_name -> cake.CategoryGender
+	71,  // 9: cake.CreateCategoryResponse.category:type_name -> cake.Category
+	24,  // 10: cake.UpdateCategoryRequest.gender_tags:type_name -> cake.CategoryGenderTags
+	4,   // 11: cake.CategoryGenderTags.tags:type_name -> cake.CategoryGender
+	71,  // 12: cake.UpdateCategoryResponse.category:type_name -> cake.Category
+	71,  // 13: cake.CategoriesResponse.categories:type_name -> cake.Category
+	70,  // 14: cake.FillingsResponse.fillings:type_name -> cake.Filling
+	72,  // 15: cake.CakesResponse.cakes:type_name -> cake.PreviewCake
+	4,   // 16: cake.GetCategoriesByGenderNameReq.categoryGender:type_name -> cake.CategoryGender
+	71,  // 17: cake.GetCategoriesByGenderNameRes.categories:type_name -> cake.Category
+	72,  // 18: cake.CategoryPreviewCakesRes.previewCakes:type_name -> cake.PreviewCake
+	0,   // 19: cake.SearchCakesReq.sort:type_name -> cake.CakeSort
+	72,  // 20: cake.SearchCakesRes.cakes:type_name -> cake.PreviewCake
+	72,  // 21: cake.NearbyCakesRes.cakes:type_name -> cake.PreviewCake
+	1,   // 22: cake.FavoriteReq.target:type_name -> cake.FavoriteTarget
+	1,   // 23: cake.FavoritesReq.target:type_name -> cake.FavoriteTarget
+	72,  // 24: cake.FavoritesRes.cakes:type_name -> cake.PreviewCake
+	69,  // 25: cake.FavoritesRes.sellers:type_name -> cake.User
+	2,   // 26: cake.Promotion.kind:type_name -> cake.DiscountKind
+	75,  // 27: cake.Promotion.starts_at:type_name -> google.protobuf.Timestamp
+	75,  // 28: cake.Promotion.ends_at:type_name -> google.protobuf.Timestamp
+	75,  // 29: cake.Promotion.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 30: cake.Promotion.cancelled_at:type_name -> google.protobuf.Timestamp
+	2,   // 31: cake.CreatePromotionReq.kind:type_name -> cake.DiscountKind
+	75,  // 32: cake.CreatePromotionReq.starts_at:type_name -> google.protobuf.Timestamp
+	75,  // 33: cake.CreatePromotionReq.ends_at:type_name -> google.protobuf.Timestamp
+	44,  // 34: cake.CreatePromotionRes.promotion:type_name -> cake.Promotion
+	44,  // 35: cake.PromotionsRes.promotions:type_name -> cake.Promotion
+	2,   // 36: cake.PromoCode.kind:type_name -> cake.DiscountKind
+	75,  // 37: cake.PromoCode.starts_at:type_name -> google.protobuf.Timestamp
+	75,  // 38: cake.PromoCode.expires_at:type_name -> google.protobuf.Timestamp
+	75,  // 39: cake.PromoCode.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 40: cake.PromoCode.cancelled_at:type_name -> google.protobuf.Timestamp
+	2,   // 41: cake.CreatePromoCodeReq.kind:type_name -> cake.DiscountKind
+	75,  // 42: cake.CreatePromoCodeReq.starts_at:type_name -> google.protobuf.Timestamp
+	75,  // 43: cake.CreatePromoCodeReq.expires_at:type_name -> google.protobuf.Timestamp
+	50,  // 44: cake.CreatePromoCodeRes.promo_code:type_name -> cake.PromoCode
+	50,  // 45: cake.PromoCodesRes.promo_codes:type_name -> cake.PromoCode
+	3,   // 46: cake.CakeOption.kind:type_name -> cake.CakeOptionKind
+	57,  // 47: cake.CakeOption.values:type_name -> cake.CakeOptionValue
+	3,   // 48: cake.CakeOptionInput.kind:type_name -> cake.CakeOptionKind
+	59,  // 49: cake.CakeOptionInput.values:type_name -> cake.CakeOptionValueInput
+	58,  // 50: cake.CreateCakeOptionReq.option:type_name -> cake.CakeOptionInput
+	58,  // 51: cake.UpdateCakeOptionReq.option:type_name -> cake.CakeOptionInput
+	56,  // 52: cake.CakeOptionRes.option:type_name -> cake.CakeOption
+	56,  // 53: cake.CakeOptionsRes.options:type_name -> cake.CakeOption
+	69,  // 54: cake.Cake.owner:type_name -> cake.User
+	70,  // 55: cake.Cake.fillings:type_name -> cake.Filling
+	71,  // 56: cake.Cake.categories:type_name -> cake.Category
+	75,  // 57: cake.Cake.discount_end_time:type_name -> google.protobuf.Timestamp
+	75,  // 58: cake.Cake.date_creation:type_name -> google.protobuf.Timestamp
+	73,  // 59: cake.Cake.images:type_name -> cake.Cake.CakeImage
+	56,  // 60: cake.Cake.options:type_name -> cake.CakeOption
+	76,  // 61: cake.User.fio:type_name -> google.protobuf.StringValue
+	76,  // 62: cake.User.address:type_name -> google.protobuf.StringValue
+	76,  // 63: cake.User.phone:type_name -> google.protobuf.StringValue
+	76,  // 64: cake.User.imageURL:type_name -> google.protobuf.StringValue
+	76,  // 65: cake.User.headerImageURL:type_name -> google.protobuf.StringValue
+	4,   // 66: cake.Category.gender_tags:type_name -> cake.CategoryGender
+	76,  // 67: cake.PreviewCake.description:type_name -> google.protobuf.StringValue
+	74,  // 68: cake.PreviewCake.discount_kg_price:type_name -> google.protobuf.DoubleValue
+	75,  // 69: cake.PreviewCake.discount_end_time:type_name -> google.protobuf.Timestamp
+	75,  // 70: cake.PreviewCake.date_creation:type_name -> google.protobuf.Timestamp
+	69,  // 71: cake.PreviewCake.owner:type_name -> cake.User
+	7,   // 72: cake.CakeService.CreateCake:input_type -> cake.CreateCakeRequest
+	5,   // 73: cake.CakeService.Cake:input_type -> cake.CakeRequest
+	31,  // 74: cake.CakeService.Cakes:input_type -> cake.CakesRequest
+	35,  // 75: cake.CakeService.CategoryPreviewCakes:input_type -> cake.CategoryPreviewCakesReq
+	37,  // 76: cake.CakeService.SearchCakes:input_type -> cake.SearchCakesReq
+	39,  // 77: cake.CakeService.NearbyCakes:input_type -> cake.NearbyCakesReq
+	9,   // 78: cake.CakeService.UpdateCake:input_type -> cake.UpdateCakeRequest
+	11,  // 79: cake.CakeService.SetCakeSaleStatus:input_type -> cake.SetCakeSaleStatusRequest
+	5,   // 80: cake.CakeService.DeleteCake:input_type -> cake.CakeRequest
+	12,  // 81: cake.CakeService.AddCakeImages:input_type -> cake.AddCakeImagesRequest
+	13,  // 82: cake.CakeService.RemoveCakeImage:input_type -> cake.RemoveCakeImageRequest
+	14,  // 83: cake.CakeService.ReorderCakeImages:input_type -> cake.ReorderCakeImagesRequest
+	41,  // 84: cake.CakeService.AddFavorite:input_type -> cake.FavoriteReq
+	41,  // 85: cake.CakeService.RemoveFavorite:input_type -> cake.FavoriteReq
+	42,  // 86: cake.CakeService.Favorites:input_type -> cake.FavoritesReq
+	45,  // 87: cake.CakeService.CreatePromotion:input_type -> cake.CreatePromotionReq
+	47,  // 88: cake.CakeService.CancelPromotion:input_type -> cake.PromotionRequest
+	48,  // 89: cake.CakeService.Promotions:input_type -> cake.PromotionsReq
+	51,  // 90: cake.CakeService.CreatePromoCode:input_type -> cake.CreatePromoCodeReq
+	53,  // 91: cake.CakeService.CancelPromoCode:input_type -> cake.PromoCodeRequest
+	54,  // 92: cake.CakeService.PromoCodes:input_type -> cake.PromoCodesReq
+	60,  // 93: cake.CakeService.CreateCakeOption:input_type -> cake.CreateCakeOptionReq
+	61,  // 94: cake.CakeService.UpdateCakeOption:input_type -> cake.UpdateCakeOptionReq
+	63,  // 95: cake.CakeService.DeleteCakeOption:input_type -> cake.CakeOptionRequest
+	64,  // 96: cake.CakeService.CakeOptions:input_type -> cake.CakeOptionsReq
+	16,  // 97: cake.CakeService.CreateFilling:input_type -> cake.CreateFillingRequest
+	18,  // 98: cake.CakeService.UpdateFilling:input_type -> cake.UpdateFillingRequest
+	20,  // 99: cake.CakeService.DeleteFilling:input_type -> cake.FillingRequest
+	29,  // 100: cake.CakeService.Fillings:input_type -> cake.FillingsRequest
+	66,  // 101: cake.CakeService.AddCakeColors:input_type -> cake.AddCakeColorsReq
+	77,  // 102: cake.CakeService.GetColors:input_type -> google.protobuf.Empty
+	21,  // 103: cake.CakeService.CreateCategory:input_type -> cake.CreateCategoryRequest
+	23,  // 104: cake.CakeService.UpdateCategory:input_type -> cake.UpdateCategoryRequest
+	26,  // 105: cake.CakeService.DeleteCategory:input_type -> cake.CategoryRequest
+	27,  // 106: cake.CakeService.Categories:input_type -> cake.CategoriesRequest
+	33,  // 107: cake.CakeService.GetCategoriesByGenderName:input_type -> cake.GetCategoriesByGenderNameReq
+	8,   // 108: cake.CakeService.CreateCake:output_type -> cake.CreateCakeResponse
+	6,   // 109: cake.CakeService.Cake:output_type -> cake.CakeResponse
+	32,  // 110: cake.CakeService.Cakes:output_type -> cake.CakesResponse
+	36,  // 111: cake.CakeService.CategoryPreviewCakes:output_type -> cake.CategoryPreviewCakesRes
+	38,  // 112: cake.CakeService.SearchCakes:output_type -> cake.SearchCakesRes
+	40,  // 113: cake.CakeService.NearbyCakes:output_type -> cake.NearbyCakesRes
+	10,  // 114: cake.CakeService.UpdateCake:output_type -> cake.UpdateCakeResponse
+	77,  // 115: cake.CakeService.SetCakeSaleStatus:output_type -> google.protobuf.Empty
+	77,  // 116: cake.CakeService.DeleteCake:output_type -> google.protobuf.Empty
+	15,  // 117: cake.CakeService.AddCakeImages:output_type -> cake.CakeImagesResponse
+	15,  // 118: cake.CakeService.RemoveCakeImage:output_type -> cake.CakeImagesResponse
+	15,  // 119: cake.CakeService.ReorderCakeImages:output_type -> cake.CakeImagesResponse
+	77,  // 120: cake.CakeService.AddFavorite:output_type -> google.protobuf.Empty
+	77,  // 121: cake.CakeService.RemoveFavorite:output_type -> google.protobuf.Empty
+	43,  // 122: cake.CakeService.Favorites:output_type -> cake.FavoritesRes
+	46,  // 123: cake.CakeService.CreatePromotion:output_type -> cake.CreatePromotionRes
+	77,  // 124: cake.CakeService.CancelPromotion:output_type -> google.protobuf.Empty
+	49,  // 125: cake.CakeService.Promotions:output_type -> cake.PromotionsRes
+	52,  // 126: cake.CakeService.CreatePromoCode:output_type -> cake.CreatePromoCodeRes
+	77,  // 127: cake.CakeService.CancelPromoCode:output_type -> google.protobuf.Empty
+	55,  // 128: cake.CakeService.PromoCodes:output_type -> cake.PromoCodesRes
+	62,  // 129: cake.CakeService.CreateCakeOption:output_type -> cake.CakeOptionRes
+	62,  // 130: cake.CakeService.UpdateCakeOption:output_type -> cake.CakeOptionRes
+	77,  // 131: cake.CakeService.DeleteCakeOption:output_type -> google.protobuf.Empty
+	65,  // 132: cake.CakeService.CakeOptions:output_type -> cake.CakeOptionsRes
+	17,  // 133: cake.CakeService.CreateFilling:output_type -> cake.CreateFillingResponse
+	19,  // 134: cake.CakeService.UpdateFilling:output_type -> cake.UpdateFillingResponse
+	77,  // 135: cake.CakeService.DeleteFilling:output_type -> google.protobuf.Empty
+	30,  // 136: cake.CakeService.Fillings:output_type -> cake.FillingsResponse
+	77,  // 137: cake.CakeService.AddCakeColors:output_type -> google.protobuf.Empty
+	67,  // 138: cake.CakeService.GetColors:output_type -> cake.CakeColorsRes
+	22,  // 139: cake.CakeService.CreateCategory:output_type -> cake.CreateCategoryResponse
+	25,  // 140: cake.CakeService.UpdateCategory:output_type -> cake.UpdateCategoryResponse
+	77,  // 141: cake.CakeService.DeleteCategory:output_type -> google.protobuf.Empty
+	28,  // 142: cake.CakeService.Categories:output_type -> cake.CategoriesResponse
+	34,  // 143: cake.CakeService.GetCategoriesByGenderName:output_type -> cake.GetCategoriesByGenderNameRes
+	108, // [108:144] is the sub-list for method output_type
+	72,  // [72:108] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_cake_proto_init() }
@@ -1984,14 +5836,28 @@ func file_cake_proto_init() {
 		return
 	}
 	file_cake_proto_msgTypes[2].OneofWrappers = []any{}
-	file_cake_proto_msgTypes[17].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[4].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[13].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[18].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[32].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[34].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[39].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[40].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[45].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[46].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[51].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[53].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[54].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[63].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[65].OneofWrappers = []any{}
+	file_cake_proto_msgTypes[67].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cake_proto_rawDesc), len(file_cake_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   23,
+			NumEnums:      5,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,11 +24,36 @@ const (
 	CakeService_Cake_FullMethodName                      = "/cake.CakeService/Cake"
 	CakeService_Cakes_FullMethodName                     = "/cake.CakeService/Cakes"
 	CakeService_CategoryPreviewCakes_FullMethodName      = "/cake.CakeService/CategoryPreviewCakes"
+	CakeService_SearchCakes_FullMethodName               = "/cake.CakeService/SearchCakes"
+	CakeService_NearbyCakes_FullMethodName               = "/cake.CakeService/NearbyCakes"
+	CakeService_UpdateCake_FullMethodName                = "/cake.CakeService/UpdateCake"
+	CakeService_SetCakeSaleStatus_FullMethodName         = "/cake.CakeService/SetCakeSaleStatus"
+	CakeService_DeleteCake_FullMethodName                = "/cake.CakeService/DeleteCake"
+	CakeService_AddCakeImages_FullMethodName             = "/cake.CakeService/AddCakeImages"
+	CakeService_RemoveCakeImage_FullMethodName           = "/cake.CakeService/RemoveCakeImage"
+	CakeService_ReorderCakeImages_FullMethodName         = "/cake.CakeService/ReorderCakeImages"
+	CakeService_AddFavorite_FullMethodName               = "/cake.CakeService/AddFavorite"
+	CakeService_RemoveFavorite_FullMethodName            = "/cake.CakeService/RemoveFavorite"
+	CakeService_Favorites_FullMethodName                 = "/cake.CakeService/Favorites"
+	CakeService_CreatePromotion_FullMethodName           = "/cake.CakeService/CreatePromotion"
+	CakeService_CancelPromotion_FullMethodName           = "/cake.CakeService/CancelPromotion"
+	CakeService_Promotions_FullMethodName                = "/cake.CakeService/Promotions"
+	CakeService_CreatePromoCode_FullMethodName           = "/cake.CakeService/CreatePromoCode"
+	CakeService_CancelPromoCode_FullMethodName           = "/cake.CakeService/CancelPromoCode"
+	CakeService_PromoCodes_FullMethodName                = "/cake.CakeService/PromoCodes"
+	CakeService_CreateCakeOption_FullMethodName          = "/cake.CakeService/CreateCakeOption"
+	CakeService_UpdateCakeOption_FullMethodName          = "/cake.CakeService/UpdateCakeOption"
+	CakeService_DeleteCakeOption_FullMethodName          = "/cake.CakeService/DeleteCakeOption"
+	CakeService_CakeOptions_FullMethodName               = "/cake.CakeService/CakeOptions"
 	CakeService_CreateFilling_FullMethodName             = "/cake.CakeService/CreateFilling"
+	CakeService_UpdateFilling_FullMethodName             = "/cake.CakeService/UpdateFilling"
+	CakeService_DeleteFilling_FullMethodName             = "/cake.CakeService/DeleteFilling"
 	CakeService_Fillings_FullMethodName                  = "/cake.CakeService/Fillings"
 	CakeService_AddCakeColors_FullMethodName             = "/cake.CakeService/AddCakeColors"
 	CakeService_GetColors_FullMethodName                 = "/cake.CakeService/GetColors"
 	CakeService_CreateCategory_FullMethodName            = "/cake.CakeService/CreateCategory"
+	CakeService_UpdateCategory_FullMethodName            = "/cake.CakeService/UpdateCategory"
+	CakeService_DeleteCategory_FullMethodName            = "/cake.CakeService/DeleteCategory"
 	CakeService_Categories_FullMethodName                = "/cake.CakeService/Categories"
 	CakeService_GetCategoriesByGenderName_FullMethodName = "/cake.CakeService/GetCategoriesByGenderName"
 )
@@ -41,14 +66,39 @@ const (
 type CakeServiceClient interface {
 	CreateCake(ctx context.Context, in *CreateCakeRequest, opts ...grpc.CallOption) (*CreateCakeResponse, error)
 	Cake(ctx context.Context, in *CakeRequest, opts ...grpc.CallOption) (*CakeResponse, error)
-	Cakes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CakesResponse, error)
+	Cakes(ctx context.Context, in *CakesRequest, opts ...grpc.CallOption) (*CakesResponse, error)
 	CategoryPreviewCakes(ctx context.Context, in *CategoryPreviewCakesReq, opts ...grpc.CallOption) (*CategoryPreviewCakesRes, error)
+	SearchCakes(ctx context.Context, in *SearchCakesReq, opts ...grpc.CallOption) (*SearchCakesRes, error)
+	NearbyCakes(ctx context.Context, in *NearbyCakesReq, opts ...grpc.CallOption) (*NearbyCakesRes, error)
+	UpdateCake(ctx context.Context, in *UpdateCakeRequest, opts ...grpc.CallOption) (*UpdateCakeResponse, error)
+	SetCakeSaleStatus(ctx context.Context, in *SetCakeSaleStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCake(ctx context.Context, in *CakeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddCakeImages(ctx context.Context, in *AddCakeImagesRequest, opts ...grpc.CallOption) (*CakeImagesResponse, error)
+	RemoveCakeImage(ctx context.Context, in *RemoveCakeImageRequest, opts ...grpc.CallOption) (*CakeImagesResponse, error)
+	ReorderCakeImages(ctx context.Context, in *ReorderCakeImagesRequest, opts ...grpc.CallOption) (*CakeImagesResponse, error)
+	AddFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Favorites(ctx context.Context, in *FavoritesReq, opts ...grpc.CallOption) (*FavoritesRes, error)
+	CreatePromotion(ctx context.Context, in *CreatePromotionReq, opts ...grpc.CallOption) (*CreatePromotionRes, error)
+	CancelPromotion(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Promotions(ctx context.Context, in *PromotionsReq, opts ...grpc.CallOption) (*PromotionsRes, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeReq, opts ...grpc.CallOption) (*CreatePromoCodeRes, error)
+	CancelPromoCode(ctx context.Context, in *PromoCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PromoCodes(ctx context.Context, in *PromoCodesReq, opts ...grpc.CallOption) (*PromoCodesRes, error)
+	CreateCakeOption(ctx context.Context, in *CreateCakeOptionReq, opts ...grpc.CallOption) (*CakeOptionRes, error)
+	UpdateCakeOption(ctx context.Context, in *UpdateCakeOptionReq, opts ...grpc.CallOption) (*CakeOptionRes, error)
+	DeleteCakeOption(ctx context.Context, in *CakeOptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CakeOptions(ctx context.Context, in *CakeOptionsReq, opts ...grpc.CallOption) (*CakeOptionsRes, error)
 	CreateFilling(ctx context.Context, in *CreateFillingRequest, opts ...grpc.CallOption) (*CreateFillingResponse, error)
-	Fillings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FillingsResponse, error)
+	UpdateFilling(ctx context.Context, in *UpdateFillingRequest, opts ...grpc.CallOption) (*UpdateFillingResponse, error)
+	DeleteFilling(ctx context.Context, in *FillingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Fillings(ctx context.Context, in *FillingsRequest, opts ...grpc.CallOption) (*FillingsResponse, error)
 	AddCakeColors(ctx context.Context, in *AddCakeColorsReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetColors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CakeColorsRes, error)
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
-	Categories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoriesResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Categories(ctx context.Context, in *CategoriesRequest, opts ...grpc.CallOption) (*CategoriesResponse, error)
 	GetCategoriesByGenderName(ctx context.Context, in *GetCategoriesByGenderNameReq, opts ...grpc.CallOption) (*GetCategoriesByGenderNameRes, error)
 }
 
@@ -80,7 +130,7 @@ func (c *cakeServiceClient) Cake(ctx context.Context, in *CakeRequest, opts ...g
 	return out, nil
 }
 
-func (c *cakeServiceClient) Cakes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CakesResponse, error) {
+func (c *cakeServiceClient) Cakes(ctx context.Context, in *CakesRequest, opts ...grpc.CallOption) (*CakesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CakesResponse)
 	err := c.cc.Invoke(ctx, CakeService_Cakes_FullMethodName, in, out, cOpts...)
@@ -100,6 +150,216 @@ func (c *cakeServiceClient) CategoryPreviewCakes(ctx context.Context, in *Catego
 	return out, nil
 }
 
+func (c *cakeServiceClient) SearchCakes(ctx context.Context, in *SearchCakesReq, opts ...grpc.CallOption) (*SearchCakesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCakesRes)
+	err := c.cc.Invoke(ctx, CakeService_SearchCakes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) NearbyCakes(ctx context.Context, in *NearbyCakesReq, opts ...grpc.CallOption) (*NearbyCakesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NearbyCakesRes)
+	err := c.cc.Invoke(ctx, CakeService_NearbyCakes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) UpdateCake(ctx context.Context, in *UpdateCakeRequest, opts ...grpc.CallOption) (*UpdateCakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCakeResponse)
+	err := c.cc.Invoke(ctx, CakeService_UpdateCake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) SetCakeSaleStatus(ctx context.Context, in *SetCakeSaleStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_SetCakeSaleStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) DeleteCake(ctx context.Context, in *CakeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_DeleteCake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) AddCakeImages(ctx context.Context, in *AddCakeImagesRequest, opts ...grpc.CallOption) (*CakeImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CakeImagesResponse)
+	err := c.cc.Invoke(ctx, CakeService_AddCakeImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) RemoveCakeImage(ctx context.Context, in *RemoveCakeImageRequest, opts ...grpc.CallOption) (*CakeImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CakeImagesResponse)
+	err := c.cc.Invoke(ctx, CakeService_RemoveCakeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) ReorderCakeImages(ctx context.Context, in *ReorderCakeImagesRequest, opts ...grpc.CallOption) (*CakeImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CakeImagesResponse)
+	err := c.cc.Invoke(ctx, CakeService_ReorderCakeImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) AddFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) RemoveFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) Favorites(ctx context.Context, in *FavoritesReq, opts ...grpc.CallOption) (*FavoritesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoritesRes)
+	err := c.cc.Invoke(ctx, CakeService_Favorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionReq, opts ...grpc.CallOption) (*CreatePromotionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionRes)
+	err := c.cc.Invoke(ctx, CakeService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) CancelPromotion(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_CancelPromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) Promotions(ctx context.Context, in *PromotionsReq, opts ...grpc.CallOption) (*PromotionsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionsRes)
+	err := c.cc.Invoke(ctx, CakeService_Promotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeReq, opts ...grpc.CallOption) (*CreatePromoCodeRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromoCodeRes)
+	err := c.cc.Invoke(ctx, CakeService_CreatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) CancelPromoCode(ctx context.Context, in *PromoCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_CancelPromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) PromoCodes(ctx context.Context, in *PromoCodesReq, opts ...grpc.CallOption) (*PromoCodesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCodesRes)
+	err := c.cc.Invoke(ctx, CakeService_PromoCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) CreateCakeOption(ctx context.Context, in *CreateCakeOptionReq, opts ...grpc.CallOption) (*CakeOptionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CakeOptionRes)
+	err := c.cc.Invoke(ctx, CakeService_CreateCakeOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) UpdateCakeOption(ctx context.Context, in *UpdateCakeOptionReq, opts ...grpc.CallOption) (*CakeOptionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CakeOptionRes)
+	err := c.cc.Invoke(ctx, CakeService_UpdateCakeOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) DeleteCakeOption(ctx context.Context, in *CakeOptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_DeleteCakeOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) CakeOptions(ctx context.Context, in *CakeOptionsReq, opts ...grpc.CallOption) (*CakeOptionsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CakeOptionsRes)
+	err := c.cc.Invoke(ctx, CakeService_CakeOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cakeServiceClient) CreateFilling(ctx context.Context, in *CreateFillingRequest, opts ...grpc.CallOption) (*CreateFillingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateFillingResponse)
@@ -110,7 +370,27 @@ func (c *cakeServiceClient) CreateFilling(ctx context.Context, in *CreateFilling
 	return out, nil
 }
 
-func (c *cakeServiceClient) Fillings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FillingsResponse, error) {
+func (c *cakeServiceClient) UpdateFilling(ctx context.Context, in *UpdateFillingRequest, opts ...grpc.CallOption) (*UpdateFillingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFillingResponse)
+	err := c.cc.Invoke(ctx, CakeService_UpdateFilling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) DeleteFilling(ctx context.Context, in *FillingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_DeleteFilling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) Fillings(ctx context.Context, in *FillingsRequest, opts ...grpc.CallOption) (*FillingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FillingsResponse)
 	err := c.cc.Invoke(ctx, CakeService_Fillings_FullMethodName, in, out, cOpts...)
@@ -150,7 +430,27 @@ func (c *cakeServiceClient) CreateCategory(ctx context.Context, in *CreateCatego
 	return out, nil
 }
 
-func (c *cakeServiceClient) Categories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoriesResponse, error) {
+func (c *cakeServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, CakeService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) DeleteCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CakeService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cakeServiceClient) Categories(ctx context.Context, in *CategoriesRequest, opts ...grpc.CallOption) (*CategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CategoriesResponse)
 	err := c.cc.Invoke(ctx, CakeService_Categories_FullMethodName, in, out, cOpts...)
@@ -178,14 +478,39 @@ func (c *cakeServiceClient) GetCategoriesByGenderName(ctx context.Context, in *G
 type CakeServiceServer interface {
 	CreateCake(context.Context, *CreateCakeRequest) (*CreateCakeResponse, error)
 	Cake(context.Context, *CakeRequest) (*CakeResponse, error)
-	Cakes(context.Context, *emptypb.Empty) (*CakesResponse, error)
+	Cakes(context.Context, *CakesRequest) (*CakesResponse, error)
 	CategoryPreviewCakes(context.Context, *CategoryPreviewCakesReq) (*CategoryPreviewCakesRes, error)
+	SearchCakes(context.Context, *SearchCakesReq) (*SearchCakesRes, error)
+	NearbyCakes(context.Context, *NearbyCakesReq) (*NearbyCakesRes, error)
+	UpdateCake(context.Context, *UpdateCakeRequest) (*UpdateCakeResponse, error)
+	SetCakeSaleStatus(context.Context, *SetCakeSaleStatusRequest) (*emptypb.Empty, error)
+	DeleteCake(context.Context, *CakeRequest) (*emptypb.Empty, error)
+	AddCakeImages(context.Context, *AddCakeImagesRequest) (*CakeImagesResponse, error)
+	RemoveCakeImage(context.Context, *RemoveCakeImageRequest) (*CakeImagesResponse, error)
+	ReorderCakeImages(context.Context, *ReorderCakeImagesRequest) (*CakeImagesResponse, error)
+	AddFavorite(context.Context, *FavoriteReq) (*emptypb.Empty, error)
+	RemoveFavorite(context.Context, *FavoriteReq) (*emptypb.Empty, error)
+	Favorites(context.Context, *FavoritesReq) (*FavoritesRes, error)
+	CreatePromotion(context.Context, *CreatePromotionReq) (*CreatePromotionRes, error)
+	CancelPromotion(context.Context, *PromotionRequest) (*emptypb.Empty, error)
+	Promotions(context.Context, *PromotionsReq) (*PromotionsRes, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeReq) (*CreatePromoCodeRes, error)
+	CancelPromoCode(context.Context, *PromoCodeRequest) (*emptypb.Empty, error)
+	PromoCodes(context.Context, *PromoCodesReq) (*PromoCodesRes, error)
+	CreateCakeOption(context.Context, *CreateCakeOptionReq) (*CakeOptionRes, error)
+	UpdateCakeOption(context.Context, *UpdateCakeOptionReq) (*CakeOptionRes, error)
+	DeleteCakeOption(context.Context, *CakeOptionRequest) (*emptypb.Empty, error)
+	CakeOptions(context.Context, *CakeOptionsReq) (*CakeOptionsRes, error)
 	CreateFilling(context.Context, *CreateFillingRequest) (*CreateFillingResponse, error)
-	Fillings(context.Context, *emptypb.Empty) (*FillingsResponse, error)
+	UpdateFilling(context.Context, *UpdateFillingRequest) (*UpdateFillingResponse, error)
+	DeleteFilling(context.Context, *FillingRequest) (*emptypb.Empty, error)
+	Fillings(context.Context, *FillingsRequest) (*FillingsResponse, error)
 	AddCakeColors(context.Context, *AddCakeColorsReq) (*emptypb.Empty, error)
 	GetColors(context.Context, *emptypb.Empty) (*CakeColorsRes, error)
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
-	Categories(context.Context, *emptypb.Empty) (*CategoriesResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	DeleteCategory(context.Context, *CategoryRequest) (*emptypb.Empty, error)
+	Categories(context.Context, *CategoriesRequest) (*CategoriesResponse, error)
 	GetCategoriesByGenderName(context.Context, *GetCategoriesByGenderNameReq) (*GetCategoriesByGenderNameRes, error)
 	mustEmbedUnimplementedCakeServiceServer()
 }
@@ -203,16 +528,85 @@ func (UnimplementedCakeServiceServer) CreateCake(context.Context, *CreateCakeReq
 func (UnimplementedCakeServiceServer) Cake(context.Context, *CakeRequest) (*CakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cake not implemented")
 }
-func (UnimplementedCakeServiceServer) Cakes(context.Context, *emptypb.Empty) (*CakesResponse, error) {
+func (UnimplementedCakeServiceServer) Cakes(context.Context, *CakesRequest) (*CakesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cakes not implemented")
 }
 func (UnimplementedCakeServiceServer) CategoryPreviewCakes(context.Context, *CategoryPreviewCakesReq) (*CategoryPreviewCakesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CategoryPreviewCakes not implemented")
 }
+func (UnimplementedCakeServiceServer) SearchCakes(context.Context, *SearchCakesReq) (*SearchCakesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCakes not implemented")
+}
+func (UnimplementedCakeServiceServer) NearbyCakes(context.Context, *NearbyCakesReq) (*NearbyCakesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearbyCakes not implemented")
+}
+func (UnimplementedCakeServiceServer) UpdateCake(context.Context, *UpdateCakeRequest) (*UpdateCakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCake not implemented")
+}
+func (UnimplementedCakeServiceServer) SetCakeSaleStatus(context.Context, *SetCakeSaleStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCakeSaleStatus not implemented")
+}
+func (UnimplementedCakeServiceServer) DeleteCake(context.Context, *CakeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCake not implemented")
+}
+func (UnimplementedCakeServiceServer) AddCakeImages(context.Context, *AddCakeImagesRequest) (*CakeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCakeImages not implemented")
+}
+func (UnimplementedCakeServiceServer) RemoveCakeImage(context.Context, *RemoveCakeImageRequest) (*CakeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCakeImage not implemented")
+}
+func (UnimplementedCakeServiceServer) ReorderCakeImages(context.Context, *ReorderCakeImagesRequest) (*CakeImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCakeImages not implemented")
+}
+func (UnimplementedCakeServiceServer) AddFavorite(context.Context, *FavoriteReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedCakeServiceServer) RemoveFavorite(context.Context, *FavoriteReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedCakeServiceServer) Favorites(context.Context, *FavoritesReq) (*FavoritesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Favorites not implemented")
+}
+func (UnimplementedCakeServiceServer) CreatePromotion(context.Context, *CreatePromotionReq) (*CreatePromotionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedCakeServiceServer) CancelPromotion(context.Context, *PromotionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPromotion not implemented")
+}
+func (UnimplementedCakeServiceServer) Promotions(context.Context, *PromotionsReq) (*PromotionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promotions not implemented")
+}
+func (UnimplementedCakeServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeReq) (*CreatePromoCodeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedCakeServiceServer) CancelPromoCode(context.Context, *PromoCodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPromoCode not implemented")
+}
+func (UnimplementedCakeServiceServer) PromoCodes(context.Context, *PromoCodesReq) (*PromoCodesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoCodes not implemented")
+}
+func (UnimplementedCakeServiceServer) CreateCakeOption(context.Context, *CreateCakeOptionReq) (*CakeOptionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCakeOption not implemented")
+}
+func (UnimplementedCakeServiceServer) UpdateCakeOption(context.Context, *UpdateCakeOptionReq) (*CakeOptionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCakeOption not implemented")
+}
+func (UnimplementedCakeServiceServer) DeleteCakeOption(context.Context, *CakeOptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCakeOption not implemented")
+}
+func (UnimplementedCakeServiceServer) CakeOptions(context.Context, *CakeOptionsReq) (*CakeOptionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CakeOptions not implemented")
+}
 func (UnimplementedCakeServiceServer) CreateFilling(context.Context, *CreateFillingRequest) (*CreateFillingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFilling not implemented")
 }
-func (UnimplementedCakeServiceServer) Fillings(context.Context, *emptypb.Empty) (*FillingsResponse, error) {
+func (UnimplementedCakeServiceServer) UpdateFilling(context.Context, *UpdateFillingRequest) (*UpdateFillingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFilling not implemented")
+}
+func (UnimplementedCakeServiceServer) DeleteFilling(context.Context, *FillingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFilling not implemented")
+}
+func (UnimplementedCakeServiceServer) Fillings(context.Context, *FillingsRequest) (*FillingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Fillings not implemented")
 }
 func (UnimplementedCakeServiceServer) AddCakeColors(context.Context, *AddCakeColorsReq) (*emptypb.Empty, error) {
@@ -224,7 +618,13 @@ func (UnimplementedCakeServiceServer) GetColors(context.Context, *emptypb.Empty)
 func (UnimplementedCakeServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
 }
-func (UnimplementedCakeServiceServer) Categories(context.Context, *emptypb.Empty) (*CategoriesResponse, error) {
+func (UnimplementedCakeServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCakeServiceServer) DeleteCategory(context.Context, *CategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCakeServiceServer) Categories(context.Context, *CategoriesRequest) (*CategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Categories not implemented")
 }
 func (UnimplementedCakeServiceServer) GetCategoriesByGenderName(context.Context, *GetCategoriesByGenderNameReq) (*GetCategoriesByGenderNameRes, error) {
@@ -288,7 +688,7 @@ func _CakeService_Cake_Handler(srv interface{}, ctx context.Context, dec func(in
 }
 
 func _CakeService_Cakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(CakesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -300,7 +700,7 @@ func _CakeService_Cakes_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: CakeService_Cakes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).Cakes(ctx, req.(*emptypb.Empty))
+		return srv.(CakeServiceServer).Cakes(ctx, req.(*CakesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -323,144 +723,594 @@ func _CakeService_CategoryPreviewCakes_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_CreateFilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFillingRequest)
+func _CakeService_SearchCakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCakesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).CreateFilling(ctx, in)
+		return srv.(CakeServiceServer).SearchCakes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_CreateFilling_FullMethodName,
+		FullMethod: CakeService_SearchCakes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).CreateFilling(ctx, req.(*CreateFillingRequest))
+		return srv.(CakeServiceServer).SearchCakes(ctx, req.(*SearchCakesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_Fillings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _CakeService_NearbyCakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyCakesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).Fillings(ctx, in)
+		return srv.(CakeServiceServer).NearbyCakes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_Fillings_FullMethodName,
+		FullMethod: CakeService_NearbyCakes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).Fillings(ctx, req.(*emptypb.Empty))
+		return srv.(CakeServiceServer).NearbyCakes(ctx, req.(*NearbyCakesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_AddCakeColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCakeColorsReq)
+func _CakeService_UpdateCake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCakeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).AddCakeColors(ctx, in)
+		return srv.(CakeServiceServer).UpdateCake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_AddCakeColors_FullMethodName,
+		FullMethod: CakeService_UpdateCake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).AddCakeColors(ctx, req.(*AddCakeColorsReq))
+		return srv.(CakeServiceServer).UpdateCake(ctx, req.(*UpdateCakeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_GetColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _CakeService_SetCakeSaleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCakeSaleStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).GetColors(ctx, in)
+		return srv.(CakeServiceServer).SetCakeSaleStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_GetColors_FullMethodName,
+		FullMethod: CakeService_SetCakeSaleStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).GetColors(ctx, req.(*emptypb.Empty))
+		return srv.(CakeServiceServer).SetCakeSaleStatus(ctx, req.(*SetCakeSaleStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _CakeService_DeleteCake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CakeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).CreateCategory(ctx, in)
+		return srv.(CakeServiceServer).DeleteCake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_CreateCategory_FullMethodName,
+		FullMethod: CakeService_DeleteCake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(CakeServiceServer).DeleteCake(ctx, req.(*CakeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_Categories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _CakeService_AddCakeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCakeImagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).Categories(ctx, in)
+		return srv.(CakeServiceServer).AddCakeImages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_Categories_FullMethodName,
+		FullMethod: CakeService_AddCakeImages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).Categories(ctx, req.(*emptypb.Empty))
+		return srv.(CakeServiceServer).AddCakeImages(ctx, req.(*AddCakeImagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CakeService_GetCategoriesByGenderName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoriesByGenderNameReq)
+func _CakeService_RemoveCakeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCakeImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CakeServiceServer).GetCategoriesByGenderName(ctx, in)
+		return srv.(CakeServiceServer).RemoveCakeImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CakeService_GetCategoriesByGenderName_FullMethodName,
+		FullMethod: CakeService_RemoveCakeImage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CakeServiceServer).GetCategoriesByGenderName(ctx, req.(*GetCategoriesByGenderNameReq))
+		return srv.(CakeServiceServer).RemoveCakeImage(ctx, req.(*RemoveCakeImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CakeService_ServiceDesc is the grpc.ServiceDesc for CakeService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var CakeService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "cake.CakeService",
-	HandlerType: (*CakeServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateCake",
-			Handler:    _CakeService_CreateCake_Handler,
-		},
-		{
+func _CakeService_ReorderCakeImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCakeImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).ReorderCakeImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_ReorderCakeImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).ReorderCakeImages(ctx, req.(*ReorderCakeImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).AddFavorite(ctx, req.(*FavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).RemoveFavorite(ctx, req.(*FavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_Favorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).Favorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_Favorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).Favorites(ctx, req.(*FavoritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CreatePromotion(ctx, req.(*CreatePromotionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CancelPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CancelPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CancelPromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CancelPromotion(ctx, req.(*PromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_Promotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).Promotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_Promotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).Promotions(ctx, req.(*PromotionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CancelPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CancelPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CancelPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CancelPromoCode(ctx, req.(*PromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_PromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).PromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_PromoCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).PromoCodes(ctx, req.(*PromoCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CreateCakeOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCakeOptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CreateCakeOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CreateCakeOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CreateCakeOption(ctx, req.(*CreateCakeOptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_UpdateCakeOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCakeOptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).UpdateCakeOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_UpdateCakeOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).UpdateCakeOption(ctx, req.(*UpdateCakeOptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_DeleteCakeOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CakeOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).DeleteCakeOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_DeleteCakeOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).DeleteCakeOption(ctx, req.(*CakeOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CakeOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CakeOptionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CakeOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CakeOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CakeOptions(ctx, req.(*CakeOptionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CreateFilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFillingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CreateFilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CreateFilling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CreateFilling(ctx, req.(*CreateFillingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_UpdateFilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFillingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).UpdateFilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_UpdateFilling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).UpdateFilling(ctx, req.(*UpdateFillingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_DeleteFilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).DeleteFilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_DeleteFilling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).DeleteFilling(ctx, req.(*FillingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_Fillings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).Fillings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_Fillings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).Fillings(ctx, req.(*FillingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_AddCakeColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCakeColorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).AddCakeColors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_AddCakeColors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).AddCakeColors(ctx, req.(*AddCakeColorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_GetColors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).GetColors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_GetColors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).GetColors(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).DeleteCategory(ctx, req.(*CategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_Categories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).Categories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_Categories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).Categories(ctx, req.(*CategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CakeService_GetCategoriesByGenderName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoriesByGenderNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CakeServiceServer).GetCategoriesByGenderName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CakeService_GetCategoriesByGenderName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CakeServiceServer).GetCategoriesByGenderName(ctx, req.(*GetCategoriesByGenderNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CakeService_ServiceDesc is the grpc.ServiceDesc for CakeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CakeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cake.CakeService",
+	HandlerType: (*CakeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCake",
+			Handler:    _CakeService_CreateCake_Handler,
+		},
+		{
 			MethodName: "Cake",
 			Handler:    _CakeService_Cake_Handler,
 		},
@@ -472,10 +1322,102 @@ var CakeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CategoryPreviewCakes",
 			Handler:    _CakeService_CategoryPreviewCakes_Handler,
 		},
+		{
+			MethodName: "SearchCakes",
+			Handler:    _CakeService_SearchCakes_Handler,
+		},
+		{
+			MethodName: "NearbyCakes",
+			Handler:    _CakeService_NearbyCakes_Handler,
+		},
+		{
+			MethodName: "UpdateCake",
+			Handler:    _CakeService_UpdateCake_Handler,
+		},
+		{
+			MethodName: "SetCakeSaleStatus",
+			Handler:    _CakeService_SetCakeSaleStatus_Handler,
+		},
+		{
+			MethodName: "DeleteCake",
+			Handler:    _CakeService_DeleteCake_Handler,
+		},
+		{
+			MethodName: "AddCakeImages",
+			Handler:    _CakeService_AddCakeImages_Handler,
+		},
+		{
+			MethodName: "RemoveCakeImage",
+			Handler:    _CakeService_RemoveCakeImage_Handler,
+		},
+		{
+			MethodName: "ReorderCakeImages",
+			Handler:    _CakeService_ReorderCakeImages_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _CakeService_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _CakeService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "Favorites",
+			Handler:    _CakeService_Favorites_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _CakeService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "CancelPromotion",
+			Handler:    _CakeService_CancelPromotion_Handler,
+		},
+		{
+			MethodName: "Promotions",
+			Handler:    _CakeService_Promotions_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _CakeService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "CancelPromoCode",
+			Handler:    _CakeService_CancelPromoCode_Handler,
+		},
+		{
+			MethodName: "PromoCodes",
+			Handler:    _CakeService_PromoCodes_Handler,
+		},
+		{
+			MethodName: "CreateCakeOption",
+			Handler:    _CakeService_CreateCakeOption_Handler,
+		},
+		{
+			MethodName: "UpdateCakeOption",
+			Handler:    _CakeService_UpdateCakeOption_Handler,
+		},
+		{
+			MethodName: "DeleteCakeOption",
+			Handler:    _CakeService_DeleteCakeOption_Handler,
+		},
+		{
+			MethodName: "CakeOptions",
+			Handler:    _CakeService_CakeOptions_Handler,
+		},
 		{
 			MethodName: "CreateFilling",
 			Handler:    _CakeService_CreateFilling_Handler,
 		},
+		{
+			MethodName: "UpdateFilling",
+			Handler:    _CakeService_UpdateFilling_Handler,
+		},
+		{
+			MethodName: "DeleteFilling",
+			Handler:    _CakeService_DeleteFilling_Handler,
+		},
 		{
 			MethodName: "Fillings",
 			Handler:    _CakeService_Fillings_Handler,
@@ -492,6 +1434,14 @@ var CakeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCategory",
 			Handler:    _CakeService_CreateCategory_Handler,
 		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _CakeService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _CakeService_DeleteCategory_Handler,
+		},
 		{
 			MethodName: "Categories",
 			Handler:    _CakeService_Categories_Handler,
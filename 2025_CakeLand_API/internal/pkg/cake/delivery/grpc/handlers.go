@@ -1,13 +1,13 @@
 package handler
 
 import (
-	"2025_CakeLand_API/internal/domains"
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/cake"
 	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
 	"2025_CakeLand_API/internal/pkg/cake/dto"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
 	"context"
 	"fmt"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -19,20 +19,17 @@ import (
 type GrpcCakeHandler struct {
 	gen.UnimplementedCakeServiceServer
 
-	log        *slog.Logger
-	usecase    cake.ICakeUsecase
-	mdProvider *md.MetadataProvider
+	log     *slog.Logger
+	usecase cake.ICakeUsecase
 }
 
 func NewCakeHandler(
 	logger *slog.Logger,
 	uc cake.ICakeUsecase,
-	mdProvider *md.MetadataProvider,
 ) *GrpcCakeHandler {
 	return &GrpcCakeHandler{
-		log:        logger,
-		usecase:    uc,
-		mdProvider: mdProvider,
+		log:     logger,
+		usecase: uc,
 	}
 }
 
@@ -84,16 +81,14 @@ func (h *GrpcCakeHandler) Cake(ctx context.Context, in *gen.CakeRequest) (*gen.C
 }
 
 func (h *GrpcCakeHandler) CreateCake(ctx context.Context, in *gen.CreateCakeRequest) (*gen.CreateCakeResponse, error) {
-	// Получаем токен из метаданных
-	accessToken, err := h.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
 	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, h.log, err,
-			fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization),
-		)
+		return nil, err
 	}
 
 	// Бизнес логика
-	res, err := h.usecase.CreateCake(ctx, dto.NewCreateCakeReq(in, accessToken))
+	res, err := h.usecase.CreateCake(ctx, dto.NewCreateCakeReq(in, userID))
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create cake")
 	}
@@ -105,12 +100,10 @@ func (h *GrpcCakeHandler) CreateCake(ctx context.Context, in *gen.CreateCakeRequ
 }
 
 func (h *GrpcCakeHandler) CreateFilling(ctx context.Context, in *gen.CreateFillingRequest) (*gen.CreateFillingResponse, error) {
-	// Получаем токен из метаданных
-	accessToken, err := h.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
 	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, h.log, err,
-			fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization),
-		)
+		return nil, err
 	}
 
 	// Бизнес логика
@@ -120,7 +113,8 @@ func (h *GrpcCakeHandler) CreateFilling(ctx context.Context, in *gen.CreateFilli
 		Content:     in.Content,
 		KgPrice:     in.KgPrice,
 		Description: in.Description,
-		AccessToken: accessToken,
+		IsPlatform:  in.IsPlatform,
+		UserID:      userID,
 	})
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create filling")
@@ -132,20 +126,65 @@ func (h *GrpcCakeHandler) CreateFilling(ctx context.Context, in *gen.CreateFilli
 	}, nil
 }
 
+func (h *GrpcCakeHandler) UpdateFilling(ctx context.Context, in *gen.UpdateFillingRequest) (*gen.UpdateFillingResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	fillingID, err := uuid.Parse(in.FillingId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'filling_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	filling, err := h.usecase.UpdateFilling(ctx, dto.NewUpdateFillingReq(in, fillingID, userID))
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update filling")
+	}
+
+	// Ответ
+	return &gen.UpdateFillingResponse{
+		Filling: filling.ConvertToFillingGRPC(),
+	}, nil
+}
+
+func (h *GrpcCakeHandler) DeleteFilling(ctx context.Context, in *gen.FillingRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	fillingID, err := uuid.Parse(in.FillingId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'filling_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.DeleteFilling(ctx, userID, fillingID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to delete filling")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
 func (h *GrpcCakeHandler) CreateCategory(ctx context.Context, in *gen.CreateCategoryRequest) (*gen.CreateCategoryResponse, error) {
-	// Получаем токен из метаданных
-	accessToken, err := h.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Параметры
+	genderTags, err := models.ConvertToCategoryGendersFromGrpc(in.GenderTags)
 	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, h.log, err,
-			fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization),
-		)
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid gender tags")
 	}
 
 	// Бизнес логика
 	res, err := h.usecase.CreateCategory(ctx, &dto.CreateCategoryReq{
-		Name:        in.Name,
-		ImageData:   in.ImageData,
-		AccessToken: accessToken,
+		Name:       in.Name,
+		ImageData:  in.ImageData,
+		GenderTags: genderTags,
 	})
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create category")
@@ -157,60 +196,177 @@ func (h *GrpcCakeHandler) CreateCategory(ctx context.Context, in *gen.CreateCate
 	}, nil
 }
 
-func (h *GrpcCakeHandler) Categories(ctx context.Context, _ *emptypb.Empty) (*gen.CategoriesResponse, error) {
+func (h *GrpcCakeHandler) UpdateCategory(ctx context.Context, in *gen.UpdateCategoryRequest) (*gen.UpdateCategoryResponse, error) {
+	// Параметры
+	categoryID, err := uuid.Parse(in.CategoryId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'category_id' must be a valid UUID")
+	}
+
+	req, err := dto.NewUpdateCategoryReq(in, categoryID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid gender tags")
+	}
+
+	// Бизнес логика
+	category, err := h.usecase.UpdateCategory(ctx, req)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update category")
+	}
+
+	// Ответ
+	return &gen.UpdateCategoryResponse{
+		Category: category.ConvertToCategoryGRPC(),
+	}, nil
+}
+
+func (h *GrpcCakeHandler) DeleteCategory(ctx context.Context, in *gen.CategoryRequest) (*emptypb.Empty, error) {
+	// Параметры
+	categoryID, err := uuid.Parse(in.CategoryId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'category_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.DeleteCategory(ctx, categoryID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to delete category")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) Categories(ctx context.Context, in *gen.CategoriesRequest) (*gen.CategoriesResponse, error) {
+	// Параметры
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, "")
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
 	// Бизнес логика
-	categories, err := h.usecase.Categories(ctx)
+	categories, nextPageToken, err := h.usecase.Categories(ctx, page)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch categories")
 	}
 
 	// Маппинг
-	categoriesGRPC := make([]*gen.Category, len(*categories))
-	for i, it := range *categories {
+	categoriesGRPC := make([]*gen.Category, len(categories))
+	for i, it := range categories {
 		categoriesGRPC[i] = it.ConvertToCategoryGRPC()
 	}
 
 	// Ответ
 	return &gen.CategoriesResponse{
-		Categories: categoriesGRPC,
+		Categories:    categoriesGRPC,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-func (h *GrpcCakeHandler) Fillings(ctx context.Context, _ *emptypb.Empty) (*gen.FillingsResponse, error) {
+func (h *GrpcCakeHandler) Fillings(ctx context.Context, in *gen.FillingsRequest) (*gen.FillingsResponse, error) {
+	// Параметры
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, "")
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
 	// Бизнес логика
-	fillings, err := h.usecase.Fillings(ctx)
+	fillings, nextPageToken, err := h.usecase.Fillings(ctx, authz.ViewerID(ctx), page)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch fillings")
 	}
 
 	// Маппинг
-	fillingsGRPC := make([]*gen.Filling, len(*fillings))
-	for i, it := range *fillings {
+	fillingsGRPC := make([]*gen.Filling, len(fillings))
+	for i, it := range fillings {
 		fillingsGRPC[i] = it.ConvertToFillingGRPC()
 	}
 
 	// Ответ
 	return &gen.FillingsResponse{
-		Fillings: fillingsGRPC,
+		Fillings:      fillingsGRPC,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-func (h *GrpcCakeHandler) Cakes(ctx context.Context, _ *emptypb.Empty) (*gen.CakesResponse, error) {
+func (h *GrpcCakeHandler) Cakes(ctx context.Context, in *gen.CakesRequest) (*gen.CakesResponse, error) {
+	// Параметры
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, string(dto.CakeSortRating))
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
 	// Бизнес логика
-	cakes, err := h.usecase.GetCakesPreview(ctx)
+	res, err := h.usecase.GetCakesPreview(ctx, authz.ViewerID(ctx), page)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch cakes")
 	}
 
 	// Маппинг
-	cakesGRPC := make([]*gen.PreviewCake, len(cakes))
-	for i, it := range cakes {
+	cakesGRPC := make([]*gen.PreviewCake, len(res.Cakes))
+	for i, it := range res.Cakes {
 		cakesGRPC[i] = it.ConvertToGrpcModel()
 	}
 
 	// Ответ
 	return &gen.CakesResponse{
-		Cakes: cakesGRPC,
+		Cakes:         cakesGRPC,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) SearchCakes(ctx context.Context, in *gen.SearchCakesReq) (*gen.SearchCakesRes, error) {
+	// Параметры
+	req, err := dto.NewSearchCakesReq(in)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid search parameters")
+	}
+
+	// Пользователь необязателен, кроме фильтра по адресу доставки
+	req.ViewerID = authz.ViewerID(ctx)
+
+	// Бизнес логика
+	res, err := h.usecase.SearchCakes(ctx, req)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to search cakes")
+	}
+
+	// Маппинг
+	cakesGRPC := make([]*gen.PreviewCake, len(res.Cakes))
+	for i, it := range res.Cakes {
+		cakesGRPC[i] = it.ConvertToGrpcModel()
+	}
+
+	// Ответ
+	return &gen.SearchCakesRes{
+		Cakes:         cakesGRPC,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) NearbyCakes(ctx context.Context, in *gen.NearbyCakesReq) (*gen.NearbyCakesRes, error) {
+	// Параметры
+	req, err := dto.NewNearbyCakesReq(in)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid nearby search parameters")
+	}
+	req.ViewerID = authz.ViewerID(ctx)
+
+	// Бизнес логика
+	res, err := h.usecase.SearchCakes(ctx, req)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch nearby cakes")
+	}
+
+	// Маппинг
+	cakesGRPC := make([]*gen.PreviewCake, len(res.Cakes))
+	for i, it := range res.Cakes {
+		cakesGRPC[i] = it.ConvertToGrpcModel()
+	}
+
+	// Ответ
+	return &gen.NearbyCakesRes{
+		Cakes:         cakesGRPC,
+		NextPageToken: res.NextPageToken,
 	}, nil
 }
 
@@ -246,20 +402,545 @@ func (h *GrpcCakeHandler) CategoryPreviewCakes(ctx context.Context, in *gen.Cate
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "parsing category id")
 	}
 
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, string(dto.CakeSortRating))
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
 	// Бизнес логика
-	previewCakes, err := h.usecase.CategoryPreviewCakes(ctx, categoryID)
+	res, err := h.usecase.CategoryPreviewCakes(ctx, authz.ViewerID(ctx), categoryID, page)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch preview cakes")
 	}
 
 	// Маппинг
-	res := make([]*gen.PreviewCake, len(previewCakes))
-	for i, it := range previewCakes {
-		res[i] = it.ConvertToGrpcModel()
+	previewCakes := make([]*gen.PreviewCake, len(res.Cakes))
+	for i, it := range res.Cakes {
+		previewCakes[i] = it.ConvertToGrpcModel()
 	}
 
 	// Ответ
 	return &gen.CategoryPreviewCakesRes{
-		PreviewCakes: res,
+		PreviewCakes:  previewCakes,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) UpdateCake(ctx context.Context, in *gen.UpdateCakeRequest) (*gen.UpdateCakeResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.UpdateCake(ctx, dto.NewUpdateCakeReq(in, cakeID, userID))
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update cake")
+	}
+
+	// Ответ
+	return &gen.UpdateCakeResponse{
+		Cake: res.Cake.ConvertToCakeGRPC(),
+	}, nil
+}
+
+func (h *GrpcCakeHandler) SetCakeSaleStatus(ctx context.Context, in *gen.SetCakeSaleStatusRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.SetCakeSaleStatus(ctx, userID, cakeID, in.IsOpenForSale); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to set cake sale status")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) DeleteCake(ctx context.Context, in *gen.CakeRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.DeleteCake(ctx, userID, cakeID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to delete cake")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) AddCakeImages(ctx context.Context, in *gen.AddCakeImagesRequest) (*gen.CakeImagesResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	images, err := h.usecase.AddCakeImages(ctx, userID, cakeID, in.Images)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to add cake images")
+	}
+
+	// Ответ
+	return newCakeImagesResponse(images), nil
+}
+
+func (h *GrpcCakeHandler) RemoveCakeImage(ctx context.Context, in *gen.RemoveCakeImageRequest) (*gen.CakeImagesResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	imageID, err := uuid.Parse(in.ImageId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'image_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	images, err := h.usecase.RemoveCakeImage(ctx, userID, cakeID, imageID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to remove cake image")
+	}
+
+	// Ответ
+	return newCakeImagesResponse(images), nil
+}
+
+func (h *GrpcCakeHandler) ReorderCakeImages(ctx context.Context, in *gen.ReorderCakeImagesRequest) (*gen.CakeImagesResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	imageIDs := make([]uuid.UUID, len(in.ImageIds))
+	for i, id := range in.ImageIds {
+		if imageIDs[i], err = uuid.Parse(id); err != nil {
+			return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'image_ids' must be valid UUIDs")
+		}
+	}
+
+	// Бизнес логика
+	images, err := h.usecase.ReorderCakeImages(ctx, userID, cakeID, imageIDs)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to reorder cake images")
+	}
+
+	// Ответ
+	return newCakeImagesResponse(images), nil
+}
+
+func newCakeImagesResponse(images []models.CakeImage) *gen.CakeImagesResponse {
+	res := make([]*gen.Cake_CakeImage, len(images))
+	for i, image := range images {
+		res[i] = image.ConvertToCakeImageGRPC()
+	}
+
+	return &gen.CakeImagesResponse{
+		Images: res,
+	}
+}
+
+func (h *GrpcCakeHandler) AddFavorite(ctx context.Context, in *gen.FavoriteReq) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	targetID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err), "parsing favorite id")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.AddFavorite(ctx, userID, dto.ConvertToFavoriteTargetFromGrpc(in.Target), targetID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to add favorite")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) RemoveFavorite(ctx context.Context, in *gen.FavoriteReq) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	targetID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err), "parsing favorite id")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.RemoveFavorite(ctx, userID, dto.ConvertToFavoriteTargetFromGrpc(in.Target), targetID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to remove favorite")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) Favorites(ctx context.Context, in *gen.FavoritesReq) (*gen.FavoritesRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	target := dto.ConvertToFavoriteTargetFromGrpc(in.Target)
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, target.CursorKey())
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
+	// Бизнес логика
+	res, err := h.usecase.Favorites(ctx, userID, target, page)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch favorites")
+	}
+
+	// Маппинг
+	cakesGRPC := make([]*gen.PreviewCake, len(res.Cakes))
+	for i, it := range res.Cakes {
+		cakesGRPC[i] = it.ConvertToGrpcModel()
+	}
+
+	sellersGRPC := make([]*gen.User, len(res.Sellers))
+	for i, it := range res.Sellers {
+		sellersGRPC[i] = it.ConvertToGrpcUser()
+	}
+
+	// Ответ
+	return &gen.FavoritesRes{
+		Cakes:         cakesGRPC,
+		Sellers:       sellersGRPC,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) CreatePromotion(ctx context.Context, in *gen.CreatePromotionReq) (*gen.CreatePromotionRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	req, err := dto.NewCreatePromotionReq(in, userID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid promotion parameters")
+	}
+
+	// Бизнес логика
+	promotion, err := h.usecase.CreatePromotion(ctx, req)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create promotion")
+	}
+
+	// Ответ
+	return &gen.CreatePromotionRes{
+		Promotion: promotion.ConvertToGrpcModel(),
+	}, nil
+}
+
+func (h *GrpcCakeHandler) CancelPromotion(ctx context.Context, in *gen.PromotionRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	promotionID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err), "parsing promotion id")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.CancelPromotion(ctx, userID, promotionID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to cancel promotion")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) Promotions(ctx context.Context, in *gen.PromotionsReq) (*gen.PromotionsRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, dto.PromotionsCursorKey)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
+	// Бизнес логика
+	promotions, nextPageToken, err := h.usecase.Promotions(ctx, userID, page)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch promotions")
+	}
+
+	// Маппинг
+	promotionsGRPC := make([]*gen.Promotion, len(promotions))
+	for i, it := range promotions {
+		promotionsGRPC[i] = it.ConvertToGrpcModel()
+	}
+
+	// Ответ
+	return &gen.PromotionsRes{
+		Promotions:    promotionsGRPC,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) CreatePromoCode(ctx context.Context, in *gen.CreatePromoCodeReq) (*gen.CreatePromoCodeRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	req, err := dto.NewCreatePromoCodeReq(in, userID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid promo code parameters")
+	}
+
+	// Бизнес логика
+	promoCode, err := h.usecase.CreatePromoCode(ctx, req)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create promo code")
+	}
+
+	// Ответ
+	return &gen.CreatePromoCodeRes{
+		PromoCode: promoCode.ConvertToGrpcModel(),
+	}, nil
+}
+
+func (h *GrpcCakeHandler) CancelPromoCode(ctx context.Context, in *gen.PromoCodeRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	promoCodeID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err), "parsing promo code id")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.CancelPromoCode(ctx, userID, promoCodeID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to cancel promo code")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcCakeHandler) PromoCodes(ctx context.Context, in *gen.PromoCodesReq) (*gen.PromoCodesRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	page, err := pagination.NewPage(in.PageSize, in.PageToken, dto.PromoCodesCursorKey)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid page token")
+	}
+
+	// Бизнес логика
+	promoCodes, nextPageToken, err := h.usecase.PromoCodes(ctx, userID, page)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch promo codes")
+	}
+
+	// Маппинг
+	promoCodesGRPC := make([]*gen.PromoCode, len(promoCodes))
+	for i, it := range promoCodes {
+		promoCodesGRPC[i] = it.ConvertToGrpcModel()
+	}
+
+	// Ответ
+	return &gen.PromoCodesRes{
+		PromoCodes:    promoCodesGRPC,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) CakeOptions(ctx context.Context, in *gen.CakeOptionsReq) (*gen.CakeOptionsRes, error) {
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	options, err := h.usecase.CakeOptions(ctx, cakeID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch cake options")
+	}
+
+	// Маппинг
+	optionsGRPC := make([]*gen.CakeOption, len(options))
+	for i, it := range options {
+		optionsGRPC[i] = it.ConvertToGrpcModel()
+	}
+
+	// Ответ
+	return &gen.CakeOptionsRes{
+		Options: optionsGRPC,
+	}, nil
+}
+
+func (h *GrpcCakeHandler) CreateCakeOption(ctx context.Context, in *gen.CreateCakeOptionReq) (*gen.CakeOptionRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	cakeID, err := uuid.Parse(in.CakeId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'cake_id' must be a valid UUID")
+	}
+
+	option, err := models.NewCakeOption(in.Option)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid cake option")
+	}
+
+	// Бизнес логика
+	created, err := h.usecase.CreateCakeOption(ctx, userID, cakeID, option)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create cake option")
+	}
+
+	// Ответ
+	return &gen.CakeOptionRes{
+		Option: created.ConvertToGrpcModel(),
+	}, nil
+}
+
+func (h *GrpcCakeHandler) UpdateCakeOption(ctx context.Context, in *gen.UpdateCakeOptionReq) (*gen.CakeOptionRes, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	optionID, err := uuid.Parse(in.OptionId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'option_id' must be a valid UUID")
+	}
+
+	option, err := models.NewCakeOption(in.Option)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "invalid cake option")
+	}
+
+	// Бизнес логика
+	updated, err := h.usecase.UpdateCakeOption(ctx, userID, optionID, option)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update cake option")
+	}
+
+	// Ответ
+	return &gen.CakeOptionRes{
+		Option: updated.ConvertToGrpcModel(),
 	}, nil
 }
+
+func (h *GrpcCakeHandler) DeleteCakeOption(ctx context.Context, in *gen.CakeOptionRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	optionID, err := uuid.Parse(in.OptionId)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "'option_id' must be a valid UUID")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.DeleteCakeOption(ctx, userID, optionID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to delete cake option")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+// userID Возвращает пользователя, которого положил в контекст authz.AuthUnaryInterceptor
+func (h *GrpcCakeHandler) userID(ctx context.Context) (uuid.UUID, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return uuid.Nil, errs.ConvertToGrpcError(ctx, h.log, err, "unauthenticated request")
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+// MethodPolicies Методы сервиса тортов, которым не обязателен токен. Остальные требуют авторизации
+var MethodPolicies = authz.MethodPolicies{
+	// Каталог
+	gen.CakeService_Cake_FullMethodName:                      authz.PolicyPublic,
+	gen.CakeService_CakeOptions_FullMethodName:               authz.PolicyPublic,
+	gen.CakeService_GetColors_FullMethodName:                 authz.PolicyPublic,
+	gen.CakeService_Categories_FullMethodName:                authz.PolicyPublic,
+	gen.CakeService_GetCategoriesByGenderName_FullMethodName: authz.PolicyPublic,
+
+	// Авторизованному пользователю отмечаются избранные торты и видны его начинки
+	gen.CakeService_Cakes_FullMethodName:                authz.PolicyOptional,
+	gen.CakeService_CategoryPreviewCakes_FullMethodName: authz.PolicyOptional,
+	gen.CakeService_SearchCakes_FullMethodName:          authz.PolicyOptional,
+	gen.CakeService_NearbyCakes_FullMethodName:          authz.PolicyOptional,
+	gen.CakeService_Fillings_FullMethodName:             authz.PolicyOptional,
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+var (
+	sellerRoles  = models.Roles{models.RoleSeller}
+	catalogRoles = models.Roles{models.RoleSeller, models.RoleAdmin}
+	adminRoles   = models.Roles{models.RoleAdmin}
+)
+
+// MethodRoles Методы сервиса тортов, доступные только части ролей. Каталог и избранное доступны всем
+var MethodRoles = authz.MethodRoles{
+	// Торты продавца
+	gen.CakeService_CreateCake_FullMethodName:        sellerRoles,
+	gen.CakeService_UpdateCake_FullMethodName:        sellerRoles,
+	gen.CakeService_SetCakeSaleStatus_FullMethodName: sellerRoles,
+	gen.CakeService_DeleteCake_FullMethodName:        sellerRoles,
+	gen.CakeService_AddCakeImages_FullMethodName:     sellerRoles,
+	gen.CakeService_RemoveCakeImage_FullMethodName:   sellerRoles,
+	gen.CakeService_ReorderCakeImages_FullMethodName: sellerRoles,
+	gen.CakeService_AddCakeColors_FullMethodName:     sellerRoles,
+	gen.CakeService_CreateCakeOption_FullMethodName:  sellerRoles,
+	gen.CakeService_UpdateCakeOption_FullMethodName:  sellerRoles,
+	gen.CakeService_DeleteCakeOption_FullMethodName:  sellerRoles,
+
+	// Акции и промокоды продавца
+	gen.CakeService_CreatePromotion_FullMethodName: sellerRoles,
+	gen.CakeService_CancelPromotion_FullMethodName: sellerRoles,
+	gen.CakeService_Promotions_FullMethodName:      sellerRoles,
+	gen.CakeService_CreatePromoCode_FullMethodName: sellerRoles,
+	gen.CakeService_CancelPromoCode_FullMethodName: sellerRoles,
+	gen.CakeService_PromoCodes_FullMethodName:      sellerRoles,
+
+	// Начинки: свои заводит продавец, общие — администратор
+	gen.CakeService_CreateFilling_FullMethodName: catalogRoles,
+	gen.CakeService_UpdateFilling_FullMethodName: catalogRoles,
+	gen.CakeService_DeleteFilling_FullMethodName: catalogRoles,
+
+	// Категории
+	gen.CakeService_CreateCategory_FullMethodName: adminRoles,
+	gen.CakeService_UpdateCategory_FullMethodName: adminRoles,
+	gen.CakeService_DeleteCategory_FullMethodName: adminRoles,
+}
@@ -17,6 +17,7 @@ type PreviewCake struct {
 	KgPrice         float64
 	ReviewsCount    uint
 	StarsSum        uint
+	Rating          float64 // Средний рейтинг (stars_sum / reviews_count)
 	Description     null.String
 	Mass            float64
 	DiscountKgPrice null.Float
@@ -25,6 +26,9 @@ type PreviewCake struct {
 	IsOpenForSale   bool
 	Owner           Owner
 	ColorsHex       []string
+	DistanceKm      null.Float // Расстояние до продавца (только в гео-поиске)
+	IsFavorite      bool       // Торт в избранном у текущего пользователя
+	FavoritesCount  uint       // Сколько пользователей добавили торт в избранное
 }
 
 type PreviewCakeDB struct {
@@ -34,6 +38,7 @@ type PreviewCakeDB struct {
 	KgPrice         float64
 	ReviewsCount    uint
 	StarsSum        uint
+	Rating          float64 // Средний рейтинг (stars_sum / reviews_count)
 	Description     null.String
 	Mass            float64
 	DiscountKgPrice null.Float
@@ -41,6 +46,7 @@ type PreviewCakeDB struct {
 	DateCreation    time.Time
 	IsOpenForSale   bool
 	OwnerID         uuid.UUID
+	FavoritesCount  uint
 }
 
 func (pc PreviewCake) ConvertToGrpcModel() *generated.PreviewCake {
@@ -59,18 +65,13 @@ func (pc PreviewCake) ConvertToGrpcModel() *generated.PreviewCake {
 		discountEndTime = timestamppb.New(pc.DiscountEndTime.Time)
 	}
 
-	// рейтинг = сумма / кол-во
-	var rating uint32 = 0
-	if pc.ReviewsCount != 0 {
-		rating = uint32(pc.StarsSum / pc.ReviewsCount)
-	}
-
 	return &generated.PreviewCake{
 		Id:              pc.ID.String(),
 		Name:            pc.Name,
 		PreviewImageUrl: pc.PreviewImageURL,
 		KgPrice:         pc.KgPrice,
-		Rating:          rating,
+		Rating:          pc.Rating,
+		ReviewsCount:    int32(pc.ReviewsCount),
 		Description:     description,
 		Mass:            pc.Mass,
 		DiscountKgPrice: discountKgPrice,
@@ -79,6 +80,9 @@ func (pc PreviewCake) ConvertToGrpcModel() *generated.PreviewCake {
 		IsOpenForSale:   pc.IsOpenForSale,
 		Owner:           pc.Owner.ConvertToGrpcUser(),
 		ColorsHex:       pc.ColorsHex,
+		DistanceKm:      pc.DistanceKm.Ptr(),
+		IsFavorite:      pc.IsFavorite,
+		FavoritesCount:  int32(pc.FavoritesCount),
 	}
 }
 
@@ -89,6 +93,7 @@ func (pc *PreviewCakeDB) ConvertToPreviewCake(owner Owner) PreviewCake {
 		PreviewImageURL: pc.PreviewImageURL,
 		KgPrice:         pc.KgPrice,
 		StarsSum:        pc.StarsSum,
+		Rating:          pc.Rating,
 		ReviewsCount:    pc.ReviewsCount,
 		Description:     pc.Description,
 		Mass:            pc.Mass,
@@ -97,5 +102,6 @@ func (pc *PreviewCakeDB) ConvertToPreviewCake(owner Owner) PreviewCake {
 		DateCreation:    pc.DateCreation,
 		IsOpenForSale:   pc.IsOpenForSale,
 		Owner:           owner,
+		FavoritesCount:  pc.FavoritesCount,
 	}
 }
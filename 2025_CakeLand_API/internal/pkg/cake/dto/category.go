@@ -2,6 +2,7 @@ package dto
 
 import (
 	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 )
@@ -21,3 +22,47 @@ func (c *DBCategory) ConvertToCategory() models.Category {
 		CategoryGenders: c.CategoryGenders,
 	}
 }
+
+type UpdateCategoryReq struct {
+	CategoryID uuid.UUID               // Код категории
+	Name       null.String             // Название категории
+	ImageData  []byte                  // Новая фотография (nil — не меняется)
+	GenderTags []models.CategoryGender // Новые теги (nil — не меняются)
+}
+
+func NewUpdateCategoryReq(in *gen.UpdateCategoryRequest, categoryID uuid.UUID) (UpdateCategoryReq, error) {
+	req := UpdateCategoryReq{
+		CategoryID: categoryID,
+		Name:       null.StringFromPtr(in.Name),
+		ImageData:  in.ImageData,
+	}
+
+	if in.GenderTags != nil {
+		genderTags, err := models.ConvertToCategoryGendersFromGrpc(in.GenderTags.Tags)
+		if err != nil {
+			return UpdateCategoryReq{}, err
+		}
+		req.GenderTags = genderTags
+	}
+
+	return req, nil
+}
+
+func (req *UpdateCategoryReq) ConvertToUpdateCategoryDBReq(imageURL null.String) UpdateCategoryDBReq {
+	return UpdateCategoryDBReq{
+		ID:               req.CategoryID,
+		Name:             req.Name,
+		ImageURL:         imageURL,
+		UpdateGenderTags: req.GenderTags != nil,
+		GenderTags:       req.GenderTags,
+	}
+}
+
+// UpdateCategoryDBReq Невалидные (null) поля не изменяются
+type UpdateCategoryDBReq struct {
+	ID               uuid.UUID
+	Name             null.String
+	ImageURL         null.String
+	UpdateGenderTags bool
+	GenderTags       []models.CategoryGender
+}
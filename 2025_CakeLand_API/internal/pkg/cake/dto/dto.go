@@ -32,11 +32,11 @@ type CreateCakeReq struct {
 	IsOpenForSale          bool       // Доступен ли для продажи
 	FillingIDs             []string   // Список ID начинок
 	CategoryIDs            []string   // Список ID категорий
-	AccessToken            string     // Токен пользователя
+	UserID                 uuid.UUID  // Продавец
 	Images                 [][]byte   // Изображения торта
 }
 
-func NewCreateCakeReq(in *gen.CreateCakeRequest, accessToken string) CreateCakeReq {
+func NewCreateCakeReq(in *gen.CreateCakeRequest, userID uuid.UUID) CreateCakeReq {
 	var discountKgPrice null.Float
 	if in.DiscountKgPrice != nil {
 		discountKgPrice = null.FloatFrom(in.DiscountKgPrice.Value)
@@ -58,7 +58,7 @@ func NewCreateCakeReq(in *gen.CreateCakeRequest, accessToken string) CreateCakeR
 		IsOpenForSale:          in.IsOpenForSale,
 		FillingIDs:             in.FillingIds,
 		CategoryIDs:            in.CategoryIds,
-		AccessToken:            accessToken,
+		UserID:                 userID,
 		Images:                 in.Images,
 	}
 }
@@ -106,15 +106,78 @@ type CreateCakeRes struct {
 	CakeID string
 }
 
+// UpdateCake
+
+type UpdateCakeReq struct {
+	CakeID                 uuid.UUID   // Код торта
+	Name                   null.String // Название торта
+	PreviewImageData       []byte      // Новое изображение торта (nil — не меняется)
+	KgPrice                null.Float  // Цена за кг
+	DiscountedKgPrice      null.Float  // Цена за кг по скидке
+	DiscountedPriceEndDate null.Time   // Дата окончания скидки
+	RemoveDiscount         bool        // Убрать скидку
+	Description            null.String // Описание торта
+	Mass                   null.Float  // Масса торта
+	UserID                 uuid.UUID   // Продавец
+}
+
+func NewUpdateCakeReq(in *gen.UpdateCakeRequest, cakeID, userID uuid.UUID) UpdateCakeReq {
+	var discountEndDate null.Time
+	if in.DiscountEndTime != nil {
+		discountEndDate = null.TimeFrom(in.DiscountEndTime.AsTime())
+	}
+
+	return UpdateCakeReq{
+		CakeID:                 cakeID,
+		Name:                   null.StringFromPtr(in.Name),
+		PreviewImageData:       in.PreviewImageData,
+		KgPrice:                null.FloatFromPtr(in.KgPrice),
+		DiscountedKgPrice:      null.FloatFromPtr(in.DiscountKgPrice),
+		DiscountedPriceEndDate: discountEndDate,
+		RemoveDiscount:         in.RemoveDiscount,
+		Description:            null.StringFromPtr(in.Description),
+		Mass:                   null.FloatFromPtr(in.Mass),
+		UserID:                 userID,
+	}
+}
+
+func (req *UpdateCakeReq) ConvertToUpdateCakeDBReq(previewImageURL null.String) UpdateCakeDBReq {
+	return UpdateCakeDBReq{
+		ID:                     req.CakeID,
+		Name:                   req.Name,
+		PreviewImageURL:        previewImageURL,
+		KgPrice:                req.KgPrice,
+		DiscountedKgPrice:      req.DiscountedKgPrice,
+		DiscountedPriceEndDate: req.DiscountedPriceEndDate,
+		RemoveDiscount:         req.RemoveDiscount,
+		Description:            req.Description,
+		Mass:                   req.Mass,
+	}
+}
+
+// UpdateCakeDBReq Невалидные (null) поля не изменяются
+type UpdateCakeDBReq struct {
+	ID                     uuid.UUID   // Код торта
+	Name                   null.String // Название торта
+	PreviewImageURL        null.String // URL изображения торта
+	KgPrice                null.Float  // Цена за кг
+	DiscountedKgPrice      null.Float  // Цена за кг по скидке
+	DiscountedPriceEndDate null.Time   // Дата окончания скидки
+	RemoveDiscount         bool        // Убрать скидку
+	Description            null.String // Описание торта
+	Mass                   null.Float  // Масса торта
+}
+
 // CreateFilling
 
 type CreateFillingReq struct {
-	Name        string  // Название начинки
-	ImageData   []byte  // Картинка начинки
-	Content     string  // Содержимое начинки
-	KgPrice     float64 // Цена за кг начинки
-	Description string  // Описание начинки
-	AccessToken string  // Токен пользователя
+	Name        string    // Название начинки
+	ImageData   []byte    // Картинка начинки
+	Content     string    // Содержимое начинки
+	KgPrice     float64   // Цена за кг начинки
+	Description string    // Описание начинки
+	IsPlatform  bool      // Общая начинка платформы (только для администраторов)
+	UserID      uuid.UUID // Пользователь
 }
 
 type CreateFillingRes struct {
@@ -124,9 +187,9 @@ type CreateFillingRes struct {
 // Create Category
 
 type CreateCategoryReq struct {
-	Name        string // Название категории
-	ImageData   []byte // Фотография категории
-	AccessToken string // Токен пользователя
+	Name       string                  // Название категории
+	ImageData  []byte                  // Фотография категории
+	GenderTags []models.CategoryGender // Теги категории
 }
 
 type CreateCategoryRes struct {
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+)
+
+type FavoriteTarget string
+
+const (
+	FavoriteTargetCake   FavoriteTarget = "cake"
+	FavoriteTargetSeller FavoriteTarget = "seller"
+)
+
+// FavoriteSellersCursorKey Контекст курсора списка избранных продавцов
+const FavoriteSellersCursorKey = "favorite_sellers"
+
+func ConvertToFavoriteTargetFromGrpc(target gen.FavoriteTarget) FavoriteTarget {
+	if target == gen.FavoriteTarget_FAVORITE_TARGET_SELLER {
+		return FavoriteTargetSeller
+	}
+
+	return FavoriteTargetCake
+}
+
+// CursorKey Возвращает контекст курсора списка избранного
+func (t FavoriteTarget) CursorKey() string {
+	if t == FavoriteTargetSeller {
+		return FavoriteSellersCursorKey
+	}
+
+	return string(CakeSortFavorited)
+}
+
+// Favorites
+
+type FavoritesRes struct {
+	Cakes         []PreviewCake // Избранные торты (для FavoriteTargetCake)
+	Sellers       []Owner       // Избранные продавцы (для FavoriteTargetSeller)
+	NextPageToken string
+}
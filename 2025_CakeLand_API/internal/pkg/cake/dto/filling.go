@@ -2,6 +2,7 @@ package dto
 
 import (
 	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 )
@@ -25,3 +26,46 @@ func (f *DBFilling) ConvertToFilling() models.Filling {
 		Description: f.Description.String,
 	}
 }
+
+type UpdateFillingReq struct {
+	FillingID   uuid.UUID   // Код начинки
+	Name        null.String // Название начинки
+	ImageData   []byte      // Новая картинка (nil — не меняется)
+	Content     null.String // Содержимое начинки
+	KgPrice     null.Float  // Цена за кг
+	Description null.String // Описание начинки
+	UserID      uuid.UUID   // Пользователь
+}
+
+func NewUpdateFillingReq(in *gen.UpdateFillingRequest, fillingID, userID uuid.UUID) UpdateFillingReq {
+	return UpdateFillingReq{
+		FillingID:   fillingID,
+		Name:        null.StringFromPtr(in.Name),
+		ImageData:   in.ImageData,
+		Content:     null.StringFromPtr(in.Content),
+		KgPrice:     null.FloatFromPtr(in.KgPrice),
+		Description: null.StringFromPtr(in.Description),
+		UserID:      userID,
+	}
+}
+
+func (req *UpdateFillingReq) ConvertToUpdateFillingDBReq(imageURL null.String) UpdateFillingDBReq {
+	return UpdateFillingDBReq{
+		ID:          req.FillingID,
+		Name:        req.Name,
+		ImageURL:    imageURL,
+		Content:     req.Content,
+		KgPrice:     req.KgPrice,
+		Description: req.Description,
+	}
+}
+
+// UpdateFillingDBReq Невалидные (null) поля не изменяются
+type UpdateFillingDBReq struct {
+	ID          uuid.UUID
+	Name        null.String
+	ImageURL    null.String
+	Content     null.String
+	KgPrice     null.Float
+	Description null.String
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -18,6 +19,21 @@ type Owner struct {
 	HeaderImageURL null.String
 }
 
+func NewOwner(p *profileGen.Profile) Owner {
+	id, _ := uuid.Parse(p.GetId())
+
+	return Owner{
+		ID:             id,
+		Nickname:       p.GetNickname(),
+		Mail:           p.GetMail(),
+		FIO:            null.NewString(p.GetFio().GetValue(), p.Fio != nil),
+		Address:        null.NewString(p.GetAddress().GetValue(), p.Address != nil),
+		Phone:          null.NewString(p.GetPhone().GetValue(), p.Phone != nil),
+		ImageURL:       null.NewString(p.GetImageUrl().GetValue(), p.ImageUrl != nil),
+		HeaderImageURL: null.NewString(p.GetHeaderImageUrl().GetValue(), p.HeaderImageUrl != nil),
+	}
+}
+
 func (pc *Owner) ConvertToGrpcUser() *generated.User {
 	var fio *wrapperspb.StringValue
 	if pc.FIO.Valid {
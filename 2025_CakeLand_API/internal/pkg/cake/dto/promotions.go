@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"time"
+)
+
+// Контексты курсоров списков акций и промокодов
+const (
+	PromotionsCursorKey = "promotions"
+	PromoCodesCursorKey = "promo_codes"
+)
+
+// CreatePromotion
+
+type CreatePromotionReq struct {
+	UserID     uuid.UUID
+	CakeID     uuid.NullUUID // Акция на торт
+	CategoryID uuid.NullUUID // Акция на торты продавца из категории
+	Discount   models.Discount
+	StartsAt   time.Time
+	EndsAt     time.Time
+}
+
+func NewCreatePromotionReq(in *gen.CreatePromotionReq, userID uuid.UUID) (CreatePromotionReq, error) {
+	cakeID, err := parseOptionalUUID(in.CakeId)
+	if err != nil {
+		return CreatePromotionReq{}, err
+	}
+
+	categoryID, err := parseOptionalUUID(in.CategoryId)
+	if err != nil {
+		return CreatePromotionReq{}, err
+	}
+
+	if in.EndsAt == nil {
+		return CreatePromotionReq{}, errs.ErrInvalidInput
+	}
+
+	return CreatePromotionReq{
+		UserID:     userID,
+		CakeID:     cakeID,
+		CategoryID: categoryID,
+		Discount: models.Discount{
+			Kind:  models.ConvertToDiscountKindFromGrpc(in.Kind),
+			Value: in.Value,
+		},
+		StartsAt: startsAtOrNow(in.StartsAt.AsTime(), in.StartsAt != nil),
+		EndsAt:   in.EndsAt.AsTime(),
+	}, nil
+}
+
+// CreatePromoCode
+
+type CreatePromoCodeReq struct {
+	UserID         uuid.UUID
+	Code           string // Нормализованный код
+	Discount       models.Discount
+	StartsAt       time.Time
+	ExpiresAt      time.Time
+	MaxUses        null.Int
+	MaxUsesPerUser int
+}
+
+func NewCreatePromoCodeReq(in *gen.CreatePromoCodeReq, userID uuid.UUID) (CreatePromoCodeReq, error) {
+	if in.ExpiresAt == nil {
+		return CreatePromoCodeReq{}, errs.ErrInvalidInput
+	}
+
+	maxUsesPerUser := 1
+	if in.MaxUsesPerUser != nil {
+		maxUsesPerUser = int(in.GetMaxUsesPerUser())
+	}
+
+	var maxUses null.Int
+	if in.MaxUses != nil {
+		maxUses = null.IntFrom(int64(in.GetMaxUses()))
+	}
+
+	return CreatePromoCodeReq{
+		UserID: userID,
+		Code:   models.NormalizePromoCode(in.Code),
+		Discount: models.Discount{
+			Kind:  models.ConvertToDiscountKindFromGrpc(in.Kind),
+			Value: in.Value,
+		},
+		StartsAt:       startsAtOrNow(in.StartsAt.AsTime(), in.StartsAt != nil),
+		ExpiresAt:      in.ExpiresAt.AsTime(),
+		MaxUses:        maxUses,
+		MaxUsesPerUser: maxUsesPerUser,
+	}, nil
+}
+
+func parseOptionalUUID(id *string) (uuid.NullUUID, error) {
+	if id == nil {
+		return uuid.NullUUID{}, nil
+	}
+
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return uuid.NullUUID{}, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
+	}
+
+	return uuid.NullUUID{UUID: parsed, Valid: true}, nil
+}
+
+func startsAtOrNow(startsAt time.Time, valid bool) time.Time {
+	if !valid {
+		return time.Now()
+	}
+
+	return startsAt
+}
@@ -0,0 +1,187 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"strings"
+)
+
+type CakeSort string
+
+const (
+	CakeSortRelevance  CakeSort = "relevance"
+	CakeSortPriceAsc   CakeSort = "price_asc"
+	CakeSortPriceDesc  CakeSort = "price_desc"
+	CakeSortRating     CakeSort = "rating"
+	CakeSortNewest     CakeSort = "newest"
+	CakeSortPopularity CakeSort = "popularity"
+	CakeSortDistance   CakeSort = "distance"
+	CakeSortFavorited  CakeSort = "favorited" // Только для списка избранного: сначала добавленные последними
+)
+
+func ConvertToCakeSortFromGrpc(sort gen.CakeSort) CakeSort {
+	switch sort {
+	case gen.CakeSort_CAKE_SORT_PRICE_ASC:
+		return CakeSortPriceAsc
+	case gen.CakeSort_CAKE_SORT_PRICE_DESC:
+		return CakeSortPriceDesc
+	case gen.CakeSort_CAKE_SORT_RATING:
+		return CakeSortRating
+	case gen.CakeSort_CAKE_SORT_NEWEST:
+		return CakeSortNewest
+	case gen.CakeSort_CAKE_SORT_POPULARITY:
+		return CakeSortPopularity
+	case gen.CakeSort_CAKE_SORT_DISTANCE:
+		return CakeSortDistance
+	default:
+		return CakeSortRelevance
+	}
+}
+
+// SearchCakes
+
+type SearchCakesReq struct {
+	Query           string          // Поисковая строка
+	MinPrice        null.Float      // Минимальная цена за кг (с учётом скидки)
+	MaxPrice        null.Float      // Максимальная цена за кг (с учётом скидки)
+	MinMass         null.Float      // Минимальная масса
+	MaxMass         null.Float      // Максимальная масса
+	CategoryIDs     []uuid.UUID     // Категории (любая из)
+	FillingIDs      []uuid.UUID     // Начинки (любая из)
+	ColorsHex       []string        // Цвета (любой из)
+	OnlyOpenForSale bool            // Только доступные для продажи
+	OnlyDiscounted  bool            // Только с действующей скидкой
+	Sort            CakeSort        // Сортировка
+	Page            pagination.Page // Страница (курсор выдаётся для конкретной сортировки)
+
+	DeliveryAddressID uuid.NullUUID    // Адрес пользователя, на который нужна доставка
+	DeliverTo         *models.GeoPoint // Точка, входящая в зону доставки продавца (заполняет usecase по адресу)
+	Near              *models.GeoPoint // Точка, от которой считается расстояние до продавца
+	RadiusKm          null.Float       // Максимальное расстояние от Near до продавца
+
+	ViewerID      uuid.NullUUID // Пользователь (необязателен, кроме фильтра по адресу доставки): для него отмечаются избранные торты
+	OnlyFavorites bool          // Только избранные торты ViewerID
+}
+
+func NewSearchCakesReq(in *gen.SearchCakesReq) (SearchCakesReq, error) {
+	categoryIDs, err := parseUUIDs(in.CategoryIds)
+	if err != nil {
+		return SearchCakesReq{}, err
+	}
+
+	fillingIDs, err := parseUUIDs(in.FillingIds)
+	if err != nil {
+		return SearchCakesReq{}, err
+	}
+
+	var deliveryAddressID uuid.NullUUID
+	if in.DeliveryAddressId != nil {
+		if deliveryAddressID.UUID, err = uuid.Parse(in.GetDeliveryAddressId()); err != nil {
+			return SearchCakesReq{}, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
+		}
+		deliveryAddressID.Valid = true
+	}
+
+	colorsHex := make([]string, len(in.ColorsHex))
+	for i, color := range in.ColorsHex {
+		colorsHex[i] = strings.ToUpper(color)
+	}
+
+	req := SearchCakesReq{
+		Query:           strings.TrimSpace(in.Query),
+		MinPrice:        null.FloatFromPtr(in.MinPrice),
+		MaxPrice:        null.FloatFromPtr(in.MaxPrice),
+		MinMass:         null.FloatFromPtr(in.MinMass),
+		MaxMass:         null.FloatFromPtr(in.MaxMass),
+		CategoryIDs:     categoryIDs,
+		FillingIDs:      fillingIDs,
+		ColorsHex:       colorsHex,
+		OnlyOpenForSale: in.OnlyOpenForSale,
+		OnlyDiscounted:  in.OnlyDiscounted,
+		Sort:            ConvertToCakeSortFromGrpc(in.Sort),
+
+		DeliveryAddressID: deliveryAddressID,
+	}
+
+	// Без поисковой строки релевантность не определена
+	if req.Sort == CakeSortRelevance && req.Query == "" {
+		req.Sort = CakeSortNewest
+	}
+
+	if req.Page, err = pagination.NewPage(in.PageSize, in.PageToken, req.CursorKey()); err != nil {
+		return SearchCakesReq{}, err
+	}
+
+	return req, nil
+}
+
+// CursorKey Контекст выдачи, для которого выдаётся курсор. Релевантность зависит от поисковой строки,
+// поэтому курсор релевантной выдачи привязан ещё и к хэшу запроса
+func (r SearchCakesReq) CursorKey() string {
+	if r.Sort != CakeSortRelevance {
+		return string(r.Sort)
+	}
+
+	sum := sha256.Sum256([]byte(r.Query))
+	return string(r.Sort) + ":" + base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// NearbyCakes
+
+// NewNearbyCakesReq Гео-поиск — это поиск по каталогу с сортировкой по расстоянию до продавца
+func NewNearbyCakesReq(in *gen.NearbyCakesReq) (SearchCakesReq, error) {
+	point := models.GeoPoint{
+		Latitude:  in.Latitude,
+		Longitude: in.Longitude,
+	}
+	if err := point.Validate(); err != nil {
+		return SearchCakesReq{}, err
+	}
+
+	req := SearchCakesReq{
+		Sort:     CakeSortDistance,
+		Near:     &point,
+		RadiusKm: null.FloatFromPtr(in.RadiusKm),
+	}
+
+	if req.RadiusKm.Valid && req.RadiusKm.Float64 <= 0 {
+		return SearchCakesReq{}, errs.ErrInvalidInput
+	}
+
+	// Без радиуса ищем продавцов, в зону доставки которых попадает точка
+	if !req.RadiusKm.Valid {
+		req.DeliverTo = &point
+	}
+
+	var err error
+	if req.Page, err = pagination.NewPage(in.PageSize, in.PageToken, req.CursorKey()); err != nil {
+		return SearchCakesReq{}, err
+	}
+
+	return req, nil
+}
+
+type SearchCakesRes struct {
+	Cakes         []PreviewCake
+	NextPageToken string
+}
+
+func parseUUIDs(ids []string) ([]uuid.UUID, error) {
+	res := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
+		}
+		res[i] = parsed
+	}
+
+	return res, nil
+}
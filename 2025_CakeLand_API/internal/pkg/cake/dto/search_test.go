@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSearchCakesReq_RelevanceToken(t *testing.T) {
+	first, err := NewSearchCakesReq(&gen.SearchCakesReq{Query: "шоколадный"})
+	require.NoError(t, err)
+	token := (&pagination.Cursor{Key: first.CursorKey(), Number: 0.5, ID: uuid.New()}).Encode()
+
+	t.Run("Same query", func(t *testing.T) {
+		req, err := NewSearchCakesReq(&gen.SearchCakesReq{Query: "шоколадный", PageToken: token})
+		require.NoError(t, err)
+		require.NotNil(t, req.Page.After)
+	})
+
+	t.Run("Another query", func(t *testing.T) {
+		_, err := NewSearchCakesReq(&gen.SearchCakesReq{Query: "ягодный", PageToken: token})
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("Another sort", func(t *testing.T) {
+		_, err := NewSearchCakesReq(&gen.SearchCakesReq{
+			Query:     "шоколадный",
+			Sort:      gen.CakeSort_CAKE_SORT_NEWEST,
+			PageToken: token,
+		})
+		require.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/pkg/cake/dto"
 	ms "2025_CakeLand_API/internal/pkg/minio"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
 	"context"
 	"github.com/google/uuid"
 )
@@ -12,38 +13,92 @@ type ICakeUsecase interface {
 	Cake(context.Context, dto.GetCakeReq) (*dto.GetCakeRes, error)
 	CreateCake(context.Context, dto.CreateCakeReq) (*dto.CreateCakeRes, error)
 	CreateFilling(context.Context, dto.CreateFillingReq) (*dto.CreateFillingRes, error)
+	UpdateFilling(context.Context, dto.UpdateFillingReq) (*models.Filling, error)
+	DeleteFilling(ctx context.Context, userID, fillingID uuid.UUID) error
 	CreateCategory(context.Context, *dto.CreateCategoryReq) (*dto.CreateCategoryRes, error)
-	Categories(context.Context) (*[]models.Category, error)
-	Fillings(context.Context) (*[]models.Filling, error)
+	UpdateCategory(context.Context, dto.UpdateCategoryReq) (*models.Category, error)
+	DeleteCategory(ctx context.Context, categoryID uuid.UUID) error
+	Categories(context.Context, pagination.Page) ([]models.Category, string, error)
+	Fillings(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) ([]models.Filling, string, error)
 	AddCakeColor(context.Context, uuid.UUID, []string) error
 	GetColors(context.Context) ([]string, error)
-	GetCakesPreview(context.Context) ([]dto.PreviewCake, error)
+	GetCakesPreview(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) (*dto.SearchCakesRes, error)
+	SearchCakes(context.Context, dto.SearchCakesReq) (*dto.SearchCakesRes, error)
 	CategoryIDsByGenderName(context.Context, models.CategoryGender) ([]models.Category, error)
-	CategoryPreviewCakes(context.Context, uuid.UUID) ([]*dto.PreviewCake, error)
+	CategoryPreviewCakes(ctx context.Context, viewerID uuid.NullUUID, categoryID uuid.UUID, page pagination.Page) (*dto.SearchCakesRes, error)
+	UpdateCake(context.Context, dto.UpdateCakeReq) (*dto.GetCakeRes, error)
+	SetCakeSaleStatus(ctx context.Context, userID, cakeID uuid.UUID, isOpenForSale bool) error
+	DeleteCake(ctx context.Context, userID, cakeID uuid.UUID) error
+	AddCakeImages(ctx context.Context, userID, cakeID uuid.UUID, images [][]byte) ([]models.CakeImage, error)
+	RemoveCakeImage(ctx context.Context, userID, cakeID, imageID uuid.UUID) ([]models.CakeImage, error)
+	ReorderCakeImages(ctx context.Context, userID, cakeID uuid.UUID, imageIDs []uuid.UUID) ([]models.CakeImage, error)
+	AddFavorite(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, targetID uuid.UUID) error
+	RemoveFavorite(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, targetID uuid.UUID) error
+	Favorites(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, page pagination.Page) (*dto.FavoritesRes, error)
+	CreatePromotion(context.Context, dto.CreatePromotionReq) (*models.Promotion, error)
+	CancelPromotion(ctx context.Context, userID, promotionID uuid.UUID) error
+	Promotions(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]models.Promotion, string, error)
+	CreatePromoCode(context.Context, dto.CreatePromoCodeReq) (*models.PromoCode, error)
+	CancelPromoCode(ctx context.Context, userID, promoCodeID uuid.UUID) error
+	PromoCodes(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]models.PromoCode, string, error)
+	CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error)
+	CreateCakeOption(ctx context.Context, userID, cakeID uuid.UUID, option models.CakeOption) (*models.CakeOption, error)
+	UpdateCakeOption(ctx context.Context, userID, optionID uuid.UUID, option models.CakeOption) (*models.CakeOption, error)
+	DeleteCakeOption(ctx context.Context, userID, optionID uuid.UUID) error
 }
 
 type ICakeRepository interface {
 	CakeByID(context.Context, dto.GetCakeReq) (*dto.GetCakeRes, error)
-	CakeCategoriesIDs(context.Context, uuid.UUID) ([]uuid.UUID, error)
-	CakeFillingsIDs(context.Context, uuid.UUID) ([]uuid.UUID, error)
-	FillingByID(context.Context, uuid.UUID) (*models.Filling, error)
-	CategoryByID(context.Context, uuid.UUID) (*models.Category, error)
 	CakeImages(context.Context, uuid.UUID) ([]models.CakeImage, error)
 
 	CreateCake(context.Context, dto.CreateCakeDBReq) error
 	CreateFilling(context.Context, models.Filling) error
 	CreateCategory(context.Context, *models.Category) error
 	GetColors(context.Context) ([]string, error)
-	AddCakeColor(context.Context, models.CakeColor) error
+	AddCakeColors(context.Context, uuid.UUID, []models.CakeColor) error
 
-	GetCakeColorsByCakeID(context.Context, uuid.UUID) ([]models.CakeColor, error)
-	GetUserByID(context.Context, uuid.UUID) (dto.Owner, error)
-	GetCakesPreview(context.Context) ([]dto.PreviewCake, error)
-	Categories(context.Context) (*[]models.Category, error)
-	Fillings(context.Context) (*[]models.Filling, error)
+	SearchCakes(context.Context, dto.SearchCakesReq) ([]dto.PreviewCake, *pagination.Cursor, error)
+	AddressLocation(ctx context.Context, addressID, userID uuid.UUID) (models.GeoPoint, error)
+	Categories(context.Context, pagination.Page) ([]models.Category, *pagination.Cursor, error)
+	Fillings(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) ([]models.Filling, *pagination.Cursor, error)
 	CategoryIDsByGenderName(context.Context, models.CategoryGender) ([]dto.DBCategory, error)
-	CategoryCakesIDs(context.Context, uuid.UUID) ([]uuid.UUID, error)
 	PreviewCakeByID(context.Context, uuid.UUID) (*dto.PreviewCake, error)
+
+	CategoryByID(context.Context, uuid.UUID) (*models.Category, error)
+	UpdateCategory(context.Context, dto.UpdateCategoryDBReq) (*models.Category, error)
+	DeleteCategory(context.Context, uuid.UUID) (string, error)
+	FillingByID(context.Context, uuid.UUID) (*models.Filling, error)
+	UpdateFilling(context.Context, dto.UpdateFillingDBReq) (*models.Filling, error)
+	DeleteFilling(context.Context, uuid.UUID) error
+	CountUsableFillings(ctx context.Context, userID uuid.UUID, fillingIDs []uuid.UUID) (int, error)
+
+	CakeOwnerID(context.Context, uuid.UUID) (uuid.UUID, error)
+	UpdateCake(context.Context, dto.UpdateCakeDBReq) error
+	SetCakeSaleStatus(ctx context.Context, cakeID uuid.UUID, isOpenForSale bool) error
+	DeleteCake(context.Context, uuid.UUID) error
+	AddCakeImages(ctx context.Context, cakeID uuid.UUID, images []models.CakeImage) error
+	DeleteCakeImage(ctx context.Context, cakeID, imageID uuid.UUID) (*models.CakeImage, error)
+	ReorderCakeImages(ctx context.Context, cakeID uuid.UUID, imageIDs []uuid.UUID) error
+
+	AddFavoriteCake(ctx context.Context, userID, cakeID uuid.UUID) error
+	RemoveFavoriteCake(ctx context.Context, userID, cakeID uuid.UUID) error
+	AddFavoriteSeller(ctx context.Context, userID, sellerID uuid.UUID) error
+	RemoveFavoriteSeller(ctx context.Context, userID, sellerID uuid.UUID) error
+	FavoriteSellers(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]uuid.UUID, *pagination.Cursor, error)
+
+	CreatePromotion(context.Context, *models.Promotion) error
+	CancelPromotion(ctx context.Context, sellerID, promotionID uuid.UUID) error
+	RefreshEffectivePrices(context.Context) error
+	Promotions(ctx context.Context, sellerID uuid.UUID, page pagination.Page) ([]models.Promotion, *pagination.Cursor, error)
+	CreatePromoCode(context.Context, *models.PromoCode) error
+	CancelPromoCode(ctx context.Context, sellerID, promoCodeID uuid.UUID) error
+	PromoCodes(ctx context.Context, sellerID uuid.UUID, page pagination.Page) ([]models.PromoCode, *pagination.Cursor, error)
+
+	CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error)
+	CakeOptionByID(ctx context.Context, optionID uuid.UUID) (*models.CakeOption, error)
+	CreateCakeOption(context.Context, *models.CakeOption) error
+	UpdateCakeOption(context.Context, *models.CakeOption) error
+	DeleteCakeOption(ctx context.Context, optionID uuid.UUID) error
 }
 
 type IImageStorage interface {
@@ -58,4 +113,9 @@ type IImageStorage interface {
 		objectName ms.ImageID,
 		imageData []byte,
 	) (string, error)
+	DeleteImages(
+		ctx context.Context,
+		bucketName string,
+		objectNames []ms.ImageID,
+	) error
 }
@@ -0,0 +1,1293 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/cake/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "2025_CakeLand_API/internal/models"
+	dto "2025_CakeLand_API/internal/pkg/cake/dto"
+	minio "2025_CakeLand_API/internal/pkg/minio"
+	pagination "2025_CakeLand_API/internal/pkg/utils/pagination"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockICakeUsecase is a mock of ICakeUsecase interface.
+type MockICakeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICakeUsecaseMockRecorder
+}
+
+// MockICakeUsecaseMockRecorder is the mock recorder for MockICakeUsecase.
+type MockICakeUsecaseMockRecorder struct {
+	mock *MockICakeUsecase
+}
+
+// NewMockICakeUsecase creates a new mock instance.
+func NewMockICakeUsecase(ctrl *gomock.Controller) *MockICakeUsecase {
+	mock := &MockICakeUsecase{ctrl: ctrl}
+	mock.recorder = &MockICakeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICakeUsecase) EXPECT() *MockICakeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddCakeColor mocks base method.
+func (m *MockICakeUsecase) AddCakeColor(arg0 context.Context, arg1 uuid.UUID, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCakeColor", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCakeColor indicates an expected call of AddCakeColor.
+func (mr *MockICakeUsecaseMockRecorder) AddCakeColor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCakeColor", reflect.TypeOf((*MockICakeUsecase)(nil).AddCakeColor), arg0, arg1, arg2)
+}
+
+// AddCakeImages mocks base method.
+func (m *MockICakeUsecase) AddCakeImages(ctx context.Context, userID, cakeID uuid.UUID, images [][]byte) ([]models.CakeImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCakeImages", ctx, userID, cakeID, images)
+	ret0, _ := ret[0].([]models.CakeImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCakeImages indicates an expected call of AddCakeImages.
+func (mr *MockICakeUsecaseMockRecorder) AddCakeImages(ctx, userID, cakeID, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCakeImages", reflect.TypeOf((*MockICakeUsecase)(nil).AddCakeImages), ctx, userID, cakeID, images)
+}
+
+// AddFavorite mocks base method.
+func (m *MockICakeUsecase) AddFavorite(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, targetID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavorite", ctx, userID, target, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavorite indicates an expected call of AddFavorite.
+func (mr *MockICakeUsecaseMockRecorder) AddFavorite(ctx, userID, target, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavorite", reflect.TypeOf((*MockICakeUsecase)(nil).AddFavorite), ctx, userID, target, targetID)
+}
+
+// Cake mocks base method.
+func (m *MockICakeUsecase) Cake(arg0 context.Context, arg1 dto.GetCakeReq) (*dto.GetCakeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cake", arg0, arg1)
+	ret0, _ := ret[0].(*dto.GetCakeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cake indicates an expected call of Cake.
+func (mr *MockICakeUsecaseMockRecorder) Cake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cake", reflect.TypeOf((*MockICakeUsecase)(nil).Cake), arg0, arg1)
+}
+
+// CakeOptions mocks base method.
+func (m *MockICakeUsecase) CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeOptions", ctx, cakeID)
+	ret0, _ := ret[0].([]models.CakeOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeOptions indicates an expected call of CakeOptions.
+func (mr *MockICakeUsecaseMockRecorder) CakeOptions(ctx, cakeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeOptions", reflect.TypeOf((*MockICakeUsecase)(nil).CakeOptions), ctx, cakeID)
+}
+
+// CancelPromoCode mocks base method.
+func (m *MockICakeUsecase) CancelPromoCode(ctx context.Context, userID, promoCodeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPromoCode", ctx, userID, promoCodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPromoCode indicates an expected call of CancelPromoCode.
+func (mr *MockICakeUsecaseMockRecorder) CancelPromoCode(ctx, userID, promoCodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPromoCode", reflect.TypeOf((*MockICakeUsecase)(nil).CancelPromoCode), ctx, userID, promoCodeID)
+}
+
+// CancelPromotion mocks base method.
+func (m *MockICakeUsecase) CancelPromotion(ctx context.Context, userID, promotionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPromotion", ctx, userID, promotionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPromotion indicates an expected call of CancelPromotion.
+func (mr *MockICakeUsecaseMockRecorder) CancelPromotion(ctx, userID, promotionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPromotion", reflect.TypeOf((*MockICakeUsecase)(nil).CancelPromotion), ctx, userID, promotionID)
+}
+
+// Categories mocks base method.
+func (m *MockICakeUsecase) Categories(arg0 context.Context, arg1 pagination.Page) ([]models.Category, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Categories", arg0, arg1)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Categories indicates an expected call of Categories.
+func (mr *MockICakeUsecaseMockRecorder) Categories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockICakeUsecase)(nil).Categories), arg0, arg1)
+}
+
+// CategoryIDsByGenderName mocks base method.
+func (m *MockICakeUsecase) CategoryIDsByGenderName(arg0 context.Context, arg1 models.CategoryGender) ([]models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryIDsByGenderName", arg0, arg1)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CategoryIDsByGenderName indicates an expected call of CategoryIDsByGenderName.
+func (mr *MockICakeUsecaseMockRecorder) CategoryIDsByGenderName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryIDsByGenderName", reflect.TypeOf((*MockICakeUsecase)(nil).CategoryIDsByGenderName), arg0, arg1)
+}
+
+// CategoryPreviewCakes mocks base method.
+func (m *MockICakeUsecase) CategoryPreviewCakes(ctx context.Context, viewerID uuid.NullUUID, categoryID uuid.UUID, page pagination.Page) (*dto.SearchCakesRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryPreviewCakes", ctx, viewerID, categoryID, page)
+	ret0, _ := ret[0].(*dto.SearchCakesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CategoryPreviewCakes indicates an expected call of CategoryPreviewCakes.
+func (mr *MockICakeUsecaseMockRecorder) CategoryPreviewCakes(ctx, viewerID, categoryID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryPreviewCakes", reflect.TypeOf((*MockICakeUsecase)(nil).CategoryPreviewCakes), ctx, viewerID, categoryID, page)
+}
+
+// CreateCake mocks base method.
+func (m *MockICakeUsecase) CreateCake(arg0 context.Context, arg1 dto.CreateCakeReq) (*dto.CreateCakeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCake", arg0, arg1)
+	ret0, _ := ret[0].(*dto.CreateCakeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCake indicates an expected call of CreateCake.
+func (mr *MockICakeUsecaseMockRecorder) CreateCake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCake", reflect.TypeOf((*MockICakeUsecase)(nil).CreateCake), arg0, arg1)
+}
+
+// CreateCakeOption mocks base method.
+func (m *MockICakeUsecase) CreateCakeOption(ctx context.Context, userID, cakeID uuid.UUID, option models.CakeOption) (*models.CakeOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCakeOption", ctx, userID, cakeID, option)
+	ret0, _ := ret[0].(*models.CakeOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCakeOption indicates an expected call of CreateCakeOption.
+func (mr *MockICakeUsecaseMockRecorder) CreateCakeOption(ctx, userID, cakeID, option interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCakeOption", reflect.TypeOf((*MockICakeUsecase)(nil).CreateCakeOption), ctx, userID, cakeID, option)
+}
+
+// CreateCategory mocks base method.
+func (m *MockICakeUsecase) CreateCategory(arg0 context.Context, arg1 *dto.CreateCategoryReq) (*dto.CreateCategoryRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*dto.CreateCategoryRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockICakeUsecaseMockRecorder) CreateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICakeUsecase)(nil).CreateCategory), arg0, arg1)
+}
+
+// CreateFilling mocks base method.
+func (m *MockICakeUsecase) CreateFilling(arg0 context.Context, arg1 dto.CreateFillingReq) (*dto.CreateFillingRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFilling", arg0, arg1)
+	ret0, _ := ret[0].(*dto.CreateFillingRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFilling indicates an expected call of CreateFilling.
+func (mr *MockICakeUsecaseMockRecorder) CreateFilling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilling", reflect.TypeOf((*MockICakeUsecase)(nil).CreateFilling), arg0, arg1)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockICakeUsecase) CreatePromoCode(arg0 context.Context, arg1 dto.CreatePromoCodeReq) (*models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", arg0, arg1)
+	ret0, _ := ret[0].(*models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockICakeUsecaseMockRecorder) CreatePromoCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockICakeUsecase)(nil).CreatePromoCode), arg0, arg1)
+}
+
+// CreatePromotion mocks base method.
+func (m *MockICakeUsecase) CreatePromotion(arg0 context.Context, arg1 dto.CreatePromotionReq) (*models.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", arg0, arg1)
+	ret0, _ := ret[0].(*models.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockICakeUsecaseMockRecorder) CreatePromotion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockICakeUsecase)(nil).CreatePromotion), arg0, arg1)
+}
+
+// DeleteCake mocks base method.
+func (m *MockICakeUsecase) DeleteCake(ctx context.Context, userID, cakeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCake", ctx, userID, cakeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCake indicates an expected call of DeleteCake.
+func (mr *MockICakeUsecaseMockRecorder) DeleteCake(ctx, userID, cakeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCake", reflect.TypeOf((*MockICakeUsecase)(nil).DeleteCake), ctx, userID, cakeID)
+}
+
+// DeleteCakeOption mocks base method.
+func (m *MockICakeUsecase) DeleteCakeOption(ctx context.Context, userID, optionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCakeOption", ctx, userID, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCakeOption indicates an expected call of DeleteCakeOption.
+func (mr *MockICakeUsecaseMockRecorder) DeleteCakeOption(ctx, userID, optionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCakeOption", reflect.TypeOf((*MockICakeUsecase)(nil).DeleteCakeOption), ctx, userID, optionID)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICakeUsecase) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICakeUsecaseMockRecorder) DeleteCategory(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICakeUsecase)(nil).DeleteCategory), ctx, categoryID)
+}
+
+// DeleteFilling mocks base method.
+func (m *MockICakeUsecase) DeleteFilling(ctx context.Context, userID, fillingID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFilling", ctx, userID, fillingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFilling indicates an expected call of DeleteFilling.
+func (mr *MockICakeUsecaseMockRecorder) DeleteFilling(ctx, userID, fillingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFilling", reflect.TypeOf((*MockICakeUsecase)(nil).DeleteFilling), ctx, userID, fillingID)
+}
+
+// Favorites mocks base method.
+func (m *MockICakeUsecase) Favorites(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, page pagination.Page) (*dto.FavoritesRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Favorites", ctx, userID, target, page)
+	ret0, _ := ret[0].(*dto.FavoritesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Favorites indicates an expected call of Favorites.
+func (mr *MockICakeUsecaseMockRecorder) Favorites(ctx, userID, target, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Favorites", reflect.TypeOf((*MockICakeUsecase)(nil).Favorites), ctx, userID, target, page)
+}
+
+// Fillings mocks base method.
+func (m *MockICakeUsecase) Fillings(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) ([]models.Filling, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fillings", ctx, viewerID, page)
+	ret0, _ := ret[0].([]models.Filling)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Fillings indicates an expected call of Fillings.
+func (mr *MockICakeUsecaseMockRecorder) Fillings(ctx, viewerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fillings", reflect.TypeOf((*MockICakeUsecase)(nil).Fillings), ctx, viewerID, page)
+}
+
+// GetCakesPreview mocks base method.
+func (m *MockICakeUsecase) GetCakesPreview(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) (*dto.SearchCakesRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCakesPreview", ctx, viewerID, page)
+	ret0, _ := ret[0].(*dto.SearchCakesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCakesPreview indicates an expected call of GetCakesPreview.
+func (mr *MockICakeUsecaseMockRecorder) GetCakesPreview(ctx, viewerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCakesPreview", reflect.TypeOf((*MockICakeUsecase)(nil).GetCakesPreview), ctx, viewerID, page)
+}
+
+// GetColors mocks base method.
+func (m *MockICakeUsecase) GetColors(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColors", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColors indicates an expected call of GetColors.
+func (mr *MockICakeUsecaseMockRecorder) GetColors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColors", reflect.TypeOf((*MockICakeUsecase)(nil).GetColors), arg0)
+}
+
+// PromoCodes mocks base method.
+func (m *MockICakeUsecase) PromoCodes(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]models.PromoCode, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoCodes", ctx, userID, page)
+	ret0, _ := ret[0].([]models.PromoCode)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PromoCodes indicates an expected call of PromoCodes.
+func (mr *MockICakeUsecaseMockRecorder) PromoCodes(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoCodes", reflect.TypeOf((*MockICakeUsecase)(nil).PromoCodes), ctx, userID, page)
+}
+
+// Promotions mocks base method.
+func (m *MockICakeUsecase) Promotions(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]models.Promotion, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promotions", ctx, userID, page)
+	ret0, _ := ret[0].([]models.Promotion)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Promotions indicates an expected call of Promotions.
+func (mr *MockICakeUsecaseMockRecorder) Promotions(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promotions", reflect.TypeOf((*MockICakeUsecase)(nil).Promotions), ctx, userID, page)
+}
+
+// RemoveCakeImage mocks base method.
+func (m *MockICakeUsecase) RemoveCakeImage(ctx context.Context, userID, cakeID, imageID uuid.UUID) ([]models.CakeImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCakeImage", ctx, userID, cakeID, imageID)
+	ret0, _ := ret[0].([]models.CakeImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCakeImage indicates an expected call of RemoveCakeImage.
+func (mr *MockICakeUsecaseMockRecorder) RemoveCakeImage(ctx, userID, cakeID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCakeImage", reflect.TypeOf((*MockICakeUsecase)(nil).RemoveCakeImage), ctx, userID, cakeID, imageID)
+}
+
+// RemoveFavorite mocks base method.
+func (m *MockICakeUsecase) RemoveFavorite(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, targetID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavorite", ctx, userID, target, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavorite indicates an expected call of RemoveFavorite.
+func (mr *MockICakeUsecaseMockRecorder) RemoveFavorite(ctx, userID, target, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavorite", reflect.TypeOf((*MockICakeUsecase)(nil).RemoveFavorite), ctx, userID, target, targetID)
+}
+
+// ReorderCakeImages mocks base method.
+func (m *MockICakeUsecase) ReorderCakeImages(ctx context.Context, userID, cakeID uuid.UUID, imageIDs []uuid.UUID) ([]models.CakeImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCakeImages", ctx, userID, cakeID, imageIDs)
+	ret0, _ := ret[0].([]models.CakeImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderCakeImages indicates an expected call of ReorderCakeImages.
+func (mr *MockICakeUsecaseMockRecorder) ReorderCakeImages(ctx, userID, cakeID, imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCakeImages", reflect.TypeOf((*MockICakeUsecase)(nil).ReorderCakeImages), ctx, userID, cakeID, imageIDs)
+}
+
+// SearchCakes mocks base method.
+func (m *MockICakeUsecase) SearchCakes(arg0 context.Context, arg1 dto.SearchCakesReq) (*dto.SearchCakesRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCakes", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SearchCakesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCakes indicates an expected call of SearchCakes.
+func (mr *MockICakeUsecaseMockRecorder) SearchCakes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCakes", reflect.TypeOf((*MockICakeUsecase)(nil).SearchCakes), arg0, arg1)
+}
+
+// SetCakeSaleStatus mocks base method.
+func (m *MockICakeUsecase) SetCakeSaleStatus(ctx context.Context, userID, cakeID uuid.UUID, isOpenForSale bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCakeSaleStatus", ctx, userID, cakeID, isOpenForSale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCakeSaleStatus indicates an expected call of SetCakeSaleStatus.
+func (mr *MockICakeUsecaseMockRecorder) SetCakeSaleStatus(ctx, userID, cakeID, isOpenForSale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCakeSaleStatus", reflect.TypeOf((*MockICakeUsecase)(nil).SetCakeSaleStatus), ctx, userID, cakeID, isOpenForSale)
+}
+
+// UpdateCake mocks base method.
+func (m *MockICakeUsecase) UpdateCake(arg0 context.Context, arg1 dto.UpdateCakeReq) (*dto.GetCakeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCake", arg0, arg1)
+	ret0, _ := ret[0].(*dto.GetCakeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCake indicates an expected call of UpdateCake.
+func (mr *MockICakeUsecaseMockRecorder) UpdateCake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCake", reflect.TypeOf((*MockICakeUsecase)(nil).UpdateCake), arg0, arg1)
+}
+
+// UpdateCakeOption mocks base method.
+func (m *MockICakeUsecase) UpdateCakeOption(ctx context.Context, userID, optionID uuid.UUID, option models.CakeOption) (*models.CakeOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCakeOption", ctx, userID, optionID, option)
+	ret0, _ := ret[0].(*models.CakeOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCakeOption indicates an expected call of UpdateCakeOption.
+func (mr *MockICakeUsecaseMockRecorder) UpdateCakeOption(ctx, userID, optionID, option interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCakeOption", reflect.TypeOf((*MockICakeUsecase)(nil).UpdateCakeOption), ctx, userID, optionID, option)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICakeUsecase) UpdateCategory(arg0 context.Context, arg1 dto.UpdateCategoryReq) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICakeUsecaseMockRecorder) UpdateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICakeUsecase)(nil).UpdateCategory), arg0, arg1)
+}
+
+// UpdateFilling mocks base method.
+func (m *MockICakeUsecase) UpdateFilling(arg0 context.Context, arg1 dto.UpdateFillingReq) (*models.Filling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFilling", arg0, arg1)
+	ret0, _ := ret[0].(*models.Filling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFilling indicates an expected call of UpdateFilling.
+func (mr *MockICakeUsecaseMockRecorder) UpdateFilling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFilling", reflect.TypeOf((*MockICakeUsecase)(nil).UpdateFilling), arg0, arg1)
+}
+
+// MockICakeRepository is a mock of ICakeRepository interface.
+type MockICakeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICakeRepositoryMockRecorder
+}
+
+// MockICakeRepositoryMockRecorder is the mock recorder for MockICakeRepository.
+type MockICakeRepositoryMockRecorder struct {
+	mock *MockICakeRepository
+}
+
+// NewMockICakeRepository creates a new mock instance.
+func NewMockICakeRepository(ctrl *gomock.Controller) *MockICakeRepository {
+	mock := &MockICakeRepository{ctrl: ctrl}
+	mock.recorder = &MockICakeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICakeRepository) EXPECT() *MockICakeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCakeColors mocks base method.
+func (m *MockICakeRepository) AddCakeColors(arg0 context.Context, arg1 uuid.UUID, arg2 []models.CakeColor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCakeColors", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCakeColors indicates an expected call of AddCakeColors.
+func (mr *MockICakeRepositoryMockRecorder) AddCakeColors(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCakeColors", reflect.TypeOf((*MockICakeRepository)(nil).AddCakeColors), arg0, arg1, arg2)
+}
+
+// AddCakeImages mocks base method.
+func (m *MockICakeRepository) AddCakeImages(ctx context.Context, cakeID uuid.UUID, images []models.CakeImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCakeImages", ctx, cakeID, images)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCakeImages indicates an expected call of AddCakeImages.
+func (mr *MockICakeRepositoryMockRecorder) AddCakeImages(ctx, cakeID, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCakeImages", reflect.TypeOf((*MockICakeRepository)(nil).AddCakeImages), ctx, cakeID, images)
+}
+
+// AddFavoriteCake mocks base method.
+func (m *MockICakeRepository) AddFavoriteCake(ctx context.Context, userID, cakeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavoriteCake", ctx, userID, cakeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavoriteCake indicates an expected call of AddFavoriteCake.
+func (mr *MockICakeRepositoryMockRecorder) AddFavoriteCake(ctx, userID, cakeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteCake", reflect.TypeOf((*MockICakeRepository)(nil).AddFavoriteCake), ctx, userID, cakeID)
+}
+
+// AddFavoriteSeller mocks base method.
+func (m *MockICakeRepository) AddFavoriteSeller(ctx context.Context, userID, sellerID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavoriteSeller", ctx, userID, sellerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavoriteSeller indicates an expected call of AddFavoriteSeller.
+func (mr *MockICakeRepositoryMockRecorder) AddFavoriteSeller(ctx, userID, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteSeller", reflect.TypeOf((*MockICakeRepository)(nil).AddFavoriteSeller), ctx, userID, sellerID)
+}
+
+// AddressLocation mocks base method.
+func (m *MockICakeRepository) AddressLocation(ctx context.Context, addressID, userID uuid.UUID) (models.GeoPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressLocation", ctx, addressID, userID)
+	ret0, _ := ret[0].(models.GeoPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddressLocation indicates an expected call of AddressLocation.
+func (mr *MockICakeRepositoryMockRecorder) AddressLocation(ctx, addressID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressLocation", reflect.TypeOf((*MockICakeRepository)(nil).AddressLocation), ctx, addressID, userID)
+}
+
+// CakeByID mocks base method.
+func (m *MockICakeRepository) CakeByID(arg0 context.Context, arg1 dto.GetCakeReq) (*dto.GetCakeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeByID", arg0, arg1)
+	ret0, _ := ret[0].(*dto.GetCakeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeByID indicates an expected call of CakeByID.
+func (mr *MockICakeRepositoryMockRecorder) CakeByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeByID", reflect.TypeOf((*MockICakeRepository)(nil).CakeByID), arg0, arg1)
+}
+
+// CakeImages mocks base method.
+func (m *MockICakeRepository) CakeImages(arg0 context.Context, arg1 uuid.UUID) ([]models.CakeImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeImages", arg0, arg1)
+	ret0, _ := ret[0].([]models.CakeImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeImages indicates an expected call of CakeImages.
+func (mr *MockICakeRepositoryMockRecorder) CakeImages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeImages", reflect.TypeOf((*MockICakeRepository)(nil).CakeImages), arg0, arg1)
+}
+
+// CakeOptionByID mocks base method.
+func (m *MockICakeRepository) CakeOptionByID(ctx context.Context, optionID uuid.UUID) (*models.CakeOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeOptionByID", ctx, optionID)
+	ret0, _ := ret[0].(*models.CakeOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeOptionByID indicates an expected call of CakeOptionByID.
+func (mr *MockICakeRepositoryMockRecorder) CakeOptionByID(ctx, optionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeOptionByID", reflect.TypeOf((*MockICakeRepository)(nil).CakeOptionByID), ctx, optionID)
+}
+
+// CakeOptions mocks base method.
+func (m *MockICakeRepository) CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeOptions", ctx, cakeID)
+	ret0, _ := ret[0].([]models.CakeOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeOptions indicates an expected call of CakeOptions.
+func (mr *MockICakeRepositoryMockRecorder) CakeOptions(ctx, cakeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeOptions", reflect.TypeOf((*MockICakeRepository)(nil).CakeOptions), ctx, cakeID)
+}
+
+// CakeOwnerID mocks base method.
+func (m *MockICakeRepository) CakeOwnerID(arg0 context.Context, arg1 uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeOwnerID", arg0, arg1)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeOwnerID indicates an expected call of CakeOwnerID.
+func (mr *MockICakeRepositoryMockRecorder) CakeOwnerID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeOwnerID", reflect.TypeOf((*MockICakeRepository)(nil).CakeOwnerID), arg0, arg1)
+}
+
+// CancelPromoCode mocks base method.
+func (m *MockICakeRepository) CancelPromoCode(ctx context.Context, sellerID, promoCodeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPromoCode", ctx, sellerID, promoCodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPromoCode indicates an expected call of CancelPromoCode.
+func (mr *MockICakeRepositoryMockRecorder) CancelPromoCode(ctx, sellerID, promoCodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPromoCode", reflect.TypeOf((*MockICakeRepository)(nil).CancelPromoCode), ctx, sellerID, promoCodeID)
+}
+
+// CancelPromotion mocks base method.
+func (m *MockICakeRepository) CancelPromotion(ctx context.Context, sellerID, promotionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPromotion", ctx, sellerID, promotionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPromotion indicates an expected call of CancelPromotion.
+func (mr *MockICakeRepositoryMockRecorder) CancelPromotion(ctx, sellerID, promotionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPromotion", reflect.TypeOf((*MockICakeRepository)(nil).CancelPromotion), ctx, sellerID, promotionID)
+}
+
+// Categories mocks base method.
+func (m *MockICakeRepository) Categories(arg0 context.Context, arg1 pagination.Page) ([]models.Category, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Categories", arg0, arg1)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Categories indicates an expected call of Categories.
+func (mr *MockICakeRepositoryMockRecorder) Categories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockICakeRepository)(nil).Categories), arg0, arg1)
+}
+
+// CategoryByID mocks base method.
+func (m *MockICakeRepository) CategoryByID(arg0 context.Context, arg1 uuid.UUID) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CategoryByID indicates an expected call of CategoryByID.
+func (mr *MockICakeRepositoryMockRecorder) CategoryByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryByID", reflect.TypeOf((*MockICakeRepository)(nil).CategoryByID), arg0, arg1)
+}
+
+// CategoryIDsByGenderName mocks base method.
+func (m *MockICakeRepository) CategoryIDsByGenderName(arg0 context.Context, arg1 models.CategoryGender) ([]dto.DBCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryIDsByGenderName", arg0, arg1)
+	ret0, _ := ret[0].([]dto.DBCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CategoryIDsByGenderName indicates an expected call of CategoryIDsByGenderName.
+func (mr *MockICakeRepositoryMockRecorder) CategoryIDsByGenderName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryIDsByGenderName", reflect.TypeOf((*MockICakeRepository)(nil).CategoryIDsByGenderName), arg0, arg1)
+}
+
+// CountUsableFillings mocks base method.
+func (m *MockICakeRepository) CountUsableFillings(ctx context.Context, userID uuid.UUID, fillingIDs []uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsableFillings", ctx, userID, fillingIDs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsableFillings indicates an expected call of CountUsableFillings.
+func (mr *MockICakeRepositoryMockRecorder) CountUsableFillings(ctx, userID, fillingIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsableFillings", reflect.TypeOf((*MockICakeRepository)(nil).CountUsableFillings), ctx, userID, fillingIDs)
+}
+
+// CreateCake mocks base method.
+func (m *MockICakeRepository) CreateCake(arg0 context.Context, arg1 dto.CreateCakeDBReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCake", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCake indicates an expected call of CreateCake.
+func (mr *MockICakeRepositoryMockRecorder) CreateCake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCake", reflect.TypeOf((*MockICakeRepository)(nil).CreateCake), arg0, arg1)
+}
+
+// CreateCakeOption mocks base method.
+func (m *MockICakeRepository) CreateCakeOption(arg0 context.Context, arg1 *models.CakeOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCakeOption", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCakeOption indicates an expected call of CreateCakeOption.
+func (mr *MockICakeRepositoryMockRecorder) CreateCakeOption(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCakeOption", reflect.TypeOf((*MockICakeRepository)(nil).CreateCakeOption), arg0, arg1)
+}
+
+// CreateCategory mocks base method.
+func (m *MockICakeRepository) CreateCategory(arg0 context.Context, arg1 *models.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockICakeRepositoryMockRecorder) CreateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICakeRepository)(nil).CreateCategory), arg0, arg1)
+}
+
+// CreateFilling mocks base method.
+func (m *MockICakeRepository) CreateFilling(arg0 context.Context, arg1 models.Filling) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFilling", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFilling indicates an expected call of CreateFilling.
+func (mr *MockICakeRepositoryMockRecorder) CreateFilling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilling", reflect.TypeOf((*MockICakeRepository)(nil).CreateFilling), arg0, arg1)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockICakeRepository) CreatePromoCode(arg0 context.Context, arg1 *models.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockICakeRepositoryMockRecorder) CreatePromoCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockICakeRepository)(nil).CreatePromoCode), arg0, arg1)
+}
+
+// CreatePromotion mocks base method.
+func (m *MockICakeRepository) CreatePromotion(arg0 context.Context, arg1 *models.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockICakeRepositoryMockRecorder) CreatePromotion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockICakeRepository)(nil).CreatePromotion), arg0, arg1)
+}
+
+// DeleteCake mocks base method.
+func (m *MockICakeRepository) DeleteCake(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCake", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCake indicates an expected call of DeleteCake.
+func (mr *MockICakeRepositoryMockRecorder) DeleteCake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCake", reflect.TypeOf((*MockICakeRepository)(nil).DeleteCake), arg0, arg1)
+}
+
+// DeleteCakeImage mocks base method.
+func (m *MockICakeRepository) DeleteCakeImage(ctx context.Context, cakeID, imageID uuid.UUID) (*models.CakeImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCakeImage", ctx, cakeID, imageID)
+	ret0, _ := ret[0].(*models.CakeImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCakeImage indicates an expected call of DeleteCakeImage.
+func (mr *MockICakeRepositoryMockRecorder) DeleteCakeImage(ctx, cakeID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCakeImage", reflect.TypeOf((*MockICakeRepository)(nil).DeleteCakeImage), ctx, cakeID, imageID)
+}
+
+// DeleteCakeOption mocks base method.
+func (m *MockICakeRepository) DeleteCakeOption(ctx context.Context, optionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCakeOption", ctx, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCakeOption indicates an expected call of DeleteCakeOption.
+func (mr *MockICakeRepositoryMockRecorder) DeleteCakeOption(ctx, optionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCakeOption", reflect.TypeOf((*MockICakeRepository)(nil).DeleteCakeOption), ctx, optionID)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICakeRepository) DeleteCategory(arg0 context.Context, arg1 uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICakeRepositoryMockRecorder) DeleteCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICakeRepository)(nil).DeleteCategory), arg0, arg1)
+}
+
+// DeleteFilling mocks base method.
+func (m *MockICakeRepository) DeleteFilling(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFilling", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFilling indicates an expected call of DeleteFilling.
+func (mr *MockICakeRepositoryMockRecorder) DeleteFilling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFilling", reflect.TypeOf((*MockICakeRepository)(nil).DeleteFilling), arg0, arg1)
+}
+
+// FavoriteSellers mocks base method.
+func (m *MockICakeRepository) FavoriteSellers(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]uuid.UUID, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FavoriteSellers", ctx, userID, page)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FavoriteSellers indicates an expected call of FavoriteSellers.
+func (mr *MockICakeRepositoryMockRecorder) FavoriteSellers(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteSellers", reflect.TypeOf((*MockICakeRepository)(nil).FavoriteSellers), ctx, userID, page)
+}
+
+// FillingByID mocks base method.
+func (m *MockICakeRepository) FillingByID(arg0 context.Context, arg1 uuid.UUID) (*models.Filling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillingByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Filling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillingByID indicates an expected call of FillingByID.
+func (mr *MockICakeRepositoryMockRecorder) FillingByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillingByID", reflect.TypeOf((*MockICakeRepository)(nil).FillingByID), arg0, arg1)
+}
+
+// Fillings mocks base method.
+func (m *MockICakeRepository) Fillings(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) ([]models.Filling, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fillings", ctx, viewerID, page)
+	ret0, _ := ret[0].([]models.Filling)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Fillings indicates an expected call of Fillings.
+func (mr *MockICakeRepositoryMockRecorder) Fillings(ctx, viewerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fillings", reflect.TypeOf((*MockICakeRepository)(nil).Fillings), ctx, viewerID, page)
+}
+
+// GetColors mocks base method.
+func (m *MockICakeRepository) GetColors(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColors", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColors indicates an expected call of GetColors.
+func (mr *MockICakeRepositoryMockRecorder) GetColors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColors", reflect.TypeOf((*MockICakeRepository)(nil).GetColors), arg0)
+}
+
+// PreviewCakeByID mocks base method.
+func (m *MockICakeRepository) PreviewCakeByID(arg0 context.Context, arg1 uuid.UUID) (*dto.PreviewCake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewCakeByID", arg0, arg1)
+	ret0, _ := ret[0].(*dto.PreviewCake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewCakeByID indicates an expected call of PreviewCakeByID.
+func (mr *MockICakeRepositoryMockRecorder) PreviewCakeByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewCakeByID", reflect.TypeOf((*MockICakeRepository)(nil).PreviewCakeByID), arg0, arg1)
+}
+
+// PromoCodes mocks base method.
+func (m *MockICakeRepository) PromoCodes(ctx context.Context, sellerID uuid.UUID, page pagination.Page) ([]models.PromoCode, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoCodes", ctx, sellerID, page)
+	ret0, _ := ret[0].([]models.PromoCode)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PromoCodes indicates an expected call of PromoCodes.
+func (mr *MockICakeRepositoryMockRecorder) PromoCodes(ctx, sellerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoCodes", reflect.TypeOf((*MockICakeRepository)(nil).PromoCodes), ctx, sellerID, page)
+}
+
+// Promotions mocks base method.
+func (m *MockICakeRepository) Promotions(ctx context.Context, sellerID uuid.UUID, page pagination.Page) ([]models.Promotion, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promotions", ctx, sellerID, page)
+	ret0, _ := ret[0].([]models.Promotion)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Promotions indicates an expected call of Promotions.
+func (mr *MockICakeRepositoryMockRecorder) Promotions(ctx, sellerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promotions", reflect.TypeOf((*MockICakeRepository)(nil).Promotions), ctx, sellerID, page)
+}
+
+// RefreshEffectivePrices mocks base method.
+func (m *MockICakeRepository) RefreshEffectivePrices(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshEffectivePrices", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshEffectivePrices indicates an expected call of RefreshEffectivePrices.
+func (mr *MockICakeRepositoryMockRecorder) RefreshEffectivePrices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshEffectivePrices", reflect.TypeOf((*MockICakeRepository)(nil).RefreshEffectivePrices), arg0)
+}
+
+// RemoveFavoriteCake mocks base method.
+func (m *MockICakeRepository) RemoveFavoriteCake(ctx context.Context, userID, cakeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavoriteCake", ctx, userID, cakeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavoriteCake indicates an expected call of RemoveFavoriteCake.
+func (mr *MockICakeRepositoryMockRecorder) RemoveFavoriteCake(ctx, userID, cakeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavoriteCake", reflect.TypeOf((*MockICakeRepository)(nil).RemoveFavoriteCake), ctx, userID, cakeID)
+}
+
+// RemoveFavoriteSeller mocks base method.
+func (m *MockICakeRepository) RemoveFavoriteSeller(ctx context.Context, userID, sellerID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavoriteSeller", ctx, userID, sellerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavoriteSeller indicates an expected call of RemoveFavoriteSeller.
+func (mr *MockICakeRepositoryMockRecorder) RemoveFavoriteSeller(ctx, userID, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavoriteSeller", reflect.TypeOf((*MockICakeRepository)(nil).RemoveFavoriteSeller), ctx, userID, sellerID)
+}
+
+// ReorderCakeImages mocks base method.
+func (m *MockICakeRepository) ReorderCakeImages(ctx context.Context, cakeID uuid.UUID, imageIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCakeImages", ctx, cakeID, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCakeImages indicates an expected call of ReorderCakeImages.
+func (mr *MockICakeRepositoryMockRecorder) ReorderCakeImages(ctx, cakeID, imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCakeImages", reflect.TypeOf((*MockICakeRepository)(nil).ReorderCakeImages), ctx, cakeID, imageIDs)
+}
+
+// SearchCakes mocks base method.
+func (m *MockICakeRepository) SearchCakes(arg0 context.Context, arg1 dto.SearchCakesReq) ([]dto.PreviewCake, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCakes", arg0, arg1)
+	ret0, _ := ret[0].([]dto.PreviewCake)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchCakes indicates an expected call of SearchCakes.
+func (mr *MockICakeRepositoryMockRecorder) SearchCakes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCakes", reflect.TypeOf((*MockICakeRepository)(nil).SearchCakes), arg0, arg1)
+}
+
+// SetCakeSaleStatus mocks base method.
+func (m *MockICakeRepository) SetCakeSaleStatus(ctx context.Context, cakeID uuid.UUID, isOpenForSale bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCakeSaleStatus", ctx, cakeID, isOpenForSale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCakeSaleStatus indicates an expected call of SetCakeSaleStatus.
+func (mr *MockICakeRepositoryMockRecorder) SetCakeSaleStatus(ctx, cakeID, isOpenForSale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCakeSaleStatus", reflect.TypeOf((*MockICakeRepository)(nil).SetCakeSaleStatus), ctx, cakeID, isOpenForSale)
+}
+
+// UpdateCake mocks base method.
+func (m *MockICakeRepository) UpdateCake(arg0 context.Context, arg1 dto.UpdateCakeDBReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCake", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCake indicates an expected call of UpdateCake.
+func (mr *MockICakeRepositoryMockRecorder) UpdateCake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCake", reflect.TypeOf((*MockICakeRepository)(nil).UpdateCake), arg0, arg1)
+}
+
+// UpdateCakeOption mocks base method.
+func (m *MockICakeRepository) UpdateCakeOption(arg0 context.Context, arg1 *models.CakeOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCakeOption", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCakeOption indicates an expected call of UpdateCakeOption.
+func (mr *MockICakeRepositoryMockRecorder) UpdateCakeOption(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCakeOption", reflect.TypeOf((*MockICakeRepository)(nil).UpdateCakeOption), arg0, arg1)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICakeRepository) UpdateCategory(arg0 context.Context, arg1 dto.UpdateCategoryDBReq) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICakeRepositoryMockRecorder) UpdateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICakeRepository)(nil).UpdateCategory), arg0, arg1)
+}
+
+// UpdateFilling mocks base method.
+func (m *MockICakeRepository) UpdateFilling(arg0 context.Context, arg1 dto.UpdateFillingDBReq) (*models.Filling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFilling", arg0, arg1)
+	ret0, _ := ret[0].(*models.Filling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFilling indicates an expected call of UpdateFilling.
+func (mr *MockICakeRepositoryMockRecorder) UpdateFilling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFilling", reflect.TypeOf((*MockICakeRepository)(nil).UpdateFilling), arg0, arg1)
+}
+
+// MockIImageStorage is a mock of IImageStorage interface.
+type MockIImageStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockIImageStorageMockRecorder
+}
+
+// MockIImageStorageMockRecorder is the mock recorder for MockIImageStorage.
+type MockIImageStorageMockRecorder struct {
+	mock *MockIImageStorage
+}
+
+// NewMockIImageStorage creates a new mock instance.
+func NewMockIImageStorage(ctrl *gomock.Controller) *MockIImageStorage {
+	mock := &MockIImageStorage{ctrl: ctrl}
+	mock.recorder = &MockIImageStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIImageStorage) EXPECT() *MockIImageStorageMockRecorder {
+	return m.recorder
+}
+
+// DeleteImages mocks base method.
+func (m *MockIImageStorage) DeleteImages(ctx context.Context, bucketName string, objectNames []minio.ImageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImages", ctx, bucketName, objectNames)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImages indicates an expected call of DeleteImages.
+func (mr *MockIImageStorageMockRecorder) DeleteImages(ctx, bucketName, objectNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImages", reflect.TypeOf((*MockIImageStorage)(nil).DeleteImages), ctx, bucketName, objectNames)
+}
+
+// SaveImage mocks base method.
+func (m *MockIImageStorage) SaveImage(ctx context.Context, bucketName string, objectName minio.ImageID, imageData []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveImage", ctx, bucketName, objectName, imageData)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveImage indicates an expected call of SaveImage.
+func (mr *MockIImageStorageMockRecorder) SaveImage(ctx, bucketName, objectName, imageData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveImage", reflect.TypeOf((*MockIImageStorage)(nil).SaveImage), ctx, bucketName, objectName, imageData)
+}
+
+// SaveImages mocks base method.
+func (m *MockIImageStorage) SaveImages(ctx context.Context, bucketName string, images map[minio.ImageID][]byte) (map[minio.ImageID]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveImages", ctx, bucketName, images)
+	ret0, _ := ret[0].(map[minio.ImageID]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveImages indicates an expected call of SaveImages.
+func (mr *MockIImageStorageMockRecorder) SaveImages(ctx, bucketName, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveImages", reflect.TypeOf((*MockIImageStorage)(nil).SaveImages), ctx, bucketName, images)
+}
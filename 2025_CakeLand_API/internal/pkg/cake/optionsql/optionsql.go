@@ -0,0 +1,103 @@
+// Package optionsql Запросы и разбор опций торта, общие для сервисов тортов и заказов
+package optionsql
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+const (
+	// OptionJSON Группа опций o со значениями одним JSON-объектом
+	OptionJSON = `
+		json_build_object(
+			'id', o.id,
+			'cake_id', o.cake_id,
+			'name', o.name,
+			'kind', o.kind,
+			'is_required', o.is_required,
+			'price_modifier', o.price_modifier,
+			'max_text_length', o.max_text_length,
+			'values', COALESCE((SELECT json_agg(json_build_object(
+											'id', v.id,
+											'name', v.name,
+											'price_modifier', v.price_modifier
+										) ORDER BY v.position, v.id)
+								FROM cake_option_value v
+								WHERE v.option_id = o.id), '[]')
+		)`
+	// OptionsJSON Опции торта c по порядку
+	OptionsJSON = `
+		COALESCE((SELECT json_agg(` + OptionJSON + ` ORDER BY o.position, o.id)
+				  FROM cake_option o
+				  WHERE o.cake_id = c.id), '[]')`
+
+	// QueryCakeOptions Опции торта $1. Нет строки, если торта нет
+	QueryCakeOptions = `SELECT ` + OptionsJSON + ` FROM cake c WHERE c.id = $1 AND c.deleted_at IS NULL`
+)
+
+type optionJSON struct {
+	ID            uuid.UUID             `json:"id"`
+	CakeID        uuid.UUID             `json:"cake_id"`
+	Name          string                `json:"name"`
+	Kind          models.CakeOptionKind `json:"kind"`
+	IsRequired    bool                  `json:"is_required"`
+	PriceModifier float64               `json:"price_modifier"`
+	MaxTextLength null.Int              `json:"max_text_length"`
+	Values        []optionValueJSON     `json:"values"`
+}
+
+type optionValueJSON struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	PriceModifier float64   `json:"price_modifier"`
+}
+
+func (row optionJSON) toModel() models.CakeOption {
+	values := make([]models.CakeOptionValue, len(row.Values))
+	for i, value := range row.Values {
+		values[i] = models.CakeOptionValue{
+			ID:            value.ID,
+			Name:          value.Name,
+			PriceModifier: value.PriceModifier,
+		}
+	}
+
+	return models.CakeOption{
+		ID:            row.ID,
+		CakeID:        row.CakeID,
+		Name:          row.Name,
+		Kind:          row.Kind,
+		IsRequired:    row.IsRequired,
+		PriceModifier: row.PriceModifier,
+		MaxTextLength: row.MaxTextLength,
+		Values:        values,
+	}
+}
+
+// DecodeOptions Разбирает результат OptionsJSON
+func DecodeOptions(data []byte) ([]models.CakeOption, error) {
+	var rows []optionJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("decode cake options: %w", err)
+	}
+
+	options := make([]models.CakeOption, len(rows))
+	for i, row := range rows {
+		options[i] = row.toModel()
+	}
+
+	return options, nil
+}
+
+// DecodeOption Разбирает результат OptionJSON
+func DecodeOption(data []byte) (models.CakeOption, error) {
+	var row optionJSON
+	if err := json.Unmarshal(data, &row); err != nil {
+		return models.CakeOption{}, fmt.Errorf("decode cake option: %w", err)
+	}
+
+	return row.toModel(), nil
+}
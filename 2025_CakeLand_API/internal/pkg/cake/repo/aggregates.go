@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// Строки JSON-агрегатов (json_agg), которыми связанные сущности торта загружаются в одном запросе
+
+type categoryJSON struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	ImageURL   string    `json:"image_url"`
+	GenderTags []string  `json:"gender_tags"`
+}
+
+type fillingJSON struct {
+	ID          uuid.UUID     `json:"id"`
+	Name        string        `json:"name"`
+	ImageURL    string        `json:"image_url"`
+	Content     string        `json:"content"`
+	KgPrice     float64       `json:"kg_price"`
+	Description string        `json:"description"`
+	OwnerID     uuid.NullUUID `json:"owner_id"`
+}
+
+type cakeImageJSON struct {
+	ID       uuid.UUID   `json:"id"`
+	ImageURL null.String `json:"image_url"`
+}
+
+func decodeCategories(data []byte) ([]models.Category, error) {
+	var rows []categoryJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("decode categories: %w", err)
+	}
+
+	categories := make([]models.Category, len(rows))
+	for i, row := range rows {
+		categories[i] = models.Category{
+			ID:              row.ID,
+			Name:            row.Name,
+			ImageURL:        row.ImageURL,
+			CategoryGenders: models.ParseGenderTags(row.GenderTags),
+		}
+	}
+
+	return categories, nil
+}
+
+func decodeFillings(data []byte) ([]models.Filling, error) {
+	var rows []fillingJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("decode fillings: %w", err)
+	}
+
+	fillings := make([]models.Filling, len(rows))
+	for i, row := range rows {
+		fillings[i] = models.Filling{
+			ID:          row.ID,
+			Name:        row.Name,
+			ImageURL:    row.ImageURL,
+			Content:     row.Content,
+			KgPrice:     row.KgPrice,
+			Description: row.Description,
+			OwnerID:     row.OwnerID,
+		}
+	}
+
+	return fillings, nil
+}
+
+func decodeCakeImages(data []byte) ([]models.CakeImage, error) {
+	var rows []cakeImageJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("decode cake images: %w", err)
+	}
+
+	images := make([]models.CakeImage, len(rows))
+	for i, row := range rows {
+		images[i] = models.CakeImage{
+			ID:       row.ID,
+			ImageURL: row.ImageURL,
+		}
+	}
+
+	return images, nil
+}
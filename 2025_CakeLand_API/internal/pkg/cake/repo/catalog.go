@@ -0,0 +1,212 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/dto"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	queryCategoryByID = `SELECT id, name, image_url, gender_tags FROM category WHERE id = $1`
+	// Переименование в уже занятое название упирается в уникальный индекс по name
+	queryUpdateCategory = `
+		UPDATE category
+		SET name        = COALESCE($2, name),
+			image_url   = COALESCE($3, image_url),
+			gender_tags = CASE WHEN $4 THEN $5::category_gender[] ELSE gender_tags END
+		WHERE id = $1
+		RETURNING id, name, image_url, gender_tags
+	`
+	queryDeleteCategory = `DELETE FROM category WHERE id = $1 RETURNING image_url`
+	queryFillingByID    = `
+		SELECT id, name, image_url, content, kg_price, description, owner_id
+		FROM filling
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	queryUpdateFilling = `
+		UPDATE filling
+		SET name        = COALESCE($2, name),
+			image_url   = COALESCE($3, image_url),
+			content     = COALESCE($4, content),
+			kg_price    = COALESCE($5, kg_price),
+			description = COALESCE($6, description)
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id, name, image_url, content, kg_price, description, owner_id
+	`
+	querySoftDeleteFilling   = `UPDATE filling SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	queryDeleteCakeFillings  = `DELETE FROM cake_filling WHERE filling_id = $1`
+	queryCountUsableFillings = `
+		SELECT COUNT(*)
+		FROM filling
+		WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL AND (owner_id IS NULL OR owner_id = $2)
+	`
+)
+
+func (r *CakeRepository) CategoryByID(ctx context.Context, categoryID uuid.UUID) (*models.Category, error) {
+	const methodName = "[Repo.CategoryByID]"
+
+	category, err := scanCategory(r.db.QueryRowContext(ctx, queryCategoryByID, categoryID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return category, nil
+}
+
+// UpdateCategory ErrNotFound, если категории нет, ErrAlreadyExists, если новое название занято другой категорией
+func (r *CakeRepository) UpdateCategory(ctx context.Context, in dto.UpdateCategoryDBReq) (*models.Category, error) {
+	const methodName = "[Repo.UpdateCategory]"
+
+	category, err := scanCategory(r.db.QueryRowContext(ctx, queryUpdateCategory,
+		in.ID,
+		in.Name,
+		in.ImageURL,
+		in.UpdateGenderTags,
+		pq.Array(models.GenderTagsToStrings(in.GenderTags)),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		if errs.IsUniqueViolation(err) {
+			return nil, errs.ErrAlreadyExists
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return category, nil
+}
+
+// DeleteCategory Удаляет категорию вместе с её привязками к тортам и акциями. Возвращает URL фотографии
+func (r *CakeRepository) DeleteCategory(ctx context.Context, categoryID uuid.UUID) (string, error) {
+	const methodName = "[Repo.DeleteCategory]"
+
+	var imageURL string
+	if err := r.db.QueryRowContext(ctx, queryDeleteCategory, categoryID).Scan(&imageURL); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errs.ErrNotFound
+		}
+		return "", errs.WrapDBError(methodName, err)
+	}
+
+	return imageURL, nil
+}
+
+func (r *CakeRepository) FillingByID(ctx context.Context, fillingID uuid.UUID) (*models.Filling, error) {
+	const methodName = "[Repo.FillingByID]"
+
+	filling, err := scanFilling(r.db.QueryRowContext(ctx, queryFillingByID, fillingID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return filling, nil
+}
+
+func (r *CakeRepository) UpdateFilling(ctx context.Context, in dto.UpdateFillingDBReq) (*models.Filling, error) {
+	const methodName = "[Repo.UpdateFilling]"
+
+	filling, err := scanFilling(r.db.QueryRowContext(ctx, queryUpdateFilling,
+		in.ID,
+		in.Name,
+		in.ImageURL,
+		in.Content,
+		in.KgPrice,
+		in.Description,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return filling, nil
+}
+
+// DeleteFilling Мягкое удаление: начинка остаётся в заказах, но убирается из тортов
+func (r *CakeRepository) DeleteFilling(ctx context.Context, fillingID uuid.UUID) error {
+	const methodName = "[Repo.DeleteFilling]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	res, err := tx.ExecContext(ctx, querySoftDeleteFilling, fillingID)
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if err = checkAffected(methodName, res); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, queryDeleteCakeFillings, fillingID); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// CountUsableFillings Сколько из начинок можно добавить в торт пользователя: общие и его собственные
+func (r *CakeRepository) CountUsableFillings(ctx context.Context, userID uuid.UUID, fillingIDs []uuid.UUID) (int, error) {
+	const methodName = "[Repo.CountUsableFillings]"
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, queryCountUsableFillings,
+		pq.Array(uuidsToStrings(fillingIDs)),
+		userID,
+	).Scan(&count); err != nil {
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	return count, nil
+}
+
+func scanCategory(row *sql.Row) (*models.Category, error) {
+	var (
+		category   models.Category
+		genderTags pq.StringArray
+	)
+	if err := row.Scan(&category.ID, &category.Name, &category.ImageURL, &genderTags); err != nil {
+		return nil, err
+	}
+
+	category.CategoryGenders = models.ParseGenderTags(genderTags)
+	return &category, nil
+}
+
+func scanFilling(row *sql.Row) (*models.Filling, error) {
+	var filling models.Filling
+	if err := row.Scan(
+		&filling.ID,
+		&filling.Name,
+		&filling.ImageURL,
+		&filling.Content,
+		&filling.KgPrice,
+		&filling.Description,
+		&filling.OwnerID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &filling, nil
+}
@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/dto"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	// Добавление идемпотентно: повторный вызов ничего не меняет. Запрос возвращает, существует ли цель
+	queryAddFavoriteCake = `
+		WITH target AS (SELECT id FROM cake WHERE id = $2 AND deleted_at IS NULL),
+			 inserted AS (
+				 INSERT INTO favorite_cake (user_id, cake_id)
+				 SELECT $1, id FROM target
+				 ON CONFLICT DO NOTHING
+			 )
+		SELECT EXISTS (SELECT 1 FROM target)
+	`
+	queryRemoveFavoriteCake = `DELETE FROM favorite_cake WHERE user_id = $1 AND cake_id = $2`
+	queryAddFavoriteSeller  = `
+		WITH target AS (SELECT id FROM "user" WHERE id = $2),
+			 inserted AS (
+				 INSERT INTO favorite_seller (user_id, seller_id)
+				 SELECT $1, id FROM target
+				 ON CONFLICT DO NOTHING
+			 )
+		SELECT EXISTS (SELECT 1 FROM target)
+	`
+	queryRemoveFavoriteSeller     = `DELETE FROM favorite_seller WHERE user_id = $1 AND seller_id = $2`
+	queryFavoriteSellersFirstPage = `
+		SELECT seller_id, created_at
+		FROM favorite_seller
+		WHERE user_id = $1
+		ORDER BY created_at DESC, seller_id DESC
+		LIMIT $2
+	`
+	queryFavoriteSellersNextPage = `
+		SELECT seller_id, created_at
+		FROM favorite_seller
+		WHERE user_id = $1 AND (created_at, seller_id) < ($3, $4)
+		ORDER BY created_at DESC, seller_id DESC
+		LIMIT $2
+	`
+)
+
+func (r *CakeRepository) AddFavoriteCake(ctx context.Context, userID, cakeID uuid.UUID) error {
+	const methodName = "[Repo.AddFavoriteCake]"
+
+	return r.addFavorite(ctx, methodName, queryAddFavoriteCake, userID, cakeID)
+}
+
+func (r *CakeRepository) RemoveFavoriteCake(ctx context.Context, userID, cakeID uuid.UUID) error {
+	const methodName = "[Repo.RemoveFavoriteCake]"
+
+	if _, err := r.db.ExecContext(ctx, queryRemoveFavoriteCake, userID, cakeID); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *CakeRepository) AddFavoriteSeller(ctx context.Context, userID, sellerID uuid.UUID) error {
+	const methodName = "[Repo.AddFavoriteSeller]"
+
+	return r.addFavorite(ctx, methodName, queryAddFavoriteSeller, userID, sellerID)
+}
+
+func (r *CakeRepository) RemoveFavoriteSeller(ctx context.Context, userID, sellerID uuid.UUID) error {
+	const methodName = "[Repo.RemoveFavoriteSeller]"
+
+	if _, err := r.db.ExecContext(ctx, queryRemoveFavoriteSeller, userID, sellerID); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// FavoriteSellers Возвращает ID избранных продавцов пользователя, сначала добавленных последними
+func (r *CakeRepository) FavoriteSellers(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]uuid.UUID, *pagination.Cursor, error) {
+	const methodName = "[Repo.FavoriteSellers]"
+
+	rows, err := r.queryTimePage(ctx, methodName, queryFavoriteSellersFirstPage, queryFavoriteSellersNextPage, userID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	// Чтение результатов
+	sellerIDs := make([]uuid.UUID, 0, page.Size+1)
+	createdAt := make([]time.Time, 0, page.Size+1)
+	for rows.Next() {
+		var (
+			sellerID uuid.UUID
+			addedAt  time.Time
+		)
+
+		if err = rows.Scan(&sellerID, &addedAt); err != nil {
+			return nil, nil, errs.WrapDBError(methodName, err)
+		}
+
+		sellerIDs = append(sellerIDs, sellerID)
+		createdAt = append(createdAt, addedAt)
+	}
+
+	// Проверка на ошибки после завершения итерации
+	if err = rows.Err(); err != nil {
+		return nil, nil, errs.WrapDBError(methodName, err)
+	}
+
+	if len(sellerIDs) <= page.Size {
+		return sellerIDs, nil, nil
+	}
+
+	last := page.Size - 1
+	return sellerIDs[:page.Size], newTimeCursor(dto.FavoriteSellersCursorKey, createdAt[last], sellerIDs[last]), nil
+}
+
+// addFavorite Выполняет идемпотентное добавление в избранное. ErrNotFound, если цели не существует
+func (r *CakeRepository) addFavorite(ctx context.Context, methodName, query string, userID, targetID uuid.UUID) error {
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, userID, targetID).Scan(&exists); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if !exists {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/optionsql"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	queryCakeOptionByID = `SELECT ` + optionsql.OptionJSON + ` FROM cake_option o WHERE o.id = $1`
+	// Строка торта блокируется до конца транзакции: параллельно добавленные опции получают разные позиции
+	queryLockCakeForOptions = `SELECT id FROM cake WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+	queryCountCakeOptions   = `SELECT count(*) FROM cake_option WHERE cake_id = $1`
+	queryCreateCakeOption   = `
+		INSERT INTO cake_option (id, cake_id, name, kind, is_required, price_modifier, max_text_length, position)
+		SELECT $1, $2, $3, $4, $5, $6, $7, COALESCE(MAX(position) + 1, 0)
+		FROM cake_option
+		WHERE cake_id = $2
+	`
+	queryUpdateCakeOption = `
+		UPDATE cake_option
+		SET name            = $2,
+			kind            = $3,
+			is_required     = $4,
+			price_modifier  = $5,
+			max_text_length = $6
+		WHERE id = $1
+	`
+	queryDeleteCakeOption = `DELETE FROM cake_option WHERE id = $1`
+	// Значения, которых нет в новом списке, удаляются
+	queryDeleteStaleOptionValues = `DELETE FROM cake_option_value WHERE option_id = $1 AND id <> ALL($2::uuid[])`
+	// Новые значения добавляются, существующие обновляются; порядок — как в запросе
+	queryUpsertOptionValues = `
+		INSERT INTO cake_option_value (id, option_id, name, price_modifier, position)
+		SELECT v.id, $1, v.name, v.price_modifier, v.position - 1
+		FROM UNNEST($2::uuid[], $3::varchar[], $4::float8[]) WITH ORDINALITY AS v(id, name, price_modifier, position)
+		ON CONFLICT (id) DO UPDATE
+			SET name           = EXCLUDED.name,
+				price_modifier = EXCLUDED.price_modifier,
+				position       = EXCLUDED.position
+			WHERE cake_option_value.option_id = EXCLUDED.option_id
+	`
+)
+
+// CakeOptions Возвращает опции торта по порядку. ErrNotFound, если торта нет
+func (r *CakeRepository) CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error) {
+	const methodName = "[Repo.CakeOptions]"
+
+	var data []byte
+	if err := r.db.QueryRowContext(ctx, optionsql.QueryCakeOptions, cakeID).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	options, err := optionsql.DecodeOptions(data)
+	if err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return options, nil
+}
+
+func (r *CakeRepository) CakeOptionByID(ctx context.Context, optionID uuid.UUID) (*models.CakeOption, error) {
+	const methodName = "[Repo.CakeOptionByID]"
+
+	var data []byte
+	if err := r.db.QueryRowContext(ctx, queryCakeOptionByID, optionID).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	option, err := optionsql.DecodeOption(data)
+	if err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &option, nil
+}
+
+// CreateCakeOption Добавляет группу опций в конец списка опций торта. ErrNotFound, если торта нет,
+// ErrInvalidInput, если у торта уже models.MaxCakeOptions групп
+func (r *CakeRepository) CreateCakeOption(ctx context.Context, option *models.CakeOption) error {
+	const methodName = "[Repo.CreateCakeOption]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	var cakeID uuid.UUID
+	if err = tx.QueryRowContext(ctx, queryLockCakeForOptions, option.CakeID).Scan(&cakeID); err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrNotFound
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	// Считаем под блокировкой торта: параллельные добавления не превысят лимит
+	var count int
+	if err = tx.QueryRowContext(ctx, queryCountCakeOptions, option.CakeID).Scan(&count); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if count >= models.MaxCakeOptions {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: cake already has %d options", errs.ErrInvalidInput, models.MaxCakeOptions)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryCreateCakeOption,
+		option.ID,
+		option.CakeID,
+		option.Name,
+		option.Kind,
+		option.IsRequired,
+		option.PriceModifier,
+		option.MaxTextLength,
+	); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = upsertOptionValues(ctx, tx, option); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// UpdateCakeOption Заменяет группу опций целиком: значения, которых нет в option.Values, удаляются
+func (r *CakeRepository) UpdateCakeOption(ctx context.Context, option *models.CakeOption) error {
+	const methodName = "[Repo.UpdateCakeOption]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	res, err := tx.ExecContext(ctx, queryUpdateCakeOption,
+		option.ID,
+		option.Name,
+		option.Kind,
+		option.IsRequired,
+		option.PriceModifier,
+		option.MaxTextLength,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if err = checkAffected(methodName, res); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	valueIDs := make([]string, len(option.Values))
+	for i, value := range option.Values {
+		valueIDs[i] = value.ID.String()
+	}
+
+	if _, err = tx.ExecContext(ctx, queryDeleteStaleOptionValues, option.ID, pq.Array(valueIDs)); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = upsertOptionValues(ctx, tx, option); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// DeleteCakeOption Удаляет группу опций. В оформленных заказах остаются копии выбранных опций
+func (r *CakeRepository) DeleteCakeOption(ctx context.Context, optionID uuid.UUID) error {
+	const methodName = "[Repo.DeleteCakeOption]"
+
+	res, err := r.db.ExecContext(ctx, queryDeleteCakeOption, optionID)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return checkAffected(methodName, res)
+}
+
+func upsertOptionValues(ctx context.Context, tx *sql.Tx, option *models.CakeOption) error {
+	if len(option.Values) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(option.Values))
+	names := make([]string, len(option.Values))
+	prices := make([]float64, len(option.Values))
+	for i, value := range option.Values {
+		ids[i], names[i], prices[i] = value.ID.String(), value.Name, value.PriceModifier
+	}
+
+	_, err := tx.ExecContext(ctx, queryUpsertOptionValues, option.ID, pq.Array(ids), pq.Array(names), pq.Array(prices))
+	return err
+}
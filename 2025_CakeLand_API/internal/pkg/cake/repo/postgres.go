@@ -4,51 +4,109 @@ import (
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/cake/dto"
+	"2025_CakeLand_API/internal/pkg/cake/optionsql"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
-	"github.com/guregu/null"
 	"github.com/lib/pq"
-	"sync"
+	"time"
 )
 
 const (
-	queryGetCakeCategoriesIDs = `SELECT category_id FROM cake_category WHERE cake_id = $1`
-	queryGetCakeFillingsIDs   = `SELECT filling_id FROM cake_filling WHERE cake_id = $1`
-	queryGetFillingByID       = `SELECT id, name, image_url, content, kg_price, description FROM filling WHERE id = $1`
-	queryGetCategoryByID      = `SELECT id, name, image_url, gender_tags FROM category WHERE id = $1`
-	queryGetCakeImages        = `SELECT id, image_url FROM cake_images WHERE cake_id = $1`
-	queryGetCakeByID          = `
-		SELECT c.id, c.name, c.image_url, c.kg_price, c.reviews_count, c.stars_sum,
-			   c.description, c.mass, c.is_open_for_sale, c.date_creation, c.discount_kg_price, c.discount_end_time,
-			   u.id AS owner_id, u.fio, u.address, u.nickname, u.image_url, u.mail, u.phone, u.header_image_url
+	queryGetCakeImages = `SELECT id, image_url FROM cake_images WHERE cake_id = $1 ORDER BY position, id`
+	// Торт со всеми связанными сущностями одним запросом: категории, начинки и фотографии — JSON-агрегаты
+	queryGetCakeByID = `
+		SELECT c.id, c.name, c.image_url, c.kg_price, c.reviews_count, c.stars_sum, c.rating,
+			   c.description, c.mass, c.is_open_for_sale, c.date_creation,
+			   CASE WHEN ep.effective_kg_price < c.kg_price THEN ep.effective_kg_price END, ep.discount_ends_at,
+			   c.favorites_count,
+			   u.id AS owner_id, u.fio, u.address, u.nickname, u.image_url, COALESCE(u.mail, ''), u.phone, u.header_image_url,
+			   COALESCE((SELECT json_agg(json_build_object(
+											 'id', cat.id,
+											 'name', cat.name,
+											 'image_url', cat.image_url,
+											 'gender_tags', cat.gender_tags
+										 ) ORDER BY cat.name, cat.id)
+						 FROM cake_category cc
+								  JOIN category cat ON cat.id = cc.category_id
+						 WHERE cc.cake_id = c.id), '[]') AS categories,
+			   COALESCE((SELECT json_agg(json_build_object(
+											 'id', f.id,
+											 'name', f.name,
+											 'image_url', f.image_url,
+											 'content', f.content,
+											 'kg_price', f.kg_price,
+											 'description', f.description,
+											 'owner_id', f.owner_id
+										 ) ORDER BY f.name, f.id)
+						 FROM cake_filling cf
+								  JOIN filling f ON f.id = cf.filling_id
+						 WHERE cf.cake_id = c.id), '[]') AS fillings,
+			   COALESCE((SELECT json_agg(json_build_object(
+											 'id', ci.id,
+											 'image_url', ci.image_url
+										 ) ORDER BY ci.position, ci.id)
+						 FROM cake_images ci
+						 WHERE ci.cake_id = c.id), '[]') AS images,
+			   ` + optionsql.OptionsJSON + ` AS options
 		FROM "cake" c
+				 CROSS JOIN LATERAL cake_effective_price(c, now()) ep
 				 LEFT JOIN "user" u ON c.owner_id = u.id
-		WHERE c.id = $1
+		WHERE c.id = $1 AND c.deleted_at IS NULL
 	`
 	queryCreateFilling = `
-		INSERT INTO "filling" (id, name, image_url, content, kg_price, description)
-		VALUES ($1, $2, $3, $4, $5, $6);
+		INSERT INTO "filling" (id, name, image_url, content, kg_price, description, owner_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7);
 	`
-	queryCreateCategory = `INSERT INTO "category" (id, name, image_url) VALUES ($1, $2, $3);`
-	queryCreateCake     = `
+	queryCreateCategory = `
+		INSERT INTO "category" (id, name, image_url, gender_tags)
+		VALUES ($1, $2, $3, $4::category_gender[])
+		ON CONFLICT (name) DO NOTHING
+	`
+	queryCreateCake = `
 		INSERT INTO "cake" (id, name, image_url, kg_price, discount_kg_price, discount_end_time, description, mass, is_open_for_sale, owner_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 	`
-	queryAddCakeImages   = `INSERT INTO "cake_images" (id, cake_id, image_url) VALUES ($1, $2, $3);`
-	queryAddCateCategory = `
+	queryAddCakeImages = `
+		INSERT INTO "cake_images" (id, cake_id, image_url, position)
+		SELECT i.id, $1, i.image_url, i.position - 1
+		FROM UNNEST($2::uuid[], $3::varchar[]) WITH ORDINALITY AS i(id, image_url, position);
+	`
+	queryAddCakeCategories = `
 		INSERT INTO "cake_category" (id, category_id, cake_id)
-		VALUES ($1, $2, $3);
+		SELECT r.id, r.category_id, $1
+		FROM UNNEST($2::uuid[], $3::uuid[]) AS r(id, category_id);
     `
-	queryAddFillingCategory = `
+	queryAddCakeFillings = `
 		INSERT INTO "cake_filling" (id, cake_id, filling_id)
-		VALUES ($1, $2, $3);
+		SELECT r.id, $1, r.filling_id
+		FROM UNNEST($2::uuid[], $3::uuid[]) AS r(id, filling_id);
     `
-	queryCategories       = `SELECT id, name, image_url, gender_tags FROM "category";`
-	queryFillings         = `SELECT id, name, image_url, content, kg_price, description FROM "filling";`
+	queryCategoriesFirstPage = `
+		SELECT id, name, image_url, gender_tags FROM "category" ORDER BY name, id LIMIT $1
+	`
+	queryCategoriesNextPage = `
+		SELECT id, name, image_url, gender_tags FROM "category" WHERE (name, id) > ($2, $3) ORDER BY name, id LIMIT $1
+	`
+	// Общие начинки и начинки пользователя $2 (без авторизации $2 = NULL — только общие)
+	queryFillingsFirstPage = `
+		SELECT id, name, image_url, content, kg_price, description, owner_id
+		FROM "filling"
+		WHERE deleted_at IS NULL AND (owner_id IS NULL OR owner_id = $2)
+		ORDER BY name, id
+		LIMIT $1
+	`
+	queryFillingsNextPage = `
+		SELECT id, name, image_url, content, kg_price, description, owner_id
+		FROM "filling"
+		WHERE deleted_at IS NULL AND (owner_id IS NULL OR owner_id = $2) AND (name, id) > ($3, $4)
+		ORDER BY name, id
+		LIMIT $1
+	`
 	queryCakesByGenderTag = `SELECT id, name, image_url, gender_tags FROM category WHERE $1 = ANY(gender_tags);`
-	queryCategoryCakesIDs = `SELECT cake_id FROM cake_category WHERE category_id = $1;`
 	queryPreviewCakeByID  = `
 		SELECT c.id,
 			   c.name,
@@ -56,6 +114,7 @@ const (
 			   c.kg_price,
 			   c.reviews_count,
 			   c.stars_sum,
+			   c.rating,
 			   c.description,
 			   c.mass,
 			   c.discount_kg_price,
@@ -67,44 +126,50 @@ const (
 			   u.address,
 			   u.nickname,
 			   u.image_url,
-			   u.mail,
+			   COALESCE(u.mail, ''),
 			   u.phone,
 			   u.header_image_url
 		FROM cake c
 				 LEFT JOIN "user" u ON u.id = c.owner_id
-		WHERE c.id = $1
+		WHERE c.id = $1 AND c.deleted_at IS NULL
+	`
+	queryGetColors     = `SELECT DISTINCT hex_color FROM cake_color`
+	queryAddCakeColors = `
+		INSERT INTO cake_color (id, cake_id, hex_color)
+		SELECT r.id, $1, r.hex_color
+		FROM UNNEST($2::uuid[], $3::varchar[]) AS r(id, hex_color)
+	`
+	queryCakeOwnerID = `SELECT owner_id FROM cake WHERE id = $1 AND deleted_at IS NULL`
+	queryUpdateCake  = `
+		UPDATE cake
+		SET name              = COALESCE($2, name),
+			image_url         = COALESCE($3, image_url),
+			kg_price          = COALESCE($4, kg_price),
+			description       = COALESCE($5, description),
+			mass              = COALESCE($6, mass),
+			discount_kg_price = CASE WHEN $7 THEN NULL ELSE COALESCE($8, discount_kg_price) END,
+			discount_end_time = CASE WHEN $7 THEN NULL ELSE COALESCE($9, discount_end_time) END
+		WHERE id = $1 AND deleted_at IS NULL
 	`
-	queryGetColors    = `SELECT DISTINCT hex_color FROM cake_color`
-	queryAddCakeColor = `INSERT INTO cake_color (id, cake_id, hex_color) VALUES ($1, $2, $3)`
-	queryGetAllCakes  = `
-		SELECT id,
-			   name,
-			   image_url,
-			   kg_price,
-			   reviews_count,
-			   stars_sum,
-			   description,
-			   mass,
-			   discount_kg_price,
-			   discount_end_time,
-			   date_creation,
-			   is_open_for_sale,
-			   owner_id
-		FROM cake
+	querySetCakeSaleStatus = `UPDATE cake SET is_open_for_sale = $2 WHERE id = $1 AND deleted_at IS NULL`
+	queryDeleteCake        = `
+		UPDATE cake
+		SET deleted_at = now(), is_open_for_sale = false
+		WHERE id = $1 AND deleted_at IS NULL
 	`
-	queryGetUser = `
-		SELECT id,
-			   fio,
-			   address,
-			   nickname,
-			   image_url,
-			   header_image_url,
-			   mail,
-			   phone
-		FROM "user"
-		WHERE id = $1
+	queryAppendCakeImages = `
+		INSERT INTO cake_images (id, cake_id, image_url, position)
+		SELECT i.id, $1, i.image_url, last.position + i.position
+		FROM UNNEST($2::uuid[], $3::varchar[]) WITH ORDINALITY AS i(id, image_url, position),
+			 (SELECT COALESCE(MAX(position), -1) AS position FROM cake_images WHERE cake_id = $1) last
+	`
+	queryDeleteCakeImage   = `DELETE FROM cake_images WHERE id = $1 AND cake_id = $2 RETURNING id, image_url`
+	queryReorderCakeImages = `
+		UPDATE cake_images ci
+		SET position = o.position - 1
+		FROM UNNEST($2::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE ci.id = o.id AND ci.cake_id = $1
 	`
-	queryGetCakeColors = `SELECT id, cake_id, hex_color FROM cake_color WHERE cake_id = $1`
 )
 
 type CakeRepository struct {
@@ -117,116 +182,17 @@ func NewCakeRepository(db *sql.DB) *CakeRepository {
 	}
 }
 
-func (r *CakeRepository) GetCakeColorsByCakeID(ctx context.Context, cakeID uuid.UUID) ([]models.CakeColor, error) {
-	const methodName = "[CakeRepository.GetCakeColorsByCakeID]"
-
-	rows, err := r.db.QueryContext(ctx, queryGetCakeColors, cakeID)
-	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-	defer rows.Close()
-
-	var colors []models.CakeColor
-	for rows.Next() {
-		var color models.CakeColor
-		if err = rows.Scan(&color.ID, &color.CakeID, &color.HexString); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
-		}
-
-		colors = append(colors, color)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	return colors, nil
-}
-
-func (r *CakeRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (dto.Owner, error) {
-	const methodName = "[UserRepository.GetUserByID]"
-
-	row := r.db.QueryRowContext(ctx, queryGetUser, userID)
-
-	var user dto.Owner
-	if err := row.Scan(
-		&user.ID,
-		&user.FIO,
-		&user.Address,
-		&user.Nickname,
-		&user.ImageURL,
-		&user.HeaderImageURL,
-		&user.Mail,
-		&user.Phone,
-	); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return user, errs.WrapDBError(methodName, err)
-		}
-		return user, errs.WrapDBError(methodName, err)
-	}
-
-	return user, nil
-}
-
-func (r *CakeRepository) GetCakesPreview(ctx context.Context) ([]dto.PreviewCake, error) {
-	const methodName = "[CakeRepository.GetCakes]"
-
-	rows, err := r.db.QueryContext(ctx, queryGetAllCakes)
-	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-	defer rows.Close()
-
-	var cakes []dto.PreviewCake
-	for rows.Next() {
-		var cake dto.PreviewCake
-		var discountKgPrice sql.NullFloat64
-		var discountEndTime sql.NullTime
-		var ownerID uuid.UUID
-
-		if err = rows.Scan(
-			&cake.ID,
-			&cake.Name,
-			&cake.PreviewImageURL,
-			&cake.KgPrice,
-			&cake.ReviewsCount,
-			&cake.StarsSum,
-			&cake.Description,
-			&cake.Mass,
-			&discountKgPrice,
-			&discountEndTime,
-			&cake.DateCreation,
-			&cake.IsOpenForSale,
-			&ownerID,
-		); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
-		}
-
-		cake.DiscountKgPrice = null.FloatFromPtr(nil)
-		if discountKgPrice.Valid {
-			cake.DiscountKgPrice = null.FloatFrom(discountKgPrice.Float64)
-		}
-
-		cake.DiscountEndTime = null.TimeFromPtr(nil)
-		if discountEndTime.Valid {
-			cake.DiscountEndTime = null.TimeFrom(discountEndTime.Time)
-		}
-
-		cake.Owner.ID = ownerID
-		cakes = append(cakes, cake)
-	}
+func (r *CakeRepository) AddCakeColors(ctx context.Context, cakeID uuid.UUID, colors []models.CakeColor) error {
+	const methodName = "[CakeRepository.AddCakeColors]"
 
-	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
+	ids := make([]string, len(colors))
+	hexStrings := make([]string, len(colors))
+	for i, color := range colors {
+		ids[i] = color.ID.String()
+		hexStrings[i] = color.HexString
 	}
 
-	return cakes, nil
-}
-
-func (r *CakeRepository) AddCakeColor(ctx context.Context, in models.CakeColor) error {
-	const methodName = "[CakeRepository.AddCakeColor]"
-
-	if _, err := r.db.ExecContext(ctx, queryAddCakeColor, in.ID, in.CakeID, in.HexString); err != nil {
+	if _, err := r.db.ExecContext(ctx, queryAddCakeColors, cakeID, pq.Array(ids), pq.Array(hexStrings)); err != nil {
 		return errs.WrapDBError(methodName, err)
 	}
 
@@ -236,13 +202,18 @@ func (r *CakeRepository) AddCakeColor(ctx context.Context, in models.CakeColor)
 func (r *CakeRepository) CakeByID(ctx context.Context, in dto.GetCakeReq) (*dto.GetCakeRes, error) {
 	const methodName = "[CakeRepository.CakeByID]"
 
-	var cake models.Cake
+	var (
+		cake                                      models.Cake
+		categories, fillings, cakeImages, options []byte
+	)
 	if err := r.db.QueryRowContext(ctx, queryGetCakeByID, in.CakeID).Scan(
-		&cake.ID, &cake.Name, &cake.PreviewImageURL, &cake.KgPrice, &cake.ReviewsCount, &cake.StarsSum, &cake.Description,
+		&cake.ID, &cake.Name, &cake.PreviewImageURL, &cake.KgPrice, &cake.ReviewsCount, &cake.StarsSum, &cake.Rating, &cake.Description,
 		&cake.Mass, &cake.IsOpenForSale, &cake.DateCreation, &cake.DiscountKgPrice, &cake.DiscountEndTime,
+		&cake.FavoritesCount,
 		&cake.Owner.ID, &cake.Owner.FIO, &cake.Owner.Address,
 		&cake.Owner.Nickname, &cake.Owner.ImageURL, &cake.Owner.Mail, &cake.Owner.Phone,
 		&cake.Owner.HeaderImageURL,
+		&categories, &fillings, &cakeImages, &options,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrNotFound
@@ -250,6 +221,20 @@ func (r *CakeRepository) CakeByID(ctx context.Context, in dto.GetCakeReq) (*dto.
 		return nil, errs.WrapDBError(methodName, err)
 	}
 
+	var err error
+	if cake.Categories, err = decodeCategories(categories); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	if cake.Fillings, err = decodeFillings(fillings); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	if cake.Images, err = decodeCakeImages(cakeImages); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+	if cake.Options, err = optionsql.DecodeOptions(options); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
 	return &dto.GetCakeRes{
 		Cake: cake,
 	}, nil
@@ -281,102 +266,6 @@ func (r *CakeRepository) GetColors(ctx context.Context) ([]string, error) {
 	return hexStrings, nil
 }
 
-func (r *CakeRepository) CakeCategoriesIDs(ctx context.Context, cakeID uuid.UUID) ([]uuid.UUID, error) {
-	const methodName = "[Repo.CakeCategoriesIDs]"
-
-	rows, err := r.db.QueryContext(ctx, queryGetCakeCategoriesIDs, cakeID)
-	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	defer rows.Close()
-	var ids []uuid.UUID
-	for rows.Next() {
-		var id uuid.UUID
-		if err = rows.Scan(&id); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
-		}
-
-		ids = append(ids, id)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	return ids, nil
-}
-
-func (r *CakeRepository) CakeFillingsIDs(ctx context.Context, cakeID uuid.UUID) ([]uuid.UUID, error) {
-	const methodName = "[Repo.CakeFillingsIDs]"
-
-	rows, err := r.db.QueryContext(ctx, queryGetCakeFillingsIDs, cakeID)
-	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	defer rows.Close()
-	var ids []uuid.UUID
-	for rows.Next() {
-		var id uuid.UUID
-		if err = rows.Scan(&id); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
-		}
-
-		ids = append(ids, id)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	return ids, nil
-}
-
-func (r *CakeRepository) FillingByID(ctx context.Context, fillingID uuid.UUID) (*models.Filling, error) {
-	const methodName = "[Repo.FillingByID]"
-
-	var filling models.Filling
-	if err := r.db.QueryRowContext(ctx, queryGetFillingByID, fillingID).Scan(
-		&filling.ID,
-		&filling.Name,
-		&filling.ImageURL,
-		&filling.Content,
-		&filling.KgPrice,
-		&filling.Description,
-	); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errs.ErrNotFound
-		}
-
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	return &filling, nil
-}
-
-func (r *CakeRepository) CategoryByID(ctx context.Context, categoryID uuid.UUID) (*models.Category, error) {
-	const methodName = "[Repo.CategoryByID]"
-
-	var category models.Category
-	var genderTags pq.StringArray
-
-	if err := r.db.QueryRowContext(ctx, queryGetCategoryByID, categoryID).Scan(
-		&category.ID,
-		&category.Name,
-		&category.ImageURL,
-		&genderTags,
-	); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errs.ErrNotFound
-		}
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	category.CategoryGenders = models.ParseGenderTags(genderTags)
-	return &category, nil
-}
-
 func (r *CakeRepository) CakeImages(ctx context.Context, cakeID uuid.UUID) ([]models.CakeImage, error) {
 	const methodName = "[Repo.CakeImages]"
 
@@ -416,55 +305,36 @@ func (r *CakeRepository) CreateCake(ctx context.Context, in dto.CreateCakeDBReq)
 		return errs.WrapDBError(methodName, err)
 	}
 
-	var wg sync.WaitGroup
-	errChan := make(chan error, 1)
-	cancelableCtx, cancel := context.WithCancel(ctx)
-
-	// Обёртка для запуска SQL-запросов в горутине
-	execInGoroutine := func(query string, args ...interface{}) {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
-			_, dbErr := tx.ExecContext(cancelableCtx, query, args...)
-			if dbErr != nil {
-				select {
-				case errChan <- dbErr:
-					cancel()
-				default:
-				}
-			}
-		}()
-	}
-
-	// Добавляем категории к торту
-	for _, categoryID := range in.CategoryIDs {
-		execInGoroutine(queryAddCateCategory, uuid.New(), categoryID, in.ID)
+	// Добавляем категории, начинки и изображения торта пачками
+	categoryRowIDs, categoryIDs := make([]string, len(in.CategoryIDs)), make([]string, len(in.CategoryIDs))
+	for i, categoryID := range in.CategoryIDs {
+		categoryRowIDs[i], categoryIDs[i] = uuid.NewString(), categoryID
 	}
 
-	// Добавляем начинки к торту
-	for _, fillingID := range in.FillingIDs {
-		execInGoroutine(queryAddFillingCategory, uuid.New(), in.ID, fillingID)
+	fillingRowIDs, fillingIDs := make([]string, len(in.FillingIDs)), make([]string, len(in.FillingIDs))
+	for i, fillingID := range in.FillingIDs {
+		fillingRowIDs[i], fillingIDs[i] = uuid.NewString(), fillingID
 	}
 
-	// Добавляем изображения торта
+	imageIDs, imageURLs := make([]string, 0, len(in.Images)), make([]string, 0, len(in.Images))
 	for imageID, imageURL := range in.Images {
-		execInGoroutine(queryAddCakeImages, imageID, in.ID, imageURL)
-	}
-
-	// Ожидаем ошибку или завершение всех горутин
-	done := make(chan struct{})
-	go func() {
-		wg.Wait()
-		close(done)
-		close(errChan)
-	}()
-
-	select {
-	case dbErr := <-errChan:
-		_ = tx.Rollback()
-		return errs.WrapDBError(methodName, dbErr)
-	case <-done:
+		imageIDs = append(imageIDs, string(imageID))
+		imageURLs = append(imageURLs, imageURL)
+	}
+
+	batches := []struct {
+		query string
+		args  []interface{}
+	}{
+		{queryAddCakeCategories, []interface{}{in.ID, pq.Array(categoryRowIDs), pq.Array(categoryIDs)}},
+		{queryAddCakeFillings, []interface{}{in.ID, pq.Array(fillingRowIDs), pq.Array(fillingIDs)}},
+		{queryAddCakeImages, []interface{}{in.ID, pq.Array(imageIDs), pq.Array(imageURLs)}},
+	}
+	for _, batch := range batches {
+		if _, err = tx.ExecContext(ctx, batch.query, batch.args...); err != nil {
+			_ = tx.Rollback()
+			return errs.WrapDBError(methodName, err)
+		}
 	}
 
 	// Фиксируем транзакцию
@@ -486,6 +356,7 @@ func (r *CakeRepository) CreateFilling(ctx context.Context, in models.Filling) e
 		in.Content,
 		in.KgPrice,
 		in.Description,
+		in.OwnerID,
 	); err != nil {
 		return errs.WrapDBError(methodName, err)
 	}
@@ -493,28 +364,51 @@ func (r *CakeRepository) CreateFilling(ctx context.Context, in models.Filling) e
 	return nil
 }
 
+// CreateCategory ErrAlreadyExists, если категория с таким названием уже есть
 func (r *CakeRepository) CreateCategory(ctx context.Context, in *models.Category) error {
 	const methodName = "[Repo.CreateCategory]"
 
-	// TODO: Сделать добавление тегов
-	if _, err := r.db.ExecContext(ctx, queryCreateCategory, in.ID, in.Name, in.ImageURL); err != nil {
+	res, err := r.db.ExecContext(ctx, queryCreateCategory,
+		in.ID,
+		in.Name,
+		in.ImageURL,
+		pq.Array(models.GenderTagsToStrings(in.CategoryGenders)),
+	)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
 		return errs.WrapDBError(methodName, err)
 	}
+	if affected == 0 {
+		return errs.ErrAlreadyExists
+	}
 
 	return nil
 }
 
-func (r *CakeRepository) Categories(ctx context.Context) (*[]models.Category, error) {
+func (r *CakeRepository) Categories(ctx context.Context, page pagination.Page) ([]models.Category, *pagination.Cursor, error) {
 	const methodName = "[Repo.Categories]"
 
-	rows, err := r.db.QueryContext(ctx, queryCategories)
-	defer rows.Close()
+	// Берём на одну категорию больше, чтобы понять, есть ли следующая страница
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if page.After == nil {
+		rows, err = r.db.QueryContext(ctx, queryCategoriesFirstPage, page.Size+1)
+	} else {
+		rows, err = r.db.QueryContext(ctx, queryCategoriesNextPage, page.Size+1, page.After.Text, page.After.ID)
+	}
 	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
+		return nil, nil, errs.WrapDBError(methodName, err)
 	}
+	defer rows.Close()
 
 	// Чтение результатов
-	var categories []models.Category
+	categories := make([]models.Category, 0, page.Size+1)
 	for rows.Next() {
 		var (
 			category        models.Category
@@ -527,7 +421,7 @@ func (r *CakeRepository) Categories(ctx context.Context) (*[]models.Category, er
 			&category.ImageURL,
 			&categoryGenders,
 		); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
+			return nil, nil, errs.WrapDBError(methodName, err)
 		}
 
 		category.CategoryGenders = models.ParseGenderTags(categoryGenders)
@@ -536,40 +430,65 @@ func (r *CakeRepository) Categories(ctx context.Context) (*[]models.Category, er
 
 	// Проверка на ошибки после завершения итерации
 	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
+		return nil, nil, errs.WrapDBError(methodName, err)
 	}
 
-	return &categories, nil
+	if len(categories) <= page.Size {
+		return categories, nil, nil
+	}
+
+	last := categories[page.Size-1]
+	return categories[:page.Size], &pagination.Cursor{
+		Text: last.Name,
+		ID:   last.ID,
+	}, nil
 }
 
-func (r *CakeRepository) Fillings(ctx context.Context) (*[]models.Filling, error) {
+func (r *CakeRepository) Fillings(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) ([]models.Filling, *pagination.Cursor, error) {
 	const methodName = "[Repo.Fillings]"
 
-	rows, err := r.db.QueryContext(ctx, queryFillings)
-	defer rows.Close()
+	// Берём на одну начинку больше, чтобы понять, есть ли следующая страница
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if page.After == nil {
+		rows, err = r.db.QueryContext(ctx, queryFillingsFirstPage, page.Size+1, viewerID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, queryFillingsNextPage, page.Size+1, viewerID, page.After.Text, page.After.ID)
+	}
 	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
+		return nil, nil, errs.WrapDBError(methodName, err)
 	}
+	defer rows.Close()
 
 	// Чтение результатов
-	var fillings []models.Filling
+	fillings := make([]models.Filling, 0, page.Size+1)
 	for rows.Next() {
 		var filling models.Filling
 		if err = rows.Scan(
 			&filling.ID, &filling.Name, &filling.ImageURL,
-			&filling.Content, &filling.KgPrice, &filling.Description,
+			&filling.Content, &filling.KgPrice, &filling.Description, &filling.OwnerID,
 		); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
+			return nil, nil, errs.WrapDBError(methodName, err)
 		}
 		fillings = append(fillings, filling)
 	}
 
 	// Проверка на ошибки после завершения итерации
 	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
+		return nil, nil, errs.WrapDBError(methodName, err)
+	}
+
+	if len(fillings) <= page.Size {
+		return fillings, nil, nil
 	}
 
-	return &fillings, nil
+	last := fillings[page.Size-1]
+	return fillings[:page.Size], &pagination.Cursor{
+		Text: last.Name,
+		ID:   last.ID,
+	}, nil
 }
 
 func (r *CakeRepository) CategoryIDsByGenderName(ctx context.Context, genderTag models.CategoryGender) ([]dto.DBCategory, error) {
@@ -608,32 +527,6 @@ func (r *CakeRepository) CategoryIDsByGenderName(ctx context.Context, genderTag
 	return categories, nil
 }
 
-func (r *CakeRepository) CategoryCakesIDs(ctx context.Context, categoryID uuid.UUID) ([]uuid.UUID, error) {
-	const methodName = "[Repo.CategoryCakesID]"
-
-	rows, err := r.db.QueryContext(ctx, queryCategoryCakesIDs, categoryID)
-	if err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	defer rows.Close()
-	var ids []uuid.UUID
-	for rows.Next() {
-		var id uuid.UUID
-		if err = rows.Scan(&id); err != nil {
-			return nil, errs.WrapDBError(methodName, err)
-		}
-
-		ids = append(ids, id)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	return ids, nil
-}
-
 func (r *CakeRepository) PreviewCakeByID(ctx context.Context, cakeID uuid.UUID) (*dto.PreviewCake, error) {
 	const methodName = "[Repo.PreviewCakeByID]"
 
@@ -645,6 +538,7 @@ func (r *CakeRepository) PreviewCakeByID(ctx context.Context, cakeID uuid.UUID)
 		&previewCake.KgPrice,
 		&previewCake.ReviewsCount,
 		&previewCake.StarsSum,
+		&previewCake.Rating,
 		&previewCake.Description,
 		&previewCake.Mass,
 		&previewCake.DiscountKgPrice,
@@ -677,3 +571,156 @@ func mapToSlice(cakes map[uuid.UUID]models.Cake) []models.Cake {
 	}
 	return result
 }
+
+func (r *CakeRepository) CakeOwnerID(ctx context.Context, cakeID uuid.UUID) (uuid.UUID, error) {
+	const methodName = "[Repo.CakeOwnerID]"
+
+	var ownerID uuid.UUID
+	if err := r.db.QueryRowContext(ctx, queryCakeOwnerID, cakeID).Scan(&ownerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errs.ErrNotFound
+		}
+		return uuid.Nil, errs.WrapDBError(methodName, err)
+	}
+
+	return ownerID, nil
+}
+
+func (r *CakeRepository) UpdateCake(ctx context.Context, in dto.UpdateCakeDBReq) error {
+	const methodName = "[Repo.UpdateCake]"
+
+	res, err := r.db.ExecContext(ctx, queryUpdateCake,
+		in.ID, in.Name, in.PreviewImageURL, in.KgPrice, in.Description, in.Mass,
+		in.RemoveDiscount, in.DiscountedKgPrice, in.DiscountedPriceEndDate,
+	)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return checkAffected(methodName, res)
+}
+
+func (r *CakeRepository) SetCakeSaleStatus(ctx context.Context, cakeID uuid.UUID, isOpenForSale bool) error {
+	const methodName = "[Repo.SetCakeSaleStatus]"
+
+	res, err := r.db.ExecContext(ctx, querySetCakeSaleStatus, cakeID, isOpenForSale)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return checkAffected(methodName, res)
+}
+
+func (r *CakeRepository) DeleteCake(ctx context.Context, cakeID uuid.UUID) error {
+	const methodName = "[Repo.DeleteCake]"
+
+	res, err := r.db.ExecContext(ctx, queryDeleteCake, cakeID)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return checkAffected(methodName, res)
+}
+
+func (r *CakeRepository) AddCakeImages(ctx context.Context, cakeID uuid.UUID, images []models.CakeImage) error {
+	const methodName = "[Repo.AddCakeImages]"
+
+	// Фотографии добавляются в конец в переданном порядке
+	ids := make([]string, len(images))
+	imageURLs := make([]string, len(images))
+	for i, image := range images {
+		ids[i] = image.ID.String()
+		imageURLs[i] = image.ImageURL.String
+	}
+
+	if _, err := r.db.ExecContext(ctx, queryAppendCakeImages, cakeID, pq.Array(ids), pq.Array(imageURLs)); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *CakeRepository) DeleteCakeImage(ctx context.Context, cakeID, imageID uuid.UUID) (*models.CakeImage, error) {
+	const methodName = "[Repo.DeleteCakeImage]"
+
+	var image models.CakeImage
+	if err := r.db.QueryRowContext(ctx, queryDeleteCakeImage, imageID, cakeID).Scan(
+		&image.ID,
+		&image.ImageURL,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &image, nil
+}
+
+func (r *CakeRepository) ReorderCakeImages(ctx context.Context, cakeID uuid.UUID, imageIDs []uuid.UUID) error {
+	const methodName = "[Repo.ReorderCakeImages]"
+
+	ids := make([]string, len(imageIDs))
+	for i, id := range imageIDs {
+		ids[i] = id.String()
+	}
+
+	if _, err := r.db.ExecContext(ctx, queryReorderCakeImages, cakeID, pq.Array(ids)); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+// checkAffected Возвращает ErrNotFound, если запрос не изменил ни одной строки
+func checkAffected(methodName string, res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if affected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// queryTimePage Выполняет запрос страницы списка, упорядоченного по (created_at, id) DESC.
+// Запросы принимают $1 — владельца списка, $2 — лимит, $3 и $4 — курсор
+func (r *CakeRepository) queryTimePage(
+	ctx context.Context,
+	methodName string,
+	firstPageQuery, nextPageQuery string,
+	ownerID uuid.UUID,
+	page pagination.Page,
+) (*sql.Rows, error) {
+	// Берём на одну запись больше, чтобы понять, есть ли следующая страница
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if page.After == nil {
+		rows, err = r.db.QueryContext(ctx, firstPageQuery, ownerID, page.Size+1)
+	} else {
+		after, parseErr := time.Parse(time.RFC3339Nano, page.After.Text)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: invalid page token: %w", errs.ErrInvalidInput, parseErr)
+		}
+		rows, err = r.db.QueryContext(ctx, nextPageQuery, ownerID, page.Size+1, after, page.After.ID)
+	}
+	if err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return rows, nil
+}
+
+// newTimeCursor Курсор для списков, упорядоченных по (created_at, id)
+func newTimeCursor(key string, createdAt time.Time, id uuid.UUID) *pagination.Cursor {
+	return &pagination.Cursor{
+		Key:  key,
+		Text: createdAt.Format(time.RFC3339Nano),
+		ID:   id,
+	}
+}
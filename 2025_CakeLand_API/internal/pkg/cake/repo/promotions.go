@@ -0,0 +1,237 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/dto"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// Акция на категорию создаётся только для существующей категории
+	queryCreatePromotion = `
+		INSERT INTO promotion (id, seller_id, cake_id, category_id, kind, value, starts_at, ends_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		WHERE $4::uuid IS NULL OR EXISTS (SELECT 1 FROM category WHERE id = $4)
+		RETURNING created_at
+	`
+	queryRefreshEffectivePrices = `SELECT refresh_cake_effective_prices()`
+	queryCancelPromotion        = `
+		UPDATE promotion
+		SET cancelled_at = now()
+		WHERE id = $1 AND seller_id = $2 AND cancelled_at IS NULL
+	`
+	queryPromotionsFirstPage = `
+		SELECT id, seller_id, cake_id, category_id, kind, value, starts_at, ends_at, created_at, cancelled_at
+		FROM promotion
+		WHERE seller_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+	queryPromotionsNextPage = `
+		SELECT id, seller_id, cake_id, category_id, kind, value, starts_at, ends_at, created_at, cancelled_at
+		FROM promotion
+		WHERE seller_id = $1 AND (created_at, id) < ($3, $4)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+	queryCreatePromoCode = `
+		INSERT INTO promo_code (id, code, seller_id, kind, value, starts_at, expires_at, max_uses, max_uses_per_user)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (code) DO NOTHING
+		RETURNING created_at
+	`
+	queryCancelPromoCode = `
+		UPDATE promo_code
+		SET cancelled_at = now()
+		WHERE id = $1 AND seller_id = $2 AND cancelled_at IS NULL
+	`
+	queryPromoCodesFirstPage = `
+		SELECT id, code, seller_id, kind, value, starts_at, expires_at, max_uses, max_uses_per_user, uses_count,
+			   created_at, cancelled_at
+		FROM promo_code
+		WHERE seller_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+	queryPromoCodesNextPage = `
+		SELECT id, code, seller_id, kind, value, starts_at, expires_at, max_uses, max_uses_per_user, uses_count,
+			   created_at, cancelled_at
+		FROM promo_code
+		WHERE seller_id = $1 AND (created_at, id) < ($3, $4)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+)
+
+// CreatePromotion Сохраняет акцию и проставляет ей дату создания. ErrNotFound, если категории не существует
+func (r *CakeRepository) CreatePromotion(ctx context.Context, promotion *models.Promotion) error {
+	const methodName = "[Repo.CreatePromotion]"
+
+	if err := r.db.QueryRowContext(ctx, queryCreatePromotion,
+		promotion.ID,
+		promotion.SellerID,
+		promotion.CakeID,
+		promotion.CategoryID,
+		promotion.Discount.Kind,
+		promotion.Discount.Value,
+		promotion.StartsAt,
+		promotion.EndsAt,
+	).Scan(&promotion.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrNotFound
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *CakeRepository) CancelPromotion(ctx context.Context, sellerID, promotionID uuid.UUID) error {
+	const methodName = "[Repo.CancelPromotion]"
+
+	res, err := r.db.ExecContext(ctx, queryCancelPromotion, promotionID, sellerID)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return checkAffected(methodName, res)
+}
+
+// Promotions Возвращает историю акций продавца, сначала новые
+func (r *CakeRepository) Promotions(ctx context.Context, sellerID uuid.UUID, page pagination.Page) ([]models.Promotion, *pagination.Cursor, error) {
+	const methodName = "[Repo.Promotions]"
+
+	rows, err := r.queryTimePage(ctx, methodName, queryPromotionsFirstPage, queryPromotionsNextPage, sellerID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	promotions := make([]models.Promotion, 0, page.Size+1)
+	for rows.Next() {
+		var promotion models.Promotion
+		if err = rows.Scan(
+			&promotion.ID,
+			&promotion.SellerID,
+			&promotion.CakeID,
+			&promotion.CategoryID,
+			&promotion.Discount.Kind,
+			&promotion.Discount.Value,
+			&promotion.StartsAt,
+			&promotion.EndsAt,
+			&promotion.CreatedAt,
+			&promotion.CancelledAt,
+		); err != nil {
+			return nil, nil, errs.WrapDBError(methodName, err)
+		}
+
+		promotions = append(promotions, promotion)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, errs.WrapDBError(methodName, err)
+	}
+
+	if len(promotions) <= page.Size {
+		return promotions, nil, nil
+	}
+
+	last := promotions[page.Size-1]
+	return promotions[:page.Size], newTimeCursor(dto.PromotionsCursorKey, last.CreatedAt, last.ID), nil
+}
+
+// CreatePromoCode Сохраняет промокод и проставляет ему дату создания. ErrAlreadyExists, если код занят
+func (r *CakeRepository) CreatePromoCode(ctx context.Context, promoCode *models.PromoCode) error {
+	const methodName = "[Repo.CreatePromoCode]"
+
+	if err := r.db.QueryRowContext(ctx, queryCreatePromoCode,
+		promoCode.ID,
+		promoCode.Code,
+		promoCode.SellerID,
+		promoCode.Discount.Kind,
+		promoCode.Discount.Value,
+		promoCode.StartsAt,
+		promoCode.ExpiresAt,
+		promoCode.MaxUses,
+		promoCode.MaxUsesPerUser,
+	).Scan(&promoCode.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrAlreadyExists
+		}
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *CakeRepository) CancelPromoCode(ctx context.Context, sellerID, promoCodeID uuid.UUID) error {
+	const methodName = "[Repo.CancelPromoCode]"
+
+	res, err := r.db.ExecContext(ctx, queryCancelPromoCode, promoCodeID, sellerID)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return checkAffected(methodName, res)
+}
+
+// PromoCodes Возвращает промокоды продавца, сначала новые
+func (r *CakeRepository) PromoCodes(ctx context.Context, sellerID uuid.UUID, page pagination.Page) ([]models.PromoCode, *pagination.Cursor, error) {
+	const methodName = "[Repo.PromoCodes]"
+
+	rows, err := r.queryTimePage(ctx, methodName, queryPromoCodesFirstPage, queryPromoCodesNextPage, sellerID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	promoCodes := make([]models.PromoCode, 0, page.Size+1)
+	for rows.Next() {
+		var promoCode models.PromoCode
+		if err = rows.Scan(
+			&promoCode.ID,
+			&promoCode.Code,
+			&promoCode.SellerID,
+			&promoCode.Discount.Kind,
+			&promoCode.Discount.Value,
+			&promoCode.StartsAt,
+			&promoCode.ExpiresAt,
+			&promoCode.MaxUses,
+			&promoCode.MaxUsesPerUser,
+			&promoCode.UsesCount,
+			&promoCode.CreatedAt,
+			&promoCode.CancelledAt,
+		); err != nil {
+			return nil, nil, errs.WrapDBError(methodName, err)
+		}
+
+		promoCodes = append(promoCodes, promoCode)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, errs.WrapDBError(methodName, err)
+	}
+
+	if len(promoCodes) <= page.Size {
+		return promoCodes, nil, nil
+	}
+
+	last := promoCodes[page.Size-1]
+	return promoCodes[:page.Size], newTimeCursor(dto.PromoCodesCursorKey, last.CreatedAt, last.ID), nil
+}
+
+// RefreshEffectivePrices Пересчитывает действующую цену тортов, у которых началась или закончилась скидка
+func (r *CakeRepository) RefreshEffectivePrices(ctx context.Context) error {
+	const methodName = "[Repo.RefreshEffectivePrices]"
+
+	if _, err := r.db.ExecContext(ctx, queryRefreshEffectivePrices); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,316 @@
+package repo
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/dto"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"strings"
+	"time"
+)
+
+const (
+	querySearchCakes = `
+		SELECT c.id,
+			   c.name,
+			   c.image_url,
+			   c.kg_price,
+			   c.reviews_count,
+			   c.stars_sum,
+			   c.rating,
+			   c.description,
+			   c.mass,
+			   CASE WHEN c.effective_kg_price < c.kg_price THEN c.effective_kg_price END AS discount_kg_price,
+			   c.effective_discount_ends_at AS discount_end_time,
+			   c.date_creation,
+			   c.is_open_for_sale,
+			   c.owner_id,
+			   c.favorites_count,
+			   fav.user_id IS NOT NULL AS is_favorite,
+			   ARRAY(SELECT DISTINCT col.hex_color FROM cake_color col WHERE col.cake_id = c.id ORDER BY col.hex_color) AS colors_hex,
+			   %[5]s AS distance_km,
+			   %[1]s AS sort_value
+		FROM cake c
+		LEFT JOIN seller_service_area sa ON sa.seller_id = c.owner_id
+		LEFT JOIN favorite_cake fav ON fav.cake_id = c.id AND fav.user_id = %[6]s
+		WHERE %[2]s
+		ORDER BY sort_value %[3]s, c.id %[3]s
+		LIMIT %[4]s
+	`
+
+	// Действующая цена за кг с учётом скидки торта и акций продавца хранится в торте и поддерживается
+	// триггерами и RefreshEffectivePrices (миграция 027), поэтому фильтр и сортировка идут по индексу
+	sqlEffectiveKgPrice  = `c.effective_kg_price`
+	sqlHasActiveDiscount = `c.effective_kg_price < c.kg_price`
+
+	// Байесовский рейтинг хранится в cake.rating_score и пересчитывается триггером (миграция 005)
+	sqlRatingScore = `c.rating_score`
+
+	queryAddressLocation = `SELECT latitude, longitude FROM address WHERE id = $1 AND user_id = $2`
+
+	// Километров в одном градусе широты: для грубого отсева продавцов по индексу перед расчётом расстояния
+	kmPerLatitudeDegree = 111.2
+)
+
+// cakesSearchBuilder Собирает условия и аргументы запроса поиска тортов
+type cakesSearchBuilder struct {
+	conditions []string
+	args       []interface{}
+	distance   string // Расстояние от центра зоны доставки продавца до точки поиска
+}
+
+// arg Добавляет аргумент запроса и возвращает его плейсхолдер
+func (b *cakesSearchBuilder) arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *cakesSearchBuilder) where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+// sortKey Ключ сортировки выдачи. Столбцы сравниваются без приведения типов, чтобы работали индексы
+// cake_date_creation_idx, cake_orders_count_idx, cake_rating_score_idx и cake_effective_kg_price_idx
+type sortKey struct {
+	expr      string
+	direction string
+	isTime    bool // Ключ — время: в курсоре хранится в Text (RFC3339Nano), иначе в Number
+}
+
+// sortKey Возвращает ключ сортировки и направление
+func (b *cakesSearchBuilder) sortKey(in dto.SearchCakesReq) sortKey {
+	switch in.Sort {
+	case dto.CakeSortPriceAsc:
+		return sortKey{expr: sqlEffectiveKgPrice, direction: "ASC"}
+	case dto.CakeSortPriceDesc:
+		return sortKey{expr: sqlEffectiveKgPrice, direction: "DESC"}
+	case dto.CakeSortRating:
+		return sortKey{expr: sqlRatingScore, direction: "DESC"}
+	case dto.CakeSortPopularity:
+		return sortKey{expr: `c.orders_count`, direction: "DESC"}
+	case dto.CakeSortDistance:
+		return sortKey{expr: b.distance, direction: "ASC"}
+	case dto.CakeSortFavorited:
+		return sortKey{expr: `fav.created_at`, direction: "DESC", isTime: true}
+	case dto.CakeSortRelevance:
+		// ts_rank возвращает real: приводим к float8, чтобы значение из курсора сравнивалось точно
+		return sortKey{
+			expr:      fmt.Sprintf(`ts_rank(c.search_vector, websearch_to_tsquery('russian', %s))::float8`, b.arg(in.Query)),
+			direction: "DESC",
+		}
+	default:
+		// date_creation объявлен NOT NULL (миграция 001), поэтому keyset сравнение не теряет торты
+		return sortKey{expr: `c.date_creation`, direction: "DESC", isTime: true}
+	}
+}
+
+// after Значение ключа сортировки из курсора предыдущей страницы
+func (k sortKey) after(cursor *pagination.Cursor) (interface{}, error) {
+	if !k.isTime {
+		return cursor.Number, nil
+	}
+
+	after, err := time.Parse(time.RFC3339Nano, cursor.Text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid page token: %w", errs.ErrInvalidInput, err)
+	}
+	return after, nil
+}
+
+// cursor Курсор, указывающий на торт со значением ключа сортировки number или at
+func (k sortKey) cursor(key string, number float64, at time.Time, id uuid.UUID) *pagination.Cursor {
+	if k.isTime {
+		return newTimeCursor(key, at, id)
+	}
+
+	return &pagination.Cursor{
+		Key:    key,
+		Number: number,
+		ID:     id,
+	}
+}
+
+func (r *CakeRepository) SearchCakes(ctx context.Context, in dto.SearchCakesReq) ([]dto.PreviewCake, *pagination.Cursor, error) {
+	const methodName = "[Repo.SearchCakes]"
+
+	b := &cakesSearchBuilder{distance: `NULL::float8`}
+	if in.Near != nil {
+		b.distance = fmt.Sprintf(`haversine_km(sa.latitude, sa.longitude, %s, %s)`,
+			b.arg(in.Near.Latitude), b.arg(in.Near.Longitude))
+	}
+	// Без авторизации соединение с избранным ничего не находит
+	viewer := `NULL::uuid`
+	if in.ViewerID.Valid {
+		viewer = b.arg(in.ViewerID.UUID)
+	}
+	sort := b.sortKey(in)
+
+	// Фильтры
+	b.where(`c.deleted_at IS NULL`)
+	if in.Query != "" {
+		b.where(fmt.Sprintf(`c.search_vector @@ websearch_to_tsquery('russian', %s)`, b.arg(in.Query)))
+	}
+	if in.MinPrice.Valid {
+		b.where(fmt.Sprintf(`%s >= %s`, sqlEffectiveKgPrice, b.arg(in.MinPrice.Float64)))
+	}
+	if in.MaxPrice.Valid {
+		b.where(fmt.Sprintf(`%s <= %s`, sqlEffectiveKgPrice, b.arg(in.MaxPrice.Float64)))
+	}
+	if in.MinMass.Valid {
+		b.where(fmt.Sprintf(`c.mass >= %s`, b.arg(in.MinMass.Float64)))
+	}
+	if in.MaxMass.Valid {
+		b.where(fmt.Sprintf(`c.mass <= %s`, b.arg(in.MaxMass.Float64)))
+	}
+	if len(in.CategoryIDs) != 0 {
+		b.where(fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM cake_category cc WHERE cc.cake_id = c.id AND cc.category_id = ANY(%s::uuid[]))`,
+			b.arg(pq.Array(uuidsToStrings(in.CategoryIDs))),
+		))
+	}
+	if len(in.FillingIDs) != 0 {
+		b.where(fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM cake_filling cf WHERE cf.cake_id = c.id AND cf.filling_id = ANY(%s::uuid[]))`,
+			b.arg(pq.Array(uuidsToStrings(in.FillingIDs))),
+		))
+	}
+	if len(in.ColorsHex) != 0 {
+		b.where(fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM cake_color col WHERE col.cake_id = c.id AND UPPER(col.hex_color) = ANY(%s))`,
+			b.arg(pq.Array(in.ColorsHex)),
+		))
+	}
+	if in.OnlyOpenForSale {
+		b.where(`c.is_open_for_sale IS TRUE`)
+	}
+	if in.OnlyDiscounted {
+		b.where(sqlHasActiveDiscount)
+	}
+	if in.OnlyFavorites {
+		b.where(`fav.user_id IS NOT NULL`)
+	}
+	if in.Near != nil {
+		b.where(`sa.seller_id IS NOT NULL`)
+	}
+	if in.Near != nil && in.RadiusKm.Valid {
+		latitudeDelta := in.RadiusKm.Float64 / kmPerLatitudeDegree
+		b.where(fmt.Sprintf(`sa.latitude BETWEEN %s AND %s`,
+			b.arg(in.Near.Latitude-latitudeDelta), b.arg(in.Near.Latitude+latitudeDelta)))
+		b.where(fmt.Sprintf(`%s <= %s`, b.distance, b.arg(in.RadiusKm.Float64)))
+	}
+	if in.DeliverTo != nil {
+		b.where(fmt.Sprintf(`haversine_km(sa.latitude, sa.longitude, %s, %s) <= sa.radius_km`,
+			b.arg(in.DeliverTo.Latitude), b.arg(in.DeliverTo.Longitude)))
+	}
+
+	// Keyset: продолжаем строго после последнего торта предыдущей страницы
+	if after := in.Page.After; after != nil {
+		value, err := sort.after(after)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		comparison := "<"
+		if sort.direction == "ASC" {
+			comparison = ">"
+		}
+		b.where(fmt.Sprintf(`(%s, c.id) %s (%s, %s)`, sort.expr, comparison, b.arg(value), b.arg(after.ID)))
+	}
+
+	// Берём на один торт больше, чтобы понять, есть ли следующая страница
+	limit := b.arg(in.Page.Size + 1)
+	query := fmt.Sprintf(querySearchCakes, sort.expr, strings.Join(b.conditions, " AND "), sort.direction, limit, b.distance, viewer)
+
+	rows, err := r.db.QueryContext(ctx, query, b.args...)
+	if err != nil {
+		return nil, nil, errs.WrapDBError(methodName, err)
+	}
+	defer rows.Close()
+
+	cakes := make([]dto.PreviewCake, 0, in.Page.Size+1)
+	var (
+		sortNumber float64
+		sortTime   time.Time
+		next       *pagination.Cursor
+	)
+	var sortValue interface{} = &sortNumber
+	if sort.isTime {
+		sortValue = &sortTime
+	}
+	for rows.Next() {
+		var cake dto.PreviewCake
+
+		if err = rows.Scan(
+			&cake.ID,
+			&cake.Name,
+			&cake.PreviewImageURL,
+			&cake.KgPrice,
+			&cake.ReviewsCount,
+			&cake.StarsSum,
+			&cake.Rating,
+			&cake.Description,
+			&cake.Mass,
+			&cake.DiscountKgPrice,
+			&cake.DiscountEndTime,
+			&cake.DateCreation,
+			&cake.IsOpenForSale,
+			&cake.Owner.ID,
+			&cake.FavoritesCount,
+			&cake.IsFavorite,
+			(*pq.StringArray)(&cake.ColorsHex),
+			&cake.DistanceKm,
+			sortValue,
+		); err != nil {
+			return nil, nil, errs.WrapDBError(methodName, err)
+		}
+
+		cakes = append(cakes, cake)
+		// Курсор указывает на последний торт страницы
+		if len(cakes) == in.Page.Size {
+			next = sort.cursor(in.CursorKey(), sortNumber, sortTime, cake.ID)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, errs.WrapDBError(methodName, err)
+	}
+
+	if len(cakes) <= in.Page.Size {
+		return cakes, nil, nil
+	}
+
+	return cakes[:in.Page.Size], next, nil
+}
+
+// AddressLocation Возвращает координаты адреса, если он принадлежит пользователю
+func (r *CakeRepository) AddressLocation(ctx context.Context, addressID, userID uuid.UUID) (models.GeoPoint, error) {
+	const methodName = "[Repo.AddressLocation]"
+
+	var point models.GeoPoint
+	if err := r.db.QueryRowContext(ctx, queryAddressLocation, addressID, userID).Scan(
+		&point.Latitude,
+		&point.Longitude,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GeoPoint{}, errs.ErrNotFound
+		}
+		return models.GeoPoint{}, errs.WrapDBError(methodName, err)
+	}
+
+	return point, nil
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id.String()
+	}
+
+	return res
+}
@@ -6,55 +6,49 @@ import (
 	"2025_CakeLand_API/internal/pkg/cake"
 	"2025_CakeLand_API/internal/pkg/cake/dto"
 	ms "2025_CakeLand_API/internal/pkg/minio"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/profile/loader"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+	"2025_CakeLand_API/internal/pkg/utils/pagination"
 	"context"
+	"fmt"
 	"github.com/google/uuid"
-	"sync"
+	"github.com/guregu/null"
+	"time"
 )
 
 type CakeUseсase struct {
-	tokenator  *jwt.Tokenator
-	repo       cake.ICakeRepository
-	imageStore cake.IImageStorage
-	bucketName string
+	repo          cake.ICakeRepository
+	imageStore    cake.IImageStorage
+	bucketName    string
+	profileClient profileGen.ProfileServiceClient
 }
 
 func NewCakeUsecase(
-	tokenator *jwt.Tokenator,
 	repo cake.ICakeRepository,
 	imageStore cake.IImageStorage,
 	bucketName string,
+	profileClient profileGen.ProfileServiceClient,
 ) *CakeUseсase {
 	return &CakeUseсase{
-		tokenator:  tokenator,
-		repo:       repo,
-		imageStore: imageStore,
-		bucketName: bucketName,
+		repo:          repo,
+		imageStore:    imageStore,
+		bucketName:    bucketName,
+		profileClient: profileClient,
 	}
 }
 
 func (u *CakeUseсase) AddCakeColor(ctx context.Context, cakeID uuid.UUID, hexStrings []string) error {
-	wg := &sync.WaitGroup{}
-	for _, hexString := range hexStrings {
-		wg.Add(1)
-		hex := hexString
-		go func() {
-			defer wg.Done()
-			if ctx.Err() != nil {
-				return
-			}
-
-			_ = u.repo.AddCakeColor(ctx, models.CakeColor{
-				ID:        uuid.New(),
-				CakeID:    cakeID,
-				HexString: hex,
-			})
-		}()
-	}
-
-	wg.Wait()
+	colors := make([]models.CakeColor, len(hexStrings))
+	for i, hex := range hexStrings {
+		colors[i] = models.CakeColor{
+			ID:        uuid.New(),
+			CakeID:    cakeID,
+			HexString: hex,
+		}
+	}
 
-	return nil
+	return u.repo.AddCakeColors(ctx, cakeID, colors)
 }
 
 func (u *CakeUseсase) GetColors(ctx context.Context) ([]string, error) {
@@ -62,157 +56,13 @@ func (u *CakeUseсase) GetColors(ctx context.Context) ([]string, error) {
 }
 
 func (u *CakeUseсase) Cake(ctx context.Context, in dto.GetCakeReq) (*dto.GetCakeRes, error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	// Получаем информацию торта
-	res, err := u.repo.CakeByID(ctx, in)
-	if err != nil {
-		return nil, err
-	}
-	cakeInfo := res.Cake
-
-	wg := sync.WaitGroup{}
-	mu := sync.Mutex{}
-	errChan := make(chan error, 1)
-
-	wg.Add(3)
-
-	// Получаем категории торта
-	go func() {
-		defer wg.Done()
-		if ctx.Err() != nil {
-			return
-		}
-
-		ids, catIdsErr := u.repo.CakeCategoriesIDs(ctx, cakeInfo.ID)
-		if catIdsErr != nil {
-			trySendError(catIdsErr, errChan, cancel)
-			return
-		}
-
-		// Получаем информацию каждой категории
-		catMu := sync.Mutex{}
-		catWg := sync.WaitGroup{}
-
-		var cakeCategories []models.Category
-		for _, categoryID := range ids {
-			catWg.Add(1)
-			go func() {
-				defer catWg.Done()
-				if ctx.Err() != nil {
-					return
-				}
-
-				category, catErr := u.repo.CategoryByID(ctx, categoryID)
-				if catErr != nil {
-					trySendError(catErr, errChan, cancel)
-					return
-				}
-
-				catMu.Lock()
-				cakeCategories = append(cakeCategories, *category)
-				catMu.Unlock()
-			}()
-		}
-
-		catWg.Wait()
-		if ctx.Err() != nil {
-			return
-		}
-
-		mu.Lock()
-		cakeInfo.Categories = cakeCategories
-		mu.Unlock()
-	}()
-
-	// Получаем начинки торта
-	go func() {
-		defer wg.Done()
-		if ctx.Err() != nil {
-			return
-		}
-
-		ids, filIdsErr := u.repo.CakeFillingsIDs(ctx, cakeInfo.ID)
-		if filIdsErr != nil {
-			trySendError(filIdsErr, errChan, cancel)
-			return
-		}
-
-		// Получаем информацию каждой категории
-		filMu := sync.Mutex{}
-		filWg := sync.WaitGroup{}
-
-		var fillings []models.Filling
-		for _, fillingID := range ids {
-			filWg.Add(1)
-			go func() {
-				defer filWg.Done()
-				if ctx.Err() != nil {
-					return
-				}
-
-				filling, fillErr := u.repo.FillingByID(ctx, fillingID)
-				if fillErr != nil {
-					trySendError(fillErr, errChan, cancel)
-					return
-				}
-
-				filMu.Lock()
-				fillings = append(fillings, *filling)
-				filMu.Unlock()
-			}()
-		}
-
-		filWg.Wait()
-		if ctx.Err() != nil {
-			return
-		}
-
-		mu.Lock()
-		cakeInfo.Fillings = fillings
-		mu.Unlock()
-	}()
-
-	// Получаем фотографии торта
-	go func() {
-		defer wg.Done()
-		if ctx.Err() != nil {
-			return
-		}
-
-		images, imgErr := u.repo.CakeImages(ctx, cakeInfo.ID)
-		if imgErr != nil {
-			trySendError(imgErr, errChan, cancel)
-			return
-		}
-
-		if ctx.Err() != nil {
-			return
-		}
-		mu.Lock()
-		cakeInfo.Images = images
-		mu.Unlock()
-	}()
-
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
-
-	if err = <-errChan; err != nil {
-		return nil, err
-	}
-
-	return &dto.GetCakeRes{
-		Cake: cakeInfo,
-	}, nil
+	// Категории, начинки и фотографии приходят вместе с тортом одним запросом
+	return u.repo.CakeByID(ctx, in)
 }
 
 func (u *CakeUseсase) CreateCake(ctx context.Context, in dto.CreateCakeReq) (*dto.CreateCakeRes, error) {
-	// Достаём userID из токена если он не протух
-	userID, err := u.tokenator.GetUserIDFromToken(in.AccessToken, false)
-	if err != nil {
+	// В торт можно добавить только общие начинки и свои собственные
+	if err := u.checkUsableFillings(ctx, in.UserID, in.FillingIDs); err != nil {
 		return nil, err
 	}
 
@@ -241,7 +91,7 @@ func (u *CakeUseсase) CreateCake(ctx context.Context, in dto.CreateCakeReq) (*d
 
 	// Создаём торт в бд
 	cakeID := uuid.New()
-	if err = u.repo.CreateCake(ctx, in.ConvertToCreateCakeDBReq(cakeID.String(), previewImageURL, userID, res)); err != nil {
+	if err = u.repo.CreateCake(ctx, in.ConvertToCreateCakeDBReq(cakeID.String(), previewImageURL, in.UserID.String(), res)); err != nil {
 		return nil, err
 	}
 
@@ -251,10 +101,13 @@ func (u *CakeUseсase) CreateCake(ctx context.Context, in dto.CreateCakeReq) (*d
 }
 
 func (u *CakeUseсase) CreateFilling(ctx context.Context, in dto.CreateFillingReq) (*dto.CreateFillingRes, error) {
-	// Достаём userID из токена если он не протух
-	_, err := u.tokenator.GetUserIDFromToken(in.AccessToken, false)
-	if err != nil {
-		return nil, err
+	// Общие начинки заводят администраторы, остальные начинки принадлежат пользователю
+	ownerID := uuid.NullUUID{UUID: in.UserID, Valid: true}
+	if in.IsPlatform {
+		if err := u.checkAdmin(ctx); err != nil {
+			return nil, err
+		}
+		ownerID = uuid.NullUUID{}
 	}
 
 	fillingID := uuid.New()
@@ -271,6 +124,7 @@ func (u *CakeUseсase) CreateFilling(ctx context.Context, in dto.CreateFillingRe
 		Content:     in.Content,
 		KgPrice:     in.KgPrice,
 		Description: in.Description,
+		OwnerID:     ownerID,
 	}
 	err = u.repo.CreateFilling(ctx, filling)
 	if err != nil {
@@ -282,13 +136,58 @@ func (u *CakeUseсase) CreateFilling(ctx context.Context, in dto.CreateFillingRe
 	}, nil
 }
 
-func (u *CakeUseсase) CreateCategory(ctx context.Context, in *dto.CreateCategoryReq) (*dto.CreateCategoryRes, error) {
-	// Достаём userID из токена если он не протух
-	_, err := u.tokenator.GetUserIDFromToken(in.AccessToken, false)
+func (u *CakeUseсase) UpdateFilling(ctx context.Context, in dto.UpdateFillingReq) (*models.Filling, error) {
+	// Валидация
+	if (in.Name.Valid && in.Name.String == "") || (in.KgPrice.Valid && in.KgPrice.Float64 < 0) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	current, err := u.fillingForUpdate(ctx, in.UserID, in.FillingID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Загружаем новую картинку, старую удалим после обновления начинки
+	var imageURL null.String
+	if in.ImageData != nil {
+		newImageURL, err := u.imageStore.SaveImage(ctx, u.bucketName, ms.ImageID(uuid.New().String()), in.ImageData)
+		if err != nil {
+			return nil, err
+		}
+		imageURL = null.StringFrom(newImageURL)
+	}
+
+	filling, err := u.repo.UpdateFilling(ctx, in.ConvertToUpdateFillingDBReq(imageURL))
+	if err != nil {
+		// Новая картинка так и не попала в бд
+		if imageURL.Valid {
+			u.deleteImages(ctx, imageURL.String)
+		}
+		return nil, err
+	}
+
+	if imageURL.Valid {
+		u.deleteImages(ctx, current.ImageURL)
+	}
+
+	return filling, nil
+}
+
+// DeleteFilling Мягкое удаление: начинка остаётся в заказах, поэтому картинка не удаляется
+func (u *CakeUseсase) DeleteFilling(ctx context.Context, userID, fillingID uuid.UUID) error {
+	if _, err := u.fillingForUpdate(ctx, userID, fillingID); err != nil {
+		return err
+	}
+
+	return u.repo.DeleteFilling(ctx, fillingID)
+}
+
+func (u *CakeUseсase) CreateCategory(ctx context.Context, in *dto.CreateCategoryReq) (*dto.CreateCategoryRes, error) {
+	// Категории заводят только администраторы
+	if err := u.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
 	categoryUUID := uuid.New()
 	imageURL, err := u.imageStore.SaveImage(ctx, u.bucketName, ms.ImageID(categoryUUID.String()), in.ImageData)
 	if err != nil {
@@ -296,11 +195,13 @@ func (u *CakeUseсase) CreateCategory(ctx context.Context, in *dto.CreateCategor
 	}
 
 	newCategory := models.Category{
-		ID:       categoryUUID,
-		Name:     in.Name,
-		ImageURL: imageURL,
+		ID:              categoryUUID,
+		Name:            in.Name,
+		ImageURL:        imageURL,
+		CategoryGenders: in.GenderTags,
 	}
 	if err = u.repo.CreateCategory(ctx, &newCategory); err != nil {
+		u.deleteImages(ctx, imageURL)
 		return nil, err
 	}
 
@@ -309,83 +210,146 @@ func (u *CakeUseсase) CreateCategory(ctx context.Context, in *dto.CreateCategor
 	}, nil
 }
 
-func (u *CakeUseсase) Categories(ctx context.Context) (*[]models.Category, error) {
-	return u.repo.Categories(ctx)
-}
+func (u *CakeUseсase) UpdateCategory(ctx context.Context, in dto.UpdateCategoryReq) (*models.Category, error) {
+	// Валидация
+	if in.Name.Valid && in.Name.String == "" {
+		return nil, errs.ErrInvalidInput
+	}
 
-func (u *CakeUseсase) Fillings(ctx context.Context) (*[]models.Filling, error) {
-	return u.repo.Fillings(ctx)
-}
+	if err := u.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
 
-func (u *CakeUseсase) GetCakesPreview(ctx context.Context) ([]dto.PreviewCake, error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	current, err := u.repo.CategoryByID(ctx, in.CategoryID)
+	if err != nil {
+		return nil, err
+	}
 
-	// Получение тортов
-	cakes, err := u.repo.GetCakesPreview(ctx)
+	// Загружаем новую фотографию, старую удалим после обновления категории
+	var imageURL null.String
+	if in.ImageData != nil {
+		newImageURL, err := u.imageStore.SaveImage(ctx, u.bucketName, ms.ImageID(uuid.New().String()), in.ImageData)
+		if err != nil {
+			return nil, err
+		}
+		imageURL = null.StringFrom(newImageURL)
+	}
+
+	category, err := u.repo.UpdateCategory(ctx, in.ConvertToUpdateCategoryDBReq(imageURL))
 	if err != nil {
+		// Новая фотография так и не попала в бд
+		if imageURL.Valid {
+			u.deleteImages(ctx, imageURL.String)
+		}
 		return nil, err
 	}
 
-	wg := sync.WaitGroup{}
-	mu := sync.Mutex{}
-	errChan := make(chan error, 1)
+	if imageURL.Valid {
+		u.deleteImages(ctx, current.ImageURL)
+	}
 
-	for i, cakeInfo := range cakes {
-		wg.Add(2)
+	return category, nil
+}
+
+// DeleteCategory Удаляет категорию: торты остаются, но теряют её
+func (u *CakeUseсase) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+	if err := u.checkAdmin(ctx); err != nil {
+		return err
+	}
+
+	imageURL, err := u.repo.DeleteCategory(ctx, categoryID)
+	if err != nil {
+		return err
+	}
 
-		// Получаем данные продавца
-		go func(i int) {
-			defer wg.Done()
-			if ctx.Err() != nil {
-				return
-			}
+	u.deleteImages(ctx, imageURL)
+	return nil
+}
 
-			user, userErr := u.repo.GetUserByID(ctx, cakeInfo.Owner.ID)
-			if userErr != nil {
-				trySendError(userErr, errChan, cancel)
-				return
-			}
+func (u *CakeUseсase) Categories(ctx context.Context, page pagination.Page) ([]models.Category, string, error) {
+	categories, next, err := u.repo.Categories(ctx, page)
+	if err != nil {
+		return nil, "", err
+	}
 
-			mu.Lock()
-			cakes[i].Owner = user
-			mu.Unlock()
-		}(i)
+	return categories, pagination.NextPageToken(next), nil
+}
+
+// Fillings Общие начинки и, если пользователь авторизован, его собственные
+func (u *CakeUseсase) Fillings(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) ([]models.Filling, string, error) {
+	fillings, next, err := u.repo.Fillings(ctx, viewerID, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return fillings, pagination.NextPageToken(next), nil
+}
 
-		// Получаем цвета тортов
-		go func(i int) {
-			defer wg.Done()
-			if ctx.Err() != nil {
-				return
-			}
+// GetCakesPreview Каталог тортов, отсортированный по байесовскому рейтингу
+func (u *CakeUseсase) GetCakesPreview(ctx context.Context, viewerID uuid.NullUUID, page pagination.Page) (*dto.SearchCakesRes, error) {
+	return u.SearchCakes(ctx, dto.SearchCakesReq{
+		Sort:     dto.CakeSortRating,
+		Page:     page,
+		ViewerID: viewerID,
+	})
+}
 
-			colors, colorsErr := u.repo.GetCakeColorsByCakeID(ctx, cakeInfo.ID)
-			if colorsErr != nil {
-				trySendError(colorsErr, errChan, cancel)
-				return
-			}
+func (u *CakeUseсase) SearchCakes(ctx context.Context, in dto.SearchCakesReq) (*dto.SearchCakesRes, error) {
+	// Адрес доставки: оставляем продавцов, в зону доставки которых он попадает
+	if in.DeliveryAddressID.Valid {
+		if !in.ViewerID.Valid {
+			return nil, errs.ErrNoToken
+		}
 
-			colorsHex := make([]string, len(colors))
-			for ind, color := range colors {
-				colorsHex[ind] = color.HexString
-			}
+		point, err := u.repo.AddressLocation(ctx, in.DeliveryAddressID.UUID, in.ViewerID.UUID)
+		if err != nil {
+			return nil, err
+		}
+		in.DeliverTo = &point
+	}
 
-			mu.Lock()
-			cakes[i].ColorsHex = colorsHex
-			mu.Unlock()
-		}(i)
+	// Расстояние считаем от адреса доставки, если точка не задана явно
+	if in.Sort == dto.CakeSortDistance && in.Near == nil {
+		if in.DeliverTo == nil {
+			return nil, errs.ErrInvalidInput
+		}
+		in.Near = in.DeliverTo
 	}
 
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
+	cakes, next, err := u.repo.SearchCakes(ctx, in)
+	if err != nil {
+		return nil, err
+	}
 
-	if err = <-errChan; err != nil {
+	if err = u.fillPreviewCakes(ctx, cakes); err != nil {
 		return nil, err
 	}
 
-	return cakes, nil
+	return &dto.SearchCakesRes{
+		Cakes:         cakes,
+		NextPageToken: pagination.NextPageToken(next),
+	}, nil
+}
+
+// fillPreviewCakes Дополняет превью тортов данными продавцов (цвета приходят из репозитория вместе с тортами)
+func (u *CakeUseсase) fillPreviewCakes(ctx context.Context, cakes []dto.PreviewCake) error {
+	ownerIDs := make([]string, len(cakes))
+	for i, cakeInfo := range cakes {
+		ownerIDs[i] = cakeInfo.Owner.ID.String()
+	}
+
+	owners, err := loader.FromContext(ctx, u.profileClient).Load(ctx, ownerIDs)
+	if err != nil {
+		return err
+	}
+
+	for i, cakeInfo := range cakes {
+		if owner, ok := owners[cakeInfo.Owner.ID.String()]; ok {
+			cakes[i].Owner = dto.NewOwner(owner)
+		}
+	}
+
+	return nil
 }
 
 func (u *CakeUseсase) CategoryIDsByGenderName(ctx context.Context, genTag models.CategoryGender) ([]models.Category, error) {
@@ -401,63 +365,529 @@ func (u *CakeUseсase) CategoryIDsByGenderName(ctx context.Context, genTag model
 	return categories, nil
 }
 
-func (u *CakeUseсase) CategoryPreviewCakes(ctx context.Context, categoryID uuid.UUID) ([]*dto.PreviewCake, error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+func (u *CakeUseсase) CategoryPreviewCakes(ctx context.Context, viewerID uuid.NullUUID, categoryID uuid.UUID, page pagination.Page) (*dto.SearchCakesRes, error) {
+	return u.SearchCakes(ctx, dto.SearchCakesReq{
+		CategoryIDs: []uuid.UUID{categoryID},
+		Sort:        dto.CakeSortRating,
+		Page:        page,
+		ViewerID:    viewerID,
+	})
+}
+
+func (u *CakeUseсase) UpdateCake(ctx context.Context, in dto.UpdateCakeReq) (*dto.GetCakeRes, error) {
+	// Валидация
+	if (in.Name.Valid && in.Name.String == "") ||
+		(in.KgPrice.Valid && in.KgPrice.Float64 <= 0) ||
+		(in.Mass.Valid && in.Mass.Float64 <= 0) ||
+		(in.DiscountedKgPrice.Valid && in.DiscountedKgPrice.Float64 <= 0) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	if err := u.checkCakeOwner(ctx, in.UserID, in.CakeID); err != nil {
+		return nil, err
+	}
+
+	// Загружаем новое превью, старое удалим после обновления торта
+	var previewImageURL null.String
+	var oldPreviewImageURL string
+	if in.PreviewImageData != nil {
+		oldPreview, err := u.repo.PreviewCakeByID(ctx, in.CakeID)
+		if err != nil {
+			return nil, err
+		}
+		oldPreviewImageURL = oldPreview.PreviewImageURL
+
+		imageURL, err := u.imageStore.SaveImage(ctx, u.bucketName, ms.ImageID(uuid.New().String()), in.PreviewImageData)
+		if err != nil {
+			return nil, err
+		}
+		previewImageURL = null.StringFrom(imageURL)
+	}
+
+	if err := u.repo.UpdateCake(ctx, in.ConvertToUpdateCakeDBReq(previewImageURL)); err != nil {
+		// Новое превью так и не попало в бд
+		if previewImageURL.Valid {
+			u.deleteImages(ctx, previewImageURL.String)
+		}
+		return nil, err
+	}
+
+	if previewImageURL.Valid {
+		u.deleteImages(ctx, oldPreviewImageURL)
+	}
+
+	return u.Cake(ctx, dto.GetCakeReq{
+		CakeID: in.CakeID,
+	})
+}
+
+func (u *CakeUseсase) SetCakeSaleStatus(ctx context.Context, userID, cakeID uuid.UUID, isOpenForSale bool) error {
+	if err := u.checkCakeOwner(ctx, userID, cakeID); err != nil {
+		return err
+	}
+
+	return u.repo.SetCakeSaleStatus(ctx, cakeID, isOpenForSale)
+}
+
+// DeleteCake Мягкое удаление: торт пропадает из каталога, но остаётся в заказах и отзывах, поэтому фотографии не удаляются
+func (u *CakeUseсase) DeleteCake(ctx context.Context, userID, cakeID uuid.UUID) error {
+	if err := u.checkCakeOwner(ctx, userID, cakeID); err != nil {
+		return err
+	}
+
+	return u.repo.DeleteCake(ctx, cakeID)
+}
+
+func (u *CakeUseсase) AddCakeImages(ctx context.Context, userID, cakeID uuid.UUID, images [][]byte) ([]models.CakeImage, error) {
+	if len(images) == 0 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	if err := u.checkCakeOwner(ctx, userID, cakeID); err != nil {
+		return nil, err
+	}
+
+	// Добавляем изображения в хранилище
+	imagesData := make(map[ms.ImageID][]byte, len(images))
+	imageIDs := make([]uuid.UUID, len(images))
+	for i, imageData := range images {
+		imageIDs[i] = uuid.New()
+		imagesData[ms.ImageID(imageIDs[i].String())] = imageData
+	}
+
+	urls, err := u.imageStore.SaveImages(ctx, u.bucketName, imagesData)
+	if err != nil {
+		return nil, err
+	}
+
+	cakeImages := make([]models.CakeImage, len(imageIDs))
+	for i, imageID := range imageIDs {
+		cakeImages[i] = models.CakeImage{
+			ID:       imageID,
+			ImageURL: null.StringFrom(urls[ms.ImageID(imageID.String())]),
+		}
+	}
+
+	if err = u.repo.AddCakeImages(ctx, cakeID, cakeImages); err != nil {
+		// Загруженные изображения так и не попали в бд
+		imageURLs := make([]string, 0, len(urls))
+		for _, imageURL := range urls {
+			imageURLs = append(imageURLs, imageURL)
+		}
+		u.deleteImages(ctx, imageURLs...)
+		return nil, err
+	}
+
+	return u.repo.CakeImages(ctx, cakeID)
+}
+
+func (u *CakeUseсase) RemoveCakeImage(ctx context.Context, userID, cakeID, imageID uuid.UUID) ([]models.CakeImage, error) {
+	if err := u.checkCakeOwner(ctx, userID, cakeID); err != nil {
+		return nil, err
+	}
+
+	image, err := u.repo.DeleteCakeImage(ctx, cakeID, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if image.ImageURL.Valid {
+		u.deleteImages(ctx, image.ImageURL.String)
+	}
+
+	return u.repo.CakeImages(ctx, cakeID)
+}
+
+func (u *CakeUseсase) ReorderCakeImages(ctx context.Context, userID, cakeID uuid.UUID, imageIDs []uuid.UUID) ([]models.CakeImage, error) {
+	if err := u.checkCakeOwner(ctx, userID, cakeID); err != nil {
+		return nil, err
+	}
+
+	// Новый порядок должен содержать каждую фотографию торта ровно один раз
+	images, err := u.repo.CakeImages(ctx, cakeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(images) != len(imageIDs) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	remaining := make(map[uuid.UUID]struct{}, len(images))
+	for _, image := range images {
+		remaining[image.ID] = struct{}{}
+	}
+
+	for _, imageID := range imageIDs {
+		if _, ok := remaining[imageID]; !ok {
+			return nil, errs.ErrInvalidInput
+		}
+		delete(remaining, imageID)
+	}
+
+	if err = u.repo.ReorderCakeImages(ctx, cakeID, imageIDs); err != nil {
+		return nil, err
+	}
+
+	return u.repo.CakeImages(ctx, cakeID)
+}
+
+func (u *CakeUseсase) AddFavorite(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, targetID uuid.UUID) error {
+	if target == dto.FavoriteTargetSeller {
+		// Добавить в избранное самого себя нельзя
+		if targetID == userID {
+			return errs.ErrInvalidInput
+		}
+		return u.repo.AddFavoriteSeller(ctx, userID, targetID)
+	}
+
+	return u.repo.AddFavoriteCake(ctx, userID, targetID)
+}
+
+func (u *CakeUseсase) RemoveFavorite(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, targetID uuid.UUID) error {
+	if target == dto.FavoriteTargetSeller {
+		return u.repo.RemoveFavoriteSeller(ctx, userID, targetID)
+	}
+
+	return u.repo.RemoveFavoriteCake(ctx, userID, targetID)
+}
+
+func (u *CakeUseсase) Favorites(ctx context.Context, userID uuid.UUID, target dto.FavoriteTarget, page pagination.Page) (*dto.FavoritesRes, error) {
+	if target == dto.FavoriteTargetSeller {
+		return u.favoriteSellers(ctx, userID, page)
+	}
+
+	// Избранные торты — это каталог, ограниченный избранным и отсортированный по дате добавления
+	res, err := u.SearchCakes(ctx, dto.SearchCakesReq{
+		Sort:          dto.CakeSortFavorited,
+		Page:          page,
+		ViewerID:      uuid.NullUUID{UUID: userID, Valid: true},
+		OnlyFavorites: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.FavoritesRes{
+		Cakes:         res.Cakes,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func (u *CakeUseсase) favoriteSellers(ctx context.Context, userID uuid.UUID, page pagination.Page) (*dto.FavoritesRes, error) {
+	sellerIDs, next, err := u.repo.FavoriteSellers(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(sellerIDs))
+	for i, id := range sellerIDs {
+		ids[i] = id.String()
+	}
+
+	profiles, err := loader.FromContext(ctx, u.profileClient).Load(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем порядок добавления, пропуская пользователей, которых уже нет
+	sellers := make([]dto.Owner, 0, len(ids))
+	for _, id := range ids {
+		if profile, ok := profiles[id]; ok {
+			sellers = append(sellers, dto.NewOwner(profile))
+		}
+	}
+
+	return &dto.FavoritesRes{
+		Sellers:       sellers,
+		NextPageToken: pagination.NextPageToken(next),
+	}, nil
+}
+
+func (u *CakeUseсase) CreatePromotion(ctx context.Context, in dto.CreatePromotionReq) (*models.Promotion, error) {
+	// Валидация
+	if err := in.Discount.Validate(); err != nil {
+		return nil, err
+	}
+	if !in.EndsAt.After(in.StartsAt) || !in.EndsAt.After(time.Now()) || (in.CakeID.Valid && in.CategoryID.Valid) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	userID := in.UserID
+
+	// Акцию на торт может завести только его владелец
+	if in.CakeID.Valid {
+		ownerID, err := u.repo.CakeOwnerID(ctx, in.CakeID.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if ownerID != userID {
+			return nil, errs.ErrPermissionDenied
+		}
+	}
+
+	promotion := models.Promotion{
+		ID:         uuid.New(),
+		SellerID:   userID,
+		CakeID:     in.CakeID,
+		CategoryID: in.CategoryID,
+		Discount:   in.Discount,
+		StartsAt:   in.StartsAt,
+		EndsAt:     in.EndsAt,
+	}
+	if err := u.repo.CreatePromotion(ctx, &promotion); err != nil {
+		return nil, err
+	}
+
+	return &promotion, nil
+}
+
+func (u *CakeUseсase) CancelPromotion(ctx context.Context, userID, promotionID uuid.UUID) error {
+	return u.repo.CancelPromotion(ctx, userID, promotionID)
+}
+
+// RefreshEffectivePrices Пересчитывает цены тортов, у которых по времени началась или закончилась скидка.
+// Вызывается по расписанию: изменения самих тортов и акций пересчитываются триггерами сразу
+func (u *CakeUseсase) RefreshEffectivePrices(ctx context.Context) error {
+	return u.repo.RefreshEffectivePrices(ctx)
+}
+
+func (u *CakeUseсase) Promotions(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]models.Promotion, string, error) {
+	promotions, next, err := u.repo.Promotions(ctx, userID, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return promotions, pagination.NextPageToken(next), nil
+}
+
+func (u *CakeUseсase) CreatePromoCode(ctx context.Context, in dto.CreatePromoCodeReq) (*models.PromoCode, error) {
+	// Валидация
+	if err := models.ValidatePromoCode(in.Code); err != nil {
+		return nil, err
+	}
+	if err := in.Discount.Validate(); err != nil {
+		return nil, err
+	}
+	if !in.ExpiresAt.After(in.StartsAt) || !in.ExpiresAt.After(time.Now()) ||
+		in.MaxUsesPerUser <= 0 || (in.MaxUses.Valid && in.MaxUses.Int64 <= 0) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	userID := in.UserID
+
+	promoCode := models.PromoCode{
+		ID:             uuid.New(),
+		Code:           in.Code,
+		SellerID:       userID,
+		Discount:       in.Discount,
+		StartsAt:       in.StartsAt,
+		ExpiresAt:      in.ExpiresAt,
+		MaxUses:        in.MaxUses,
+		MaxUsesPerUser: in.MaxUsesPerUser,
+	}
+	if err := u.repo.CreatePromoCode(ctx, &promoCode); err != nil {
+		return nil, err
+	}
+
+	return &promoCode, nil
+}
+
+func (u *CakeUseсase) CancelPromoCode(ctx context.Context, userID, promoCodeID uuid.UUID) error {
+	return u.repo.CancelPromoCode(ctx, userID, promoCodeID)
+}
 
-	// Получаем id тортов категории
-	cakeIDs, err := u.repo.CategoryCakesIDs(ctx, categoryID)
+func (u *CakeUseсase) PromoCodes(ctx context.Context, userID uuid.UUID, page pagination.Page) ([]models.PromoCode, string, error) {
+	promoCodes, next, err := u.repo.PromoCodes(ctx, userID, page)
 	if err != nil {
+		return nil, "", err
+	}
+
+	return promoCodes, pagination.NextPageToken(next), nil
+}
+
+func (u *CakeUseсase) CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error) {
+	return u.repo.CakeOptions(ctx, cakeID)
+}
+
+func (u *CakeUseсase) CreateCakeOption(
+	ctx context.Context,
+	userID uuid.UUID,
+	cakeID uuid.UUID,
+	option models.CakeOption,
+) (*models.CakeOption, error) {
+	// Валидация
+	if err := option.Validate(); err != nil {
 		return nil, err
 	}
 
-	// Получаем данные тортов по id
-	previewCakes := make([]*dto.PreviewCake, len(cakeIDs))
-	mu := &sync.Mutex{}
-	wg := &sync.WaitGroup{}
-	errCh := make(chan error, 1)
-	for i, cakeID := range cakeIDs {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if ctx.Err() != nil {
-				return
-			}
+	if err := u.checkCakeOwner(ctx, userID, cakeID); err != nil {
+		return nil, err
+	}
 
-			previewCake, prevErr := u.repo.PreviewCakeByID(ctx, cakeID)
-			if prevErr != nil {
-				trySendError(prevErr, errCh, cancel)
-				return
-			}
+	// У новой группы все значения новые
+	option.ID = uuid.New()
+	option.CakeID = cakeID
+	for i := range option.Values {
+		if option.Values[i].ID != uuid.Nil {
+			return nil, errs.ErrInvalidInput
+		}
+		option.Values[i].ID = uuid.New()
+	}
 
-			if ctx.Err() != nil {
-				return
-			}
+	// Лимит групп проверяет репозиторий под блокировкой торта
+	if err := u.repo.CreateCakeOption(ctx, &option); err != nil {
+		return nil, err
+	}
+
+	return &option, nil
+}
 
-			mu.Lock()
-			previewCakes[i] = previewCake
-			mu.Unlock()
-		}()
+// UpdateCakeOption Заменяет группу опций целиком. Значения с ID должны принадлежать этой группе
+func (u *CakeUseсase) UpdateCakeOption(
+	ctx context.Context,
+	userID uuid.UUID,
+	optionID uuid.UUID,
+	option models.CakeOption,
+) (*models.CakeOption, error) {
+	// Валидация
+	if err := option.Validate(); err != nil {
+		return nil, err
 	}
 
-	go func() {
-		wg.Wait()
-		close(errCh)
-	}()
+	current, err := u.repo.CakeOptionByID(ctx, optionID)
+	if err != nil {
+		return nil, err
+	}
+	if err = u.checkCakeOwner(ctx, userID, current.CakeID); err != nil {
+		return nil, err
+	}
 
-	if err = <-errCh; err != nil {
+	existing := make(map[uuid.UUID]struct{}, len(current.Values))
+	for _, value := range current.Values {
+		existing[value.ID] = struct{}{}
+	}
+
+	option.ID = current.ID
+	option.CakeID = current.CakeID
+	for i := range option.Values {
+		if option.Values[i].ID == uuid.Nil {
+			option.Values[i].ID = uuid.New()
+			continue
+		}
+		if _, ok := existing[option.Values[i].ID]; !ok {
+			return nil, errs.ErrInvalidInput
+		}
+	}
+
+	if err = u.repo.UpdateCakeOption(ctx, &option); err != nil {
 		return nil, err
 	}
 
-	return previewCakes, nil
+	return &option, nil
 }
 
-// trySendError Вспомогательная функция для безопасной отправки ошибки
-func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
-	select {
-	case errCh <- err:
-		cancel()
-	default:
-		// Если ошибка уже есть - игнорируем (сохраняем первую)
+func (u *CakeUseсase) DeleteCakeOption(ctx context.Context, userID, optionID uuid.UUID) error {
+	option, err := u.repo.CakeOptionByID(ctx, optionID)
+	if err != nil {
+		return err
+	}
+	if err = u.checkCakeOwner(ctx, userID, option.CakeID); err != nil {
+		return err
 	}
+
+	return u.repo.DeleteCakeOption(ctx, optionID)
+}
+
+// checkAdmin Проверяет роль администратора у пользователя запроса
+func (u *CakeUseсase) checkAdmin(ctx context.Context) error {
+	if !authz.HasAnyRole(ctx, models.RoleAdmin) {
+		return errs.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// fillingForUpdate Возвращает начинку, если пользователь может её менять: свою — владелец, общую — администратор
+func (u *CakeUseсase) fillingForUpdate(ctx context.Context, userID, fillingID uuid.UUID) (*models.Filling, error) {
+	filling, err := u.repo.FillingByID(ctx, fillingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if filling.IsPlatform() {
+		if err = u.checkAdmin(ctx); err != nil {
+			return nil, err
+		}
+		return filling, nil
+	}
+
+	if filling.OwnerID.UUID != userID {
+		return nil, errs.ErrPermissionDenied
+	}
+
+	return filling, nil
+}
+
+// checkUsableFillings Проверяет, что все начинки существуют и доступны пользователю
+func (u *CakeUseсase) checkUsableFillings(ctx context.Context, ownerID uuid.UUID, fillingIDs []string) error {
+	if len(fillingIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(fillingIDs))
+	seen := make(map[uuid.UUID]struct{}, len(fillingIDs))
+	for _, fillingID := range fillingIDs {
+		id, err := uuid.Parse(fillingID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	count, err := u.repo.CountUsableFillings(ctx, ownerID, ids)
+	if err != nil {
+		return err
+	}
+	if count != len(ids) {
+		return errs.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// checkCakeOwner Проверяет, что торт существует и принадлежит пользователю
+func (u *CakeUseсase) checkCakeOwner(ctx context.Context, userID, cakeID uuid.UUID) error {
+	ownerID, err := u.repo.CakeOwnerID(ctx, cakeID)
+	if err != nil {
+		return err
+	}
+
+	if ownerID != userID {
+		return errs.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// deleteImages Удаляет изображения из хранилища. Изменения в бд к этому моменту уже применены,
+// поэтому ошибка хранилища не прерывает запрос: в худшем случае в бакете останется лишний объект
+func (u *CakeUseсase) deleteImages(ctx context.Context, imageURLs ...string) {
+	objectNames := make([]ms.ImageID, 0, len(imageURLs))
+	for _, imageURL := range imageURLs {
+		// Картинки вне нашего бакета (например, из сидов) не трогаем
+		if objectName, ok := ms.ImageIDFromURL(imageURL, u.bucketName); ok {
+			objectNames = append(objectNames, objectName)
+		}
+	}
+
+	if len(objectNames) == 0 {
+		return
+	}
+
+	_ = u.imageStore.DeleteImages(context.WithoutCancel(ctx), u.bucketName, objectNames)
 }
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/dto"
+	"2025_CakeLand_API/internal/pkg/cake/mocks"
+	ms "2025_CakeLand_API/internal/pkg/minio"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testBucket = "cakes"
+
+func newTestUsecase(t *testing.T) (*CakeUseсase, *mocks.MockICakeRepository, *mocks.MockIImageStorage) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockICakeRepository(ctrl)
+	mockImages := mocks.NewMockIImageStorage(ctrl)
+
+	return NewCakeUsecase(mockRepo, mockImages, testBucket, nil), mockRepo, mockImages
+}
+
+func contextWithRoles(roles ...models.Role) context.Context {
+	return authz.WithPrincipal(context.Background(), authz.Principal{UserID: uuid.New(), Roles: roles})
+}
+
+func TestCakeUsecase_UpdateCategory(t *testing.T) {
+	adminCtx := contextWithRoles(models.RoleAdmin)
+	categoryID := uuid.New()
+	current := &models.Category{
+		ID:       categoryID,
+		Name:     "Свадебные",
+		ImageURL: "http://minio/" + testBucket + "/old-image",
+	}
+
+	t.Run("Role denied", func(t *testing.T) {
+		uc, _, _ := newTestUsecase(t)
+
+		_, err := uc.UpdateCategory(contextWithRoles(models.DefaultRoles...), dto.UpdateCategoryReq{
+			CategoryID: categoryID,
+			Name:       null.StringFrom("Детские"),
+		})
+		assert.ErrorIs(t, err, errs.ErrPermissionDenied)
+
+		_, err = uc.UpdateCategory(context.Background(), dto.UpdateCategoryReq{CategoryID: categoryID})
+		assert.ErrorIs(t, err, errs.ErrPermissionDenied)
+	})
+
+	t.Run("Category not found", func(t *testing.T) {
+		uc, mockRepo, _ := newTestUsecase(t)
+
+		mockRepo.EXPECT().CategoryByID(gomock.Any(), categoryID).Return(nil, errs.ErrNotFound)
+
+		_, err := uc.UpdateCategory(adminCtx, dto.UpdateCategoryReq{
+			CategoryID: categoryID,
+			Name:       null.StringFrom("Детские"),
+		})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("Deleted during update", func(t *testing.T) {
+		uc, mockRepo, _ := newTestUsecase(t)
+
+		mockRepo.EXPECT().CategoryByID(gomock.Any(), categoryID).Return(current, nil)
+		mockRepo.EXPECT().UpdateCategory(gomock.Any(), gomock.Any()).Return(nil, errs.ErrNotFound)
+
+		_, err := uc.UpdateCategory(adminCtx, dto.UpdateCategoryReq{
+			CategoryID: categoryID,
+			Name:       null.StringFrom("Детские"),
+		})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("Duplicate name removes the uploaded image", func(t *testing.T) {
+		uc, mockRepo, mockImages := newTestUsecase(t)
+		var uploaded ms.ImageID
+
+		mockRepo.EXPECT().CategoryByID(gomock.Any(), categoryID).Return(current, nil)
+		mockImages.EXPECT().
+			SaveImage(gomock.Any(), testBucket, gomock.Any(), []byte("image")).
+			DoAndReturn(func(_ context.Context, _ string, objectName ms.ImageID, _ []byte) (string, error) {
+				uploaded = objectName
+				return "http://minio/" + testBucket + "/" + string(objectName), nil
+			})
+		mockRepo.EXPECT().
+			UpdateCategory(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in dto.UpdateCategoryDBReq) (*models.Category, error) {
+				assert.Equal(t, null.StringFrom("Детские"), in.Name)
+				return nil, errs.ErrAlreadyExists
+			})
+		mockImages.EXPECT().
+			DeleteImages(gomock.Any(), testBucket, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, objectNames []ms.ImageID) error {
+				assert.Equal(t, []ms.ImageID{uploaded}, objectNames)
+				return nil
+			})
+
+		_, err := uc.UpdateCategory(adminCtx, dto.UpdateCategoryReq{
+			CategoryID: categoryID,
+			Name:       null.StringFrom("Детские"),
+			ImageData:  []byte("image"),
+		})
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+
+	t.Run("Empty name", func(t *testing.T) {
+		uc, _, _ := newTestUsecase(t)
+
+		_, err := uc.UpdateCategory(adminCtx, dto.UpdateCategoryReq{
+			CategoryID: categoryID,
+			Name:       null.StringFrom(""),
+		})
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
+
+func TestCakeUsecase_CategoryRoles(t *testing.T) {
+	sellerCtx := contextWithRoles(models.DefaultRoles...)
+
+	t.Run("Create", func(t *testing.T) {
+		uc, _, _ := newTestUsecase(t)
+
+		_, err := uc.CreateCategory(sellerCtx, &dto.CreateCategoryReq{Name: "Детские", ImageData: []byte("image")})
+		assert.ErrorIs(t, err, errs.ErrPermissionDenied)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		uc, _, _ := newTestUsecase(t)
+
+		assert.ErrorIs(t, uc.DeleteCategory(sellerCtx, uuid.New()), errs.ErrPermissionDenied)
+	})
+}
+
+func TestCakeUsecase_CreateCakeOption(t *testing.T) {
+	userID, cakeID := uuid.New(), uuid.New()
+	ctx := context.Background()
+	tiers := func() models.CakeOption {
+		return models.CakeOption{
+			Name: "Ярусы",
+			Kind: models.CakeOptionKindSingle,
+			Values: []models.CakeOptionValue{
+				{Name: "Один"},
+				{Name: "Два", PriceModifier: 500},
+			},
+		}
+	}
+
+	t.Run("Created", func(t *testing.T) {
+		uc, mockRepo, _ := newTestUsecase(t)
+
+		mockRepo.EXPECT().CakeOwnerID(gomock.Any(), cakeID).Return(userID, nil)
+		mockRepo.EXPECT().CreateCakeOption(gomock.Any(), gomock.Any()).Return(nil)
+
+		option, err := uc.CreateCakeOption(ctx, userID, cakeID, tiers())
+		assert.NoError(t, err)
+		assert.Equal(t, cakeID, option.CakeID)
+		assert.NotEqual(t, uuid.Nil, option.ID)
+		for _, value := range option.Values {
+			assert.NotEqual(t, uuid.Nil, value.ID)
+		}
+	})
+
+	invalid := []struct {
+		name   string
+		modify func(*models.CakeOption)
+	}{
+		{name: "Empty name", modify: func(o *models.CakeOption) { o.Name = "" }},
+		{name: "Without values", modify: func(o *models.CakeOption) { o.Values = nil }},
+		{name: "Negative price", modify: func(o *models.CakeOption) { o.Values[0].PriceModifier = -1 }},
+		{name: "Text price on a single option", modify: func(o *models.CakeOption) { o.PriceModifier = 100 }},
+		{name: "Text option with values", modify: func(o *models.CakeOption) { o.Kind = models.CakeOptionKindText }},
+		{name: "Text limit too large", modify: func(o *models.CakeOption) {
+			o.Kind, o.Values = models.CakeOptionKindText, nil
+			o.MaxTextLength = null.IntFrom(models.MaxCakeOptionTextLength + 1)
+		}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, _ := newTestUsecase(t)
+			option := tiers()
+			tt.modify(&option)
+
+			_, err := uc.CreateCakeOption(ctx, userID, cakeID, option)
+			assert.ErrorIs(t, err, errs.ErrInvalidInput)
+		})
+	}
+
+	t.Run("Value with ID", func(t *testing.T) {
+		uc, mockRepo, _ := newTestUsecase(t)
+		option := tiers()
+		option.Values[0].ID = uuid.New()
+
+		mockRepo.EXPECT().CakeOwnerID(gomock.Any(), cakeID).Return(userID, nil)
+
+		_, err := uc.CreateCakeOption(ctx, userID, cakeID, option)
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("Too many options", func(t *testing.T) {
+		uc, mockRepo, _ := newTestUsecase(t)
+
+		mockRepo.EXPECT().CakeOwnerID(gomock.Any(), cakeID).Return(userID, nil)
+		mockRepo.EXPECT().
+			CreateCakeOption(gomock.Any(), gomock.Any()).
+			Return(fmt.Errorf("%w: cake already has %d options", errs.ErrInvalidInput, models.MaxCakeOptions))
+
+		_, err := uc.CreateCakeOption(ctx, userID, cakeID, tiers())
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+
+	t.Run("Not the owner", func(t *testing.T) {
+		uc, mockRepo, _ := newTestUsecase(t)
+
+		mockRepo.EXPECT().CakeOwnerID(gomock.Any(), cakeID).Return(uuid.New(), nil)
+
+		_, err := uc.CreateCakeOption(ctx, userID, cakeID, tiers())
+		assert.ErrorIs(t, err, errs.ErrPermissionDenied)
+	})
+}
@@ -1,14 +1,15 @@
 package grpc
 
 import (
-	"2025_CakeLand_API/internal/domains"
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/cake/delivery/grpc/generated"
+	cakeDto "2025_CakeLand_API/internal/pkg/cake/dto"
 	gen "2025_CakeLand_API/internal/pkg/chat/delivery/grpc/generated"
 	"2025_CakeLand_API/internal/pkg/chat/repo"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/profile/loader"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
@@ -22,42 +23,33 @@ import (
 
 type ChatProvider struct {
 	gen.UnimplementedChatServiceServer
-	clients    map[string]gen.ChatService_ChatServer
-	mdProvider *md.MetadataProvider
-	tokenator  *jwt.Tokenator
-	log        *slog.Logger
-	mu         sync.Mutex
-	repo       repo.IChatRepository
+	clients       map[string]gen.ChatService_ChatServer
+	log           *slog.Logger
+	mu            sync.Mutex
+	repo          repo.IChatRepository
+	profileClient profileGen.ProfileServiceClient
 }
 
 func NewChatProvider(
 	log *slog.Logger,
-	mdProvider *md.MetadataProvider,
-	tokenator *jwt.Tokenator,
 	repo repo.IChatRepository,
+	profileClient profileGen.ProfileServiceClient,
 ) *ChatProvider {
 	return &ChatProvider{
-		clients:    make(map[string]gen.ChatService_ChatServer),
-		mdProvider: mdProvider,
-		tokenator:  tokenator,
-		log:        log,
-		repo:       repo,
+		clients:       make(map[string]gen.ChatService_ChatServer),
+		log:           log,
+		repo:          repo,
+		profileClient: profileClient,
 	}
 }
 
 func (p *ChatProvider) Chat(stream gen.ChatService_ChatServer) error {
 	ctx := stream.Context()
 
-	// Получаем токен из метаданных
-	accessToken, err := p.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Получаем пользователя из контекста потока
+	ownerID, err := p.userID(ctx)
 	if err != nil {
-		return errs.ConvertToGrpcError(ctx, p.log, err, fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization))
-	}
-
-	// Получаем UserID из токена
-	ownerID, err := p.tokenator.GetUserIDFromToken(accessToken, false)
-	if err != nil {
-		return errs.ConvertToGrpcError(ctx, p.log, err, "failed to fetch user id from token")
+		return err
 	}
 
 	var fromID string
@@ -126,19 +118,10 @@ func (p *ChatProvider) Chat(stream gen.ChatService_ChatServer) error {
 }
 
 func (p *ChatProvider) UserChats(ctx context.Context, _ *emptypb.Empty) (*gen.UserChatsResponse, error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	// Получаем токен из метаданных
-	accessToken, err := p.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Получаем пользователя из контекста
+	userID, err := p.userID(ctx)
 	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, p.log, err, fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization))
-	}
-
-	// Получаем UserID из токена
-	userID, err := p.tokenator.GetUserIDFromToken(accessToken, false)
-	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, p.log, err, "failed to fetch user id from token")
+		return nil, err
 	}
 
 	// Получаем всех пользователей
@@ -149,38 +132,18 @@ func (p *ChatProvider) UserChats(ctx context.Context, _ *emptypb.Empty) (*gen.Us
 
 	uniqueInterlocutors := uniqueStrings(interlocutors)
 
-	// Получаем данные по пользователям
-	mu := sync.Mutex{}
-	wg := sync.WaitGroup{}
-	errChan := make(chan error, 1)
-	interlocutorsInfo := make([]*generated.User, len(uniqueInterlocutors))
-	for index, interlocutorID := range uniqueInterlocutors {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if ctx.Err() != nil {
-				return
-			}
-
-			interlocutor, userErr := p.repo.UserByID(ctx, interlocutorID)
-			if userErr != nil {
-				trySendError(userErr, errChan, cancel)
-				return
-			}
-
-			mu.Lock()
-			interlocutorsInfo[index] = interlocutor.ConvertToUserGRPC()
-			mu.Unlock()
-		}()
+	// Получаем данные по пользователям одним запросом
+	users, err := loader.FromContext(ctx, p.profileClient).Load(ctx, uniqueInterlocutors)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, p.log, err, "failed to fetch interlocutors")
 	}
 
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
-
-	if err = <-errChan; err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, p.log, err, "failed to fetch interlocutors")
+	interlocutorsInfo := make([]*generated.User, 0, len(uniqueInterlocutors))
+	for _, interlocutorID := range uniqueInterlocutors {
+		if user, ok := users[interlocutorID]; ok {
+			owner := cakeDto.NewOwner(user)
+			interlocutorsInfo = append(interlocutorsInfo, owner.ConvertToGrpcUser())
+		}
 	}
 
 	return &gen.UserChatsResponse{
@@ -189,16 +152,10 @@ func (p *ChatProvider) UserChats(ctx context.Context, _ *emptypb.Empty) (*gen.Us
 }
 
 func (p *ChatProvider) ChatHistory(ctx context.Context, in *gen.ChatHistoryRequest) (*gen.ChatHistoryResponse, error) {
-	// Получаем токен из метаданных
-	accessToken, err := p.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Получаем пользователя из контекста
+	userID, err := p.userID(ctx)
 	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, p.log, err, fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization))
-	}
-
-	// Получаем UserID из токена
-	userID, err := p.tokenator.GetUserIDFromToken(accessToken, false)
-	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, p.log, err, "failed to fetch user id from token")
+		return nil, err
 	}
 
 	messages, err := p.repo.ChatHistory(ctx, userID, in.InterlocutorID)
@@ -215,6 +172,16 @@ func (p *ChatProvider) ChatHistory(ctx context.Context, in *gen.ChatHistoryReque
 	}, nil
 }
 
+// userID Возвращает пользователя, которого положили в контекст интерцепторы authz
+func (p *ChatProvider) userID(ctx context.Context) (string, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return "", errs.ConvertToGrpcError(ctx, p.log, err, "unauthenticated request")
+	}
+
+	return userID.String(), nil
+}
+
 func (p *ChatProvider) removeClient(id string) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -243,12 +210,3 @@ func uniqueStrings(input []string) []string {
 
 	return result
 }
-
-func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
-	select {
-	case errCh <- err:
-		cancel()
-	default:
-		// Если ошибка уже есть - игнорируем (сохраняем первую)
-	}
-}
@@ -5,7 +5,6 @@ import (
 	"2025_CakeLand_API/internal/models/errs"
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 )
 
@@ -20,18 +19,6 @@ const (
 		WHERE (owner_id = $1 OR receiver_id = $1)
 		AND owner_id != receiver_id;
 	`
-	queryUserByID = `
-		SELECT id,
-			   fio,
-			   address,
-			   nickname,
-			   image_url,
-			   mail,
-			   phone,
-			   header_image_url
-		FROM "user"
-		WHERE id = $1;
-	`
 	queryUserHistory = `
 		SELECT id, text, date_creation, owner_id, receiver_id 
 		FROM message 
@@ -42,7 +29,6 @@ const (
 type IChatRepository interface {
 	AddMessage(context.Context, models.Message) error
 	UserInterlocutors(context.Context, string) ([]string, error)
-	UserByID(context.Context, string) (*models.User, error)
 	ChatHistory(ctx context.Context, ownerID, interlocutorID string) ([]*models.Message, error)
 }
 
@@ -66,29 +52,6 @@ func (r *ChatRepository) AddMessage(ctx context.Context, msg models.Message) err
 	return nil
 }
 
-func (r *ChatRepository) UserByID(ctx context.Context, userID string) (*models.User, error) {
-	methodName := "[Repo.GetUserByID]"
-
-	var user models.User
-	if err := r.db.QueryRowContext(ctx, queryUserByID, userID).Scan(
-		&user.ID,
-		&user.FIO,
-		&user.Address,
-		&user.Nickname,
-		&user.ImageURL,
-		&user.Mail,
-		&user.Phone,
-		&user.HeaderImageURL,
-	); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errs.ErrNotFound
-		}
-		return nil, errs.WrapDBError(methodName, err)
-	}
-
-	return &user, nil
-}
-
 func (r *ChatRepository) ChatHistory(ctx context.Context, ownerID, interlocutorID string) ([]*models.Message, error) {
 	methodName := "[Repo.GetChatHistory]"
 
@@ -13,10 +13,18 @@ import (
 )
 
 type Config struct {
-	Env   logger.EnvKind `yaml:"env" env-default:"local"`
-	GRPC  GRPCConfig     `yaml:"grpc"`
-	DB    DatabaseConfig `yaml:"database"`
-	MinIO MinioConfig    `yaml:"minio"`
+	Env         logger.EnvKind    `yaml:"env" env-default:"local"`
+	GRPC        GRPCConfig        `yaml:"grpc"`
+	DB          DatabaseConfig    `yaml:"database"`
+	MinIO       MinioConfig       `yaml:"minio"`
+	Mailer      MailerConfig      `yaml:"mailer"`
+	Password    PasswordConfig    `yaml:"password"`
+	LoginLimits LoginLimitsConfig `yaml:"loginLimits"`
+	JWT         JWTConfig         `yaml:"jwt"`
+	OAuth       OAuthConfig       `yaml:"oauth"`
+	PhoneAuth   PhoneAuthConfig   `yaml:"phoneAuth"`
+	TwoFactor   TwoFactorConfig   `yaml:"twoFactor"`
+	Catalog     CatalogConfig     `yaml:"catalog"`
 }
 
 type GRPCConfig struct {
@@ -27,6 +35,8 @@ type GRPCConfig struct {
 	ReviewsPort int           `yaml:"reviewsPort"`
 	OrderPort   int           `yaml:"orderPort"`
 	Timeout     time.Duration `yaml:"timeout"`
+	// TrustedProxies Прокси перед сервисами (IP или CIDR). Только от них принимаем x-forwarded-for и x-real-ip
+	TrustedProxies []string `yaml:"trustedProxies"`
 }
 
 type DatabaseConfig struct {
@@ -47,6 +57,128 @@ type MinioConfig struct {
 	UseSSL    bool   `json:"use_ssl"`
 }
 
+// MailerConfig Отправка писем. Kind: smtp, file (письма пишутся в Dir) или memory (письма остаются в памяти)
+type MailerConfig struct {
+	Kind     string `yaml:"kind" env-default:"file"`
+	From     string `yaml:"from" env-default:"no-reply@cakeland.local"`
+	Dir      string `yaml:"dir" env-default:"./mail"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port" env-default:"587"`
+	Username string `yaml:"username"`
+	Password string `yaml:"-"`
+}
+
+// PasswordConfig Политика паролей. Классы символов включаются явно в yaml
+type PasswordConfig struct {
+	MinLength     int                `yaml:"minLength" env-default:"8"`
+	MaxLength     int                `yaml:"maxLength" env-default:"128"`
+	RequireLetter bool               `yaml:"requireLetter"`
+	RequireUpper  bool               `yaml:"requireUpper"`
+	RequireDigit  bool               `yaml:"requireDigit"`
+	RequireSymbol bool               `yaml:"requireSymbol"`
+	BreachedList  string             `yaml:"breachedList"` // Файл с утёкшими паролями, по одному на строку. Пусто — без проверки
+	Hash          PasswordHashConfig `yaml:"hash"`
+}
+
+// PasswordHashConfig Алгоритм хэширования новых паролей: argon2id или bcrypt.
+// Хэши с другим алгоритмом или параметрами пересчитываются при входе
+type PasswordHashConfig struct {
+	Algorithm         string `yaml:"algorithm" env-default:"argon2id"`
+	BcryptCost        int    `yaml:"bcryptCost" env-default:"12"`
+	Argon2Memory      uint32 `yaml:"argon2Memory" env-default:"65536"` // КиБ
+	Argon2Iterations  uint32 `yaml:"argon2Iterations" env-default:"3"`
+	Argon2Parallelism uint8  `yaml:"argon2Parallelism" env-default:"2"`
+}
+
+// LoginLimitsConfig Защита входа от перебора паролей. Storage: postgres или memory (счётчики живут до перезапуска).
+// Неудачи старше Window забываются
+type LoginLimitsConfig struct {
+	Storage         string           `yaml:"storage" env-default:"postgres"`
+	Window          time.Duration    `yaml:"window" env-default:"24h"`
+	Account         LoginLimitConfig `yaml:"account"`
+	IP              LoginLimitConfig `yaml:"ip"`
+	AuditRetention  time.Duration    `yaml:"auditRetention" env-default:"2160h"` // Сколько хранится журнал входов
+	CleanupInterval time.Duration    `yaml:"cleanupInterval" env-default:"1h"`   // Как часто удаляются устаревшие счётчики и журнал
+}
+
+// LoginLimitConfig Первые FreeAttempts неудач бесплатны, дальше задержка удваивается от BaseDelay до MaxDelay.
+// После LockoutAttempts неудач вход блокируется на LockoutDuration
+type LoginLimitConfig struct {
+	FreeAttempts    int           `yaml:"freeAttempts"`
+	BaseDelay       time.Duration `yaml:"baseDelay"`
+	MaxDelay        time.Duration `yaml:"maxDelay"`
+	LockoutAttempts int           `yaml:"lockoutAttempts"`
+	LockoutDuration time.Duration `yaml:"lockoutDuration"`
+}
+
+// JWTConfig Подпись access токенов. HS256 — общий секрет ACCESS_SIGN во всех сервисах.
+// RS256 и EdDSA — приватный ключ есть только у auth, остальные сервисы проверяют подпись публичными ключами
+// из VerificationKeys и JWKS сервиса auth. Ротация: новый публичный ключ сначала добавляется в VerificationKeys,
+// и только после JWKSRefresh им начинают подписывать, прежний ключ остаётся в VerificationKeys до истечения токенов
+type JWTConfig struct {
+	Algorithm        string         `yaml:"algorithm" env-default:"HS256"`
+	SigningKey       JWTKeyConfig   `yaml:"signingKey"`                          // Только в auth: приватный ключ в PEM
+	VerificationKeys []JWTKeyConfig `yaml:"verificationKeys"`                    // Публичные ключи в PEM
+	JWKSRefresh      time.Duration  `yaml:"jwksRefresh" env-default:"5m"`        // Как часто сервисы перечитывают JWKS из auth. 0 — не читать
+	JWKSHTTPPort     int            `yaml:"jwksHttpPort"`                        // HTTP /.well-known/jwks.json в auth. 0 — не поднимать
+	Issuer           string         `yaml:"issuer" env-default:"cakeland-auth"`  // iss всех токенов
+	Audience         string         `yaml:"audience" env-default:"cakeland-api"` // aud access токенов. Refresh токены адресованы самому auth (Issuer)
+	AccessTokenTTL   time.Duration  `yaml:"accessTokenTTL" env-default:"15m"`
+	RefreshTokenTTL  time.Duration  `yaml:"refreshTokenTTL" env-default:"168h"`
+	ChallengeTTL     time.Duration  `yaml:"challengeTTL" env-default:"5m"` // Сколько ждём второй фактор после пароля
+}
+
+type JWTKeyConfig struct {
+	ID   string `yaml:"kid"`
+	Path string `yaml:"path"`
+}
+
+// OAuthConfig Вход по ID токенам внешних провайдеров. Провайдер включён, если заданы ClientIDs и JWKSURL
+type OAuthConfig struct {
+	Google OAuthProviderConfig `yaml:"google"`
+	Apple  OAuthProviderConfig `yaml:"apple"`
+	VK     OAuthProviderConfig `yaml:"vk"`
+}
+
+type OAuthProviderConfig struct {
+	Issuers     []string      `yaml:"issuers"`                      // Допустимые iss ID токена
+	ClientIDs   []string      `yaml:"clientIds"`                    // Допустимые aud: client_id наших приложений у провайдера
+	JWKSURL     string        `yaml:"jwksUrl"`                      // Ключи подписи ID токенов
+	JWKSRefresh time.Duration `yaml:"jwksRefresh" env-default:"1h"` // Как часто перечитывать ключи. Неизвестный kid перечитывает их сразу
+	SkipNonce   bool          `yaml:"skipNonce"`                    // Провайдер не возвращает nonce в ID токене: не требовать его
+}
+
+// PhoneAuthConfig Вход по коду из SMS. Новый код на тот же номер — не раньше ResendCooldown и не больше MaxCodesPerDay
+// за сутки. Неверные попытки номера копятся за те же сутки и новым кодом не обнуляются: после MaxAttempts коды
+// перестают приниматься и отправляться. MaxCodesPerIP ограничивает число кодов с одного IP за окно
+// loginLimits.window, 0 — без ограничения
+type PhoneAuthConfig struct {
+	SMS            SMSConfig     `yaml:"sms"`
+	CodeTTL        time.Duration `yaml:"codeTTL" env-default:"5m"`
+	MaxAttempts    int           `yaml:"maxAttempts" env-default:"5"`
+	ResendCooldown time.Duration `yaml:"resendCooldown" env-default:"1m"`
+	MaxCodesPerDay int           `yaml:"maxCodesPerDay" env-default:"5"`
+	MaxCodesPerIP  int           `yaml:"maxCodesPerIp"`
+}
+
+// SMSConfig Отправка SMS. Kind: log (сообщения пишутся в лог) или memory (остаются в памяти)
+type SMSConfig struct {
+	Kind string `yaml:"kind" env-default:"log"`
+}
+
+// TwoFactorConfig Двухфакторная аутентификация по TOTP. Issuer — название сервиса в приложении-аутентификаторе.
+// Ключ шифрования секретов только из окружения
+type TwoFactorConfig struct {
+	Issuer        string `yaml:"issuer" env-default:"CakeLand"`
+	EncryptionKey string `yaml:"-"` // TOTP_ENCRYPTION_KEY: 32 байта в base64
+}
+
+// CatalogConfig Каталог тортов. PriceRefreshInterval — как часто пересчитываются цены тортов,
+// у которых по времени началась или закончилась скидка: на столько цена в поиске может отставать
+type CatalogConfig struct {
+	PriceRefreshInterval time.Duration `yaml:"priceRefreshInterval" env-default:"1m"`
+}
+
 func NewConfig() (*Config, error) {
 	configPath := fetchConfigPath()
 	if configPath == "" {
@@ -87,6 +219,10 @@ func NewConfig() (*Config, error) {
 		cfg.MinIO.UseSSL = false
 	}
 
+	// Пароль SMTP и ключ шифрования TOTP секретов только из окружения
+	cfg.Mailer.Password = os.Getenv("SMTP_PASSWORD")
+	cfg.TwoFactor.EncryptionKey = os.Getenv("TOTP_ENCRYPTION_KEY")
+
 	return &cfg, nil
 }
 
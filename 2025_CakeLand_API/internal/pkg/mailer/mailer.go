@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"context"
+	"fmt"
+)
+
+// Message Письмо пользователю. Тело — обычный текст
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer Отправка писем. Реализация выбирается конфигом: SMTP на сервере, файлы или память локально
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+const (
+	KindSMTP   = "smtp"
+	KindFile   = "file"
+	KindMemory = "memory"
+)
+
+func NewMailer(conf *config.MailerConfig) (Mailer, error) {
+	switch conf.Kind {
+	case KindSMTP:
+		return NewSMTPMailer(conf), nil
+	case KindFile:
+		return NewFileMailer(conf.Dir, conf.From)
+	case KindMemory:
+		return NewMemoryMailer(), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный тип отправки писем: %q", conf.Kind)
+}
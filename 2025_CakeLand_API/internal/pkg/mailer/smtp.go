@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(conf *config.MailerConfig) *SMTPMailer {
+	var auth smtp.Auth
+	if conf.Username != "" {
+		auth = smtp.PlainAuth("", conf.Username, conf.Password, conf.Host)
+	}
+
+	return &SMTPMailer{
+		addr: net.JoinHostPort(conf.Host, strconv.Itoa(conf.Port)),
+		from: conf.From,
+		auth: auth,
+	}
+}
+
+// Send Отправляет письмо через SMTP сервер. STARTTLS включается, если сервер его поддерживает
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, buildMessage(m.from, msg))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("ошибка отправки письма: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// buildMessage Письмо в формате RFC 5322 с темой в UTF-8
+func buildMessage(from string, msg Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(msg.Body)
+
+	return buf.Bytes()
+}
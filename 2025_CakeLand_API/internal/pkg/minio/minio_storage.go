@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/pkg/errors"
+	"net/url"
+	"strings"
 	"sync"
 
 	"github.com/minio/minio-go/v7"
@@ -115,6 +117,37 @@ func (m *MinioProvider) SaveImages(
 	return urls, nil
 }
 
+// DeleteImages Удаляет изображения из бакета. Отсутствующие объекты ошибкой не считаются
+func (m *MinioProvider) DeleteImages(
+	ctx context.Context,
+	bucketName string,
+	objectNames []ImageID,
+) error {
+	for _, objectName := range objectNames {
+		if err := m.client.RemoveObject(ctx, bucketName, string(objectName), minio.RemoveObjectOptions{}); err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("ошибка при удалении изображения из MinIO в бакете %s с объектом %s", bucketName, objectName))
+		}
+	}
+
+	return nil
+}
+
+// ImageIDFromURL Достаёт имя объекта из URL, сформированного SaveImage/SaveImages.
+// Для URL вне бакета (например, внешних картинок) возвращает false
+func ImageIDFromURL(imageURL string, bucketName string) (ImageID, bool) {
+	parsed, err := url.Parse(imageURL)
+	if err != nil {
+		return "", false
+	}
+
+	objectName, ok := strings.CutPrefix(parsed.Path, "/"+bucketName+"/")
+	if !ok || objectName == "" || strings.Contains(objectName, "/") {
+		return "", false
+	}
+
+	return ImageID(objectName), true
+}
+
 // ensureBucketExists проверяет, существует ли бакет, и создает его, если нет
 func (m *MinioProvider) ensureBucketExists(ctx context.Context, bucketName string, region string) error {
 	exists, err := m.client.BucketExists(ctx, bucketName)
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_MakeOrder_FullMethodName = "/order.OrderService/MakeOrder"
+	OrderService_MakeOrder_FullMethodName  = "/order.OrderService/MakeOrder"
+	OrderService_QuoteOrder_FullMethodName = "/order.OrderService/QuoteOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -29,6 +30,7 @@ const (
 // ################# OrderService #################
 type OrderServiceClient interface {
 	MakeOrder(ctx context.Context, in *MakeOrderReq, opts ...grpc.CallOption) (*MakeOrderRes, error)
+	QuoteOrder(ctx context.Context, in *QuoteOrderReq, opts ...grpc.CallOption) (*QuoteOrderRes, error)
 }
 
 type orderServiceClient struct {
@@ -49,6 +51,16 @@ func (c *orderServiceClient) MakeOrder(ctx context.Context, in *MakeOrderReq, op
 	return out, nil
 }
 
+func (c *orderServiceClient) QuoteOrder(ctx context.Context, in *QuoteOrderReq, opts ...grpc.CallOption) (*QuoteOrderRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteOrderRes)
+	err := c.cc.Invoke(ctx, OrderService_QuoteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -56,6 +68,7 @@ func (c *orderServiceClient) MakeOrder(ctx context.Context, in *MakeOrderReq, op
 // ################# OrderService #################
 type OrderServiceServer interface {
 	MakeOrder(context.Context, *MakeOrderReq) (*MakeOrderRes, error)
+	QuoteOrder(context.Context, *QuoteOrderReq) (*QuoteOrderRes, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -69,6 +82,9 @@ type UnimplementedOrderServiceServer struct{}
 func (UnimplementedOrderServiceServer) MakeOrder(context.Context, *MakeOrderReq) (*MakeOrderRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakeOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) QuoteOrder(context.Context, *QuoteOrderReq) (*QuoteOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -108,6 +124,24 @@ func _OrderService_MakeOrder_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, req.(*QuoteOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,6 +153,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MakeOrder",
 			Handler:    _OrderService_MakeOrder_Handler,
 		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _OrderService_QuoteOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
@@ -1,40 +1,37 @@
 package handler
 
 import (
-	"2025_CakeLand_API/internal/domains"
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/order"
 	gen "2025_CakeLand_API/internal/pkg/order/delivery/grpc/generated"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"context"
 	"fmt"
+	"github.com/google/uuid"
 	"log/slog"
 )
 
 type OrderHandler struct {
 	gen.UnimplementedOrderServiceServer
 
-	log        *slog.Logger
-	usecase    order.IOrderUsecase
-	mdProvider *md.MetadataProvider
+	log     *slog.Logger
+	usecase order.IOrderUsecase
 }
 
 func NewOrderHandler(
 	log *slog.Logger,
 	usecase order.IOrderUsecase,
-	mdProvider *md.MetadataProvider,
 ) *OrderHandler {
 	return &OrderHandler{
-		log:        log,
-		usecase:    usecase,
-		mdProvider: mdProvider,
+		log:     log,
+		usecase: usecase,
 	}
 }
 
 func (h *OrderHandler) MakeOrder(ctx context.Context, in *gen.MakeOrderReq) (*gen.MakeOrderRes, error) {
-	// Получаем токен из метаданных
-	accessToken, convertedErr := h.getAccessToken(ctx)
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
 	if convertedErr != nil {
 		return nil, convertedErr
 	}
@@ -46,7 +43,7 @@ func (h *OrderHandler) MakeOrder(ctx context.Context, in *gen.MakeOrderReq) (*ge
 	}
 
 	// Бизнес логика
-	createdOrder, err := h.usecase.MakeOrder(ctx, accessToken, dbOrder)
+	createdOrder, err := h.usecase.MakeOrder(ctx, userID, dbOrder)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to make order")
 	}
@@ -59,11 +56,35 @@ func (h *OrderHandler) MakeOrder(ctx context.Context, in *gen.MakeOrderReq) (*ge
 	}, nil
 }
 
-func (h *OrderHandler) getAccessToken(ctx context.Context) (string, error) {
-	accessToken, err := h.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+func (h *OrderHandler) QuoteOrder(ctx context.Context, in *gen.QuoteOrderReq) (*gen.QuoteOrderRes, error) {
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
+	if convertedErr != nil {
+		return nil, convertedErr
+	}
+
+	// Параметры
+	req, err := models.NewQuoteOrderReq(in)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidInput, err), "invalid quote request")
+	}
+
+	// Бизнес логика
+	quote, err := h.usecase.QuoteOrder(ctx, userID, req)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to quote order")
+	}
+
+	// Ответ
+	return quote.ConvertToGrpcModel(), nil
+}
+
+// userID Возвращает пользователя, которого положил в контекст authz.AuthUnaryInterceptor
+func (h *OrderHandler) userID(ctx context.Context) (uuid.UUID, error) {
+	userID, err := authz.UserID(ctx)
 	if err != nil {
-		return "", errs.ConvertToGrpcError(ctx, h.log, err, fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization))
+		return uuid.Nil, errs.ConvertToGrpcError(ctx, h.log, err, "unauthenticated request")
 	}
 
-	return accessToken, nil
+	return userID, nil
 }
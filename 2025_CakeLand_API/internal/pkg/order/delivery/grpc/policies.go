@@ -0,0 +1,6 @@
+package handler
+
+import "2025_CakeLand_API/internal/pkg/utils/authz"
+
+// MethodPolicies Все методы сервиса заказов требуют авторизации
+var MethodPolicies = authz.MethodPolicies{}
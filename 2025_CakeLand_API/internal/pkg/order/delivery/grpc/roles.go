@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/order/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+var customerRoles = models.Roles{models.RoleCustomer}
+
+// MethodRoles Методы сервиса заказов, доступные только части ролей
+var MethodRoles = authz.MethodRoles{
+	gen.OrderService_MakeOrder_FullMethodName:  customerRoles,
+	gen.OrderService_QuoteOrder_FullMethodName: customerRoles,
+}
@@ -7,10 +7,14 @@ import (
 )
 
 type IOrderUsecase interface {
-	MakeOrder(context.Context, string, models.OrderDB) (*models.OrderDB, error)
+	MakeOrder(context.Context, uuid.UUID, models.OrderDB) (*models.OrderDB, error)
+	QuoteOrder(context.Context, uuid.UUID, models.QuoteOrderReq) (*models.OrderQuote, error)
 }
 
 type IOrderRepository interface {
-	CreateOrder(context.Context, models.OrderDB) error
+	CreateOrder(context.Context, models.OrderDB, *models.PromoCodeUsage) error
 	CakeInfo(context.Context, uuid.UUID) (models.Cake, error)
+	CakeOptions(context.Context, uuid.UUID) ([]models.CakeOption, error)
+	PromoCodeByCode(context.Context, string) (models.PromoCode, error)
+	PromoCodeUsesByUser(ctx context.Context, promoCodeID, userID uuid.UUID) (int, error)
 }
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/order/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "2025_CakeLand_API/internal/models"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIOrderUsecase is a mock of IOrderUsecase interface.
+type MockIOrderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderUsecaseMockRecorder
+}
+
+// MockIOrderUsecaseMockRecorder is the mock recorder for MockIOrderUsecase.
+type MockIOrderUsecaseMockRecorder struct {
+	mock *MockIOrderUsecase
+}
+
+// NewMockIOrderUsecase creates a new mock instance.
+func NewMockIOrderUsecase(ctrl *gomock.Controller) *MockIOrderUsecase {
+	mock := &MockIOrderUsecase{ctrl: ctrl}
+	mock.recorder = &MockIOrderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderUsecase) EXPECT() *MockIOrderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// MakeOrder mocks base method.
+func (m *MockIOrderUsecase) MakeOrder(arg0 context.Context, arg1 uuid.UUID, arg2 models.OrderDB) (*models.OrderDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.OrderDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeOrder indicates an expected call of MakeOrder.
+func (mr *MockIOrderUsecaseMockRecorder) MakeOrder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).MakeOrder), arg0, arg1, arg2)
+}
+
+// QuoteOrder mocks base method.
+func (m *MockIOrderUsecase) QuoteOrder(arg0 context.Context, arg1 uuid.UUID, arg2 models.QuoteOrderReq) (*models.OrderQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.OrderQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteOrder indicates an expected call of QuoteOrder.
+func (mr *MockIOrderUsecaseMockRecorder) QuoteOrder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).QuoteOrder), arg0, arg1, arg2)
+}
+
+// MockIOrderRepository is a mock of IOrderRepository interface.
+type MockIOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderRepositoryMockRecorder
+}
+
+// MockIOrderRepositoryMockRecorder is the mock recorder for MockIOrderRepository.
+type MockIOrderRepositoryMockRecorder struct {
+	mock *MockIOrderRepository
+}
+
+// NewMockIOrderRepository creates a new mock instance.
+func NewMockIOrderRepository(ctrl *gomock.Controller) *MockIOrderRepository {
+	mock := &MockIOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockIOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderRepository) EXPECT() *MockIOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CakeInfo mocks base method.
+func (m *MockIOrderRepository) CakeInfo(arg0 context.Context, arg1 uuid.UUID) (models.Cake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeInfo", arg0, arg1)
+	ret0, _ := ret[0].(models.Cake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeInfo indicates an expected call of CakeInfo.
+func (mr *MockIOrderRepositoryMockRecorder) CakeInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeInfo", reflect.TypeOf((*MockIOrderRepository)(nil).CakeInfo), arg0, arg1)
+}
+
+// CakeOptions mocks base method.
+func (m *MockIOrderRepository) CakeOptions(arg0 context.Context, arg1 uuid.UUID) ([]models.CakeOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CakeOptions", arg0, arg1)
+	ret0, _ := ret[0].([]models.CakeOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CakeOptions indicates an expected call of CakeOptions.
+func (mr *MockIOrderRepositoryMockRecorder) CakeOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CakeOptions", reflect.TypeOf((*MockIOrderRepository)(nil).CakeOptions), arg0, arg1)
+}
+
+// CreateOrder mocks base method.
+func (m *MockIOrderRepository) CreateOrder(arg0 context.Context, arg1 models.OrderDB, arg2 *models.PromoCodeUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockIOrderRepositoryMockRecorder) CreateOrder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockIOrderRepository)(nil).CreateOrder), arg0, arg1, arg2)
+}
+
+// PromoCodeByCode mocks base method.
+func (m *MockIOrderRepository) PromoCodeByCode(arg0 context.Context, arg1 string) (models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoCodeByCode", arg0, arg1)
+	ret0, _ := ret[0].(models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoCodeByCode indicates an expected call of PromoCodeByCode.
+func (mr *MockIOrderRepositoryMockRecorder) PromoCodeByCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoCodeByCode", reflect.TypeOf((*MockIOrderRepository)(nil).PromoCodeByCode), arg0, arg1)
+}
+
+// PromoCodeUsesByUser mocks base method.
+func (m *MockIOrderRepository) PromoCodeUsesByUser(ctx context.Context, promoCodeID, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoCodeUsesByUser", ctx, promoCodeID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoCodeUsesByUser indicates an expected call of PromoCodeUsesByUser.
+func (mr *MockIOrderRepositoryMockRecorder) PromoCodeUsesByUser(ctx, promoCodeID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoCodeUsesByUser", reflect.TypeOf((*MockIOrderRepository)(nil).PromoCodeUsesByUser), ctx, promoCodeID, userID)
+}
@@ -3,10 +3,12 @@ package repo
 import (
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/cake/optionsql"
 	"context"
 	"database/sql"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const (
@@ -23,10 +25,42 @@ const (
                      cake_id)
 		VALUES ($1, $2, $3, $4, $5, $6 , $7, $8, $9, $10) 
 	`
+	// Цена за кг считается той же функцией cake_effective_price, что и в каталоге
 	queryCakeInfo = `
-		SELECT kg_price, mass, discount_kg_price, discount_end_time, is_open_for_sale
-		FROM cake
-		WHERE id = $1
+		SELECT ep.effective_kg_price, c.mass, c.is_open_for_sale, c.owner_id
+		FROM cake c
+		CROSS JOIN LATERAL cake_effective_price(c, now()) ep
+		WHERE c.id = $1 AND c.deleted_at IS NULL
+	`
+	queryPromoCodeByCode = `
+		SELECT id, code, seller_id, kind, value, starts_at, expires_at, max_uses, max_uses_per_user, uses_count,
+			   created_at, cancelled_at
+		FROM promo_code
+		WHERE code = $1
+	`
+	queryPromoCodeUsesByUser = `SELECT COUNT(*) FROM promo_code_usage WHERE promo_code_id = $1 AND user_id = $2`
+	// Блокировка строки промокода сериализует применения: следующий запрос в транзакции
+	// получает свежий снимок и видит использования, записанные параллельными заказами
+	queryLockPromoCode = `SELECT id FROM promo_code WHERE id = $1 FOR UPDATE`
+	// Списываем применение, только если промокод всё ещё действует и лимиты не исчерпаны
+	queryUsePromoCode = `
+		UPDATE promo_code pc
+		SET uses_count = uses_count + 1
+		WHERE pc.id = $1
+		  AND pc.cancelled_at IS NULL
+		  AND pc.starts_at <= now()
+		  AND pc.expires_at > now()
+		  AND (pc.max_uses IS NULL OR pc.uses_count < pc.max_uses)
+		  AND (SELECT COUNT(*) FROM promo_code_usage u WHERE u.promo_code_id = pc.id AND u.user_id = $2) < pc.max_uses_per_user
+	`
+	queryAddOrderOptions = `
+		INSERT INTO order_option (id, order_id, option_id, value_id, option_name, value_name, text, price_modifier)
+		SELECT r.id, $1, r.option_id, r.value_id, r.option_name, r.value_name, r.text, r.price_modifier
+		FROM UNNEST($2::uuid[], $3::uuid[], $4::uuid[], $5::varchar[], $6::varchar[], $7::text[], $8::float8[])
+			AS r(id, option_id, value_id, option_name, value_name, text, price_modifier)
+	`
+	queryAddPromoCodeUsage = `
+		INSERT INTO promo_code_usage (promo_code_id, user_id, order_id, discount_amount) VALUES ($1, $2, $3, $4)
 	`
 )
 
@@ -40,10 +74,16 @@ func NewOrderRepo(db *sql.DB) *OrderRepo {
 	}
 }
 
-func (r *OrderRepo) CreateOrder(ctx context.Context, in models.OrderDB) error {
+// CreateOrder Создаёт заказ. Если usage не nil, в той же транзакции списывает применение промокода
+func (r *OrderRepo) CreateOrder(ctx context.Context, in models.OrderDB, usage *models.PromoCodeUsage) error {
 	const methodName = "[OrderRepo.CreateOrder]"
 
-	if _, err := r.db.ExecContext(ctx, queryCreateOrder,
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryCreateOrder,
 		in.ID,
 		in.TotalPrice,
 		in.DeliveryAddressID,
@@ -55,6 +95,50 @@ func (r *OrderRepo) CreateOrder(ctx context.Context, in models.OrderDB) error {
 		in.PaymentMethod,
 		in.CakeID,
 	); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = addOrderOptions(ctx, tx, in.ID, in.Options); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if usage != nil {
+		if _, err = tx.ExecContext(ctx, queryLockPromoCode, usage.PromoCodeID); err != nil {
+			_ = tx.Rollback()
+			return errs.WrapDBError(methodName, err)
+		}
+
+		res, err := tx.ExecContext(ctx, queryUsePromoCode, usage.PromoCodeID, usage.UserID)
+		if err != nil {
+			_ = tx.Rollback()
+			return errs.WrapDBError(methodName, err)
+		}
+
+		// Промокод успели исчерпать или отменить после расчёта стоимости
+		affected, err := res.RowsAffected()
+		if err != nil {
+			_ = tx.Rollback()
+			return errs.WrapDBError(methodName, err)
+		}
+		if affected == 0 {
+			_ = tx.Rollback()
+			return errs.ErrPromoCodeNotApplicable
+		}
+
+		if _, err = tx.ExecContext(ctx, queryAddPromoCodeUsage,
+			usage.PromoCodeID,
+			usage.UserID,
+			usage.OrderID,
+			usage.DiscountAmount,
+		); err != nil {
+			_ = tx.Rollback()
+			return errs.WrapDBError(methodName, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
 		return errs.WrapDBError(methodName, err)
 	}
 
@@ -64,13 +148,13 @@ func (r *OrderRepo) CreateOrder(ctx context.Context, in models.OrderDB) error {
 func (r *OrderRepo) CakeInfo(ctx context.Context, cakeID uuid.UUID) (models.Cake, error) {
 	const methodName = "[OrderRepo.CakeInfo]"
 
+	// KgPrice — уже действующая цена с учётом скидок и акций
 	var cake models.Cake
 	if err := r.db.QueryRowContext(ctx, queryCakeInfo, cakeID).Scan(
 		&cake.KgPrice,
 		&cake.Mass,
-		&cake.DiscountKgPrice,
-		&cake.DiscountEndTime,
 		&cake.IsOpenForSale,
+		&cake.Owner.ID,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return models.Cake{}, errs.ErrNotFound
@@ -80,3 +164,99 @@ func (r *OrderRepo) CakeInfo(ctx context.Context, cakeID uuid.UUID) (models.Cake
 
 	return cake, nil
 }
+
+func (r *OrderRepo) PromoCodeByCode(ctx context.Context, code string) (models.PromoCode, error) {
+	const methodName = "[OrderRepo.PromoCodeByCode]"
+
+	var promoCode models.PromoCode
+	if err := r.db.QueryRowContext(ctx, queryPromoCodeByCode, code).Scan(
+		&promoCode.ID,
+		&promoCode.Code,
+		&promoCode.SellerID,
+		&promoCode.Discount.Kind,
+		&promoCode.Discount.Value,
+		&promoCode.StartsAt,
+		&promoCode.ExpiresAt,
+		&promoCode.MaxUses,
+		&promoCode.MaxUsesPerUser,
+		&promoCode.UsesCount,
+		&promoCode.CreatedAt,
+		&promoCode.CancelledAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PromoCode{}, errs.ErrNotFound
+		}
+		return models.PromoCode{}, errs.WrapDBError(methodName, err)
+	}
+
+	return promoCode, nil
+}
+
+func (r *OrderRepo) PromoCodeUsesByUser(ctx context.Context, promoCodeID, userID uuid.UUID) (int, error) {
+	const methodName = "[OrderRepo.PromoCodeUsesByUser]"
+
+	var uses int
+	if err := r.db.QueryRowContext(ctx, queryPromoCodeUsesByUser, promoCodeID, userID).Scan(&uses); err != nil {
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	return uses, nil
+}
+
+// CakeOptions Возвращает опции торта со значениями по порядку. ErrNotFound, если торта нет
+func (r *OrderRepo) CakeOptions(ctx context.Context, cakeID uuid.UUID) ([]models.CakeOption, error) {
+	const methodName = "[OrderRepo.CakeOptions]"
+
+	var data []byte
+	if err := r.db.QueryRowContext(ctx, optionsql.QueryCakeOptions, cakeID).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	options, err := optionsql.DecodeOptions(data)
+	if err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return options, nil
+}
+
+func addOrderOptions(ctx context.Context, tx *sql.Tx, orderID uuid.UUID, options []models.OrderOption) error {
+	if len(options) == 0 {
+		return nil
+	}
+
+	var (
+		ids         = make([]string, len(options))
+		optionIDs   = make([]string, len(options))
+		valueIDs    = make([]sql.NullString, len(options))
+		optionNames = make([]string, len(options))
+		valueNames  = make([]sql.NullString, len(options))
+		texts       = make([]sql.NullString, len(options))
+		prices      = make([]float64, len(options))
+	)
+	for i, option := range options {
+		ids[i] = option.ID.String()
+		optionIDs[i] = option.OptionID.String()
+		if option.ValueID.Valid {
+			valueIDs[i] = sql.NullString{String: option.ValueID.UUID.String(), Valid: true}
+		}
+		optionNames[i] = option.OptionName
+		valueNames[i] = option.ValueName.NullString
+		texts[i] = option.Text.NullString
+		prices[i] = option.PriceModifier
+	}
+
+	_, err := tx.ExecContext(ctx, queryAddOrderOptions, orderID,
+		pq.Array(ids),
+		pq.Array(optionIDs),
+		pq.Array(valueIDs),
+		pq.Array(optionNames),
+		pq.Array(valueNames),
+		pq.Array(texts),
+		pq.Array(prices),
+	)
+	return err
+}
@@ -4,86 +4,137 @@ import (
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/order"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
 	"context"
+	"errors"
 	"github.com/google/uuid"
 	"math"
 	"time"
 )
 
 type OrderUsecase struct {
-	tokenator *jwt.Tokenator
-	repo      order.IOrderRepository
+	repo order.IOrderRepository
 }
 
 func NewOrderUsecase(
-	tokenator *jwt.Tokenator,
 	repo order.IOrderRepository,
 ) *OrderUsecase {
 	return &OrderUsecase{
-		tokenator: tokenator,
-		repo:      repo,
+		repo: repo,
 	}
 }
 
-func (u *OrderUsecase) MakeOrder(ctx context.Context, accessToken string, dbOrder models.OrderDB) (*models.OrderDB, error) {
-	// Достаём UserID
-	userID, err := u.getUserUUID(accessToken)
-	if err != nil {
-		return nil, err
-	}
-
+func (u *OrderUsecase) MakeOrder(ctx context.Context, userID uuid.UUID, dbOrder models.OrderDB) (*models.OrderDB, error) {
 	// Сэтим оставшиеся данные
 	dbOrder.ID = uuid.New()
 	dbOrder.CustomerID = userID
 
-	// Получение актуальной информации
-	cake, err := u.repo.CakeInfo(ctx, dbOrder.CakeID)
+	// Считаем актуальную стоимость
+	quote, err := u.quote(ctx, userID, models.QuoteOrderReq{
+		CakeID:          dbOrder.CakeID,
+		Mass:            dbOrder.Mass,
+		PromoCode:       dbOrder.PromoCode,
+		SelectedOptions: dbOrder.SelectedOptions,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Получаем актуальную цену торта
-	kgPrice := cake.KgPrice
-	mass := cake.Mass
-	if cake.DiscountKgPrice.Valid && cake.DiscountEndTime.Valid {
-		if cake.DiscountEndTime.Time.After(time.Now()) {
-			// Скидка активна
-			kgPrice = cake.DiscountKgPrice.Float64
+	// Сравниваем итоговую цену
+	if models.RoundPrice(dbOrder.TotalPrice) != quote.TotalPrice {
+		return nil, errs.ErrTotalPriceIncorrect
+	}
+	dbOrder.SellerID = quote.SellerID
+	dbOrder.TotalPrice = quote.TotalPrice
+	dbOrder.Options = quote.Options
+
+	var usage *models.PromoCodeUsage
+	if quote.PromoCodeID.Valid {
+		usage = &models.PromoCodeUsage{
+			PromoCodeID:    quote.PromoCodeID.UUID,
+			UserID:         userID,
+			OrderID:        dbOrder.ID,
+			DiscountAmount: quote.PromoDiscount,
 		}
 	}
 
+	// Запрос в БД
+	if err = u.repo.CreateOrder(ctx, dbOrder, usage); err != nil {
+		return nil, err
+	}
+
+	// Ответ
+	return &dbOrder, nil
+}
+
+func (u *OrderUsecase) QuoteOrder(ctx context.Context, userID uuid.UUID, in models.QuoteOrderReq) (*models.OrderQuote, error) {
+	return u.quote(ctx, userID, in)
+}
+
+// quote Считает стоимость заказа: цена за кг уже с учётом скидок и акций, к ней добавляются наценки за опции,
+// промокод применяется к итоговой сумме
+func (u *OrderUsecase) quote(ctx context.Context, userID uuid.UUID, in models.QuoteOrderReq) (*models.OrderQuote, error) {
+	// Получение актуальной информации
+	cake, err := u.repo.CakeInfo(ctx, in.CakeID)
+	if err != nil {
+		return nil, err
+	}
+	if !cake.IsOpenForSale {
+		return nil, errs.ErrCakeIsNotForSale
+	}
+
 	// Проверяем, что масса кратна базовой массе
-	if math.Mod(dbOrder.Mass, mass) != 0 {
+	if in.Mass <= 0 || math.Mod(in.Mass, cake.Mass) != 0 {
 		return nil, errs.ErrMassNotExists
 	}
 
-	// Сравниваем итоговую цену
-	totalPrice := kgPrice * (dbOrder.Mass / 1000)
-	if totalPrice != dbOrder.TotalPrice {
-		return nil, errs.ErrTotalPriceIncorrect
+	// Проверяем выбранные опции и считаем наценки
+	cakeOptions, err := u.repo.CakeOptions(ctx, in.CakeID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Запрос в БД
-	if err = u.repo.CreateOrder(ctx, dbOrder); err != nil {
+	options, err := models.NewOrderOptions(cakeOptions, in.SelectedOptions)
+	if err != nil {
 		return nil, err
 	}
 
-	// Ответ
-	return &dbOrder, nil
-}
+	optionsPrice := models.RoundPrice(models.OptionsPrice(options))
+	quote := models.OrderQuote{
+		KgPrice:      cake.KgPrice,
+		OptionsPrice: optionsPrice,
+		TotalPrice:   models.RoundPrice(cake.KgPrice*(in.Mass/1000) + optionsPrice),
+		SellerID:     cake.Owner.ID,
+		Options:      options,
+	}
+	if in.PromoCode == "" {
+		return &quote, nil
+	}
 
-func (u *OrderUsecase) getUserUUID(accessToken string) (uuid.UUID, error) {
-	// Достаём UserID
-	userIDStr, err := u.tokenator.GetUserIDFromToken(accessToken, false)
+	// Применяем промокод
+	code, err := u.repo.PromoCodeByCode(ctx, in.PromoCode)
 	if err != nil {
-		return uuid.Nil, err
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.ErrPromoCodeNotApplicable
+		}
+		return nil, err
+	}
+	if !code.IsActive(time.Now()) || code.SellerID != cake.Owner.ID ||
+		(code.MaxUses.Valid && int64(code.UsesCount) >= code.MaxUses.Int64) {
+		return nil, errs.ErrPromoCodeNotApplicable
 	}
 
-	userID, err := uuid.Parse(userIDStr)
+	uses, err := u.repo.PromoCodeUsesByUser(ctx, code.ID, userID)
 	if err != nil {
-		return uuid.Nil, err
+		return nil, err
 	}
+	if uses >= code.MaxUsesPerUser {
+		return nil, errs.ErrPromoCodeNotApplicable
+	}
+
+	discounted := models.RoundPrice(code.Discount.Apply(quote.TotalPrice))
+	quote.PromoDiscount = models.RoundPrice(quote.TotalPrice - discounted)
+	quote.TotalPrice = discounted
+	quote.PromoCodeID = uuid.NullUUID{UUID: code.ID, Valid: true}
 
-	return userID, nil
+	return &quote, nil
 }
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/order/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOrderUsecase_QuotePromoCode(t *testing.T) {
+	userID, sellerID, cakeID := uuid.New(), uuid.New(), uuid.New()
+	cake := models.Cake{KgPrice: 1000, Mass: 1000, IsOpenForSale: true}
+	cake.Owner.ID = sellerID
+	req := models.QuoteOrderReq{CakeID: cakeID, Mass: 2000, PromoCode: "SALE10"}
+
+	activeCode := func() models.PromoCode {
+		return models.PromoCode{
+			ID:             uuid.New(),
+			Code:           "SALE10",
+			SellerID:       sellerID,
+			Discount:       models.Discount{Kind: models.DiscountKindPercent, Value: 10},
+			StartsAt:       time.Now().Add(-time.Hour),
+			ExpiresAt:      time.Now().Add(time.Hour),
+			MaxUsesPerUser: 1,
+		}
+	}
+	setup := func(t *testing.T) (*OrderUsecase, *mocks.MockIOrderRepository) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIOrderRepository(ctrl)
+
+		mockRepo.EXPECT().CakeInfo(gomock.Any(), cakeID).Return(cake, nil)
+		mockRepo.EXPECT().CakeOptions(gomock.Any(), cakeID).Return(nil, nil)
+		return NewOrderUsecase(mockRepo), mockRepo
+	}
+
+	t.Run("Applied", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+		code := activeCode()
+
+		mockRepo.EXPECT().PromoCodeByCode(gomock.Any(), "SALE10").Return(code, nil)
+		mockRepo.EXPECT().PromoCodeUsesByUser(gomock.Any(), code.ID, userID).Return(0, nil)
+
+		quote, err := uc.QuoteOrder(context.Background(), userID, req)
+		assert.NoError(t, err)
+		assert.Equal(t, 1800.0, quote.TotalPrice)
+		assert.Equal(t, 200.0, quote.PromoDiscount)
+		assert.Equal(t, uuid.NullUUID{UUID: code.ID, Valid: true}, quote.PromoCodeID)
+	})
+
+	notApplicable := []struct {
+		name   string
+		modify func(*models.PromoCode)
+	}{
+		{name: "Expired", modify: func(c *models.PromoCode) { c.ExpiresAt = time.Now().Add(-time.Minute) }},
+		{name: "Not started", modify: func(c *models.PromoCode) { c.StartsAt = time.Now().Add(time.Minute) }},
+		{name: "Cancelled", modify: func(c *models.PromoCode) { c.CancelledAt = null.TimeFrom(time.Now()) }},
+		{name: "Another seller", modify: func(c *models.PromoCode) { c.SellerID = uuid.New() }},
+		{name: "Total limit reached", modify: func(c *models.PromoCode) { c.MaxUses, c.UsesCount = null.IntFrom(3), 3 }},
+	}
+	for _, tt := range notApplicable {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, mockRepo := setup(t)
+			code := activeCode()
+			tt.modify(&code)
+
+			mockRepo.EXPECT().PromoCodeByCode(gomock.Any(), "SALE10").Return(code, nil)
+
+			_, err := uc.QuoteOrder(context.Background(), userID, req)
+			assert.ErrorIs(t, err, errs.ErrPromoCodeNotApplicable)
+		})
+	}
+
+	t.Run("Per user limit reached", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+		code := activeCode()
+
+		mockRepo.EXPECT().PromoCodeByCode(gomock.Any(), "SALE10").Return(code, nil)
+		mockRepo.EXPECT().PromoCodeUsesByUser(gomock.Any(), code.ID, userID).Return(1, nil)
+
+		_, err := uc.QuoteOrder(context.Background(), userID, req)
+		assert.ErrorIs(t, err, errs.ErrPromoCodeNotApplicable)
+	})
+
+	t.Run("Unknown code", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+
+		mockRepo.EXPECT().PromoCodeByCode(gomock.Any(), "SALE10").Return(models.PromoCode{}, errs.ErrNotFound)
+
+		_, err := uc.QuoteOrder(context.Background(), userID, req)
+		assert.ErrorIs(t, err, errs.ErrPromoCodeNotApplicable)
+	})
+
+	t.Run("Spent by a parallel order", func(t *testing.T) {
+		uc, mockRepo := setup(t)
+		code := activeCode()
+
+		mockRepo.EXPECT().PromoCodeByCode(gomock.Any(), "SALE10").Return(code, nil)
+		mockRepo.EXPECT().PromoCodeUsesByUser(gomock.Any(), code.ID, userID).Return(0, nil)
+		mockRepo.EXPECT().
+			CreateOrder(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ models.OrderDB, usage *models.PromoCodeUsage) error {
+				assert.Equal(t, code.ID, usage.PromoCodeID)
+				assert.Equal(t, userID, usage.UserID)
+				return errs.ErrPromoCodeNotApplicable
+			})
+
+		_, err := uc.MakeOrder(context.Background(), userID, models.OrderDB{
+			CakeID:     cakeID,
+			Mass:       2000,
+			TotalPrice: 1800,
+			PromoCode:  "SALE10",
+		})
+		assert.ErrorIs(t, err, errs.ErrPromoCodeNotApplicable)
+	})
+}
+
+func TestOrderUsecase_QuoteOptions(t *testing.T) {
+	userID, cakeID := uuid.New(), uuid.New()
+	cake := models.Cake{KgPrice: 1000, Mass: 1000, IsOpenForSale: true}
+
+	tiers := models.CakeOption{
+		ID:         uuid.New(),
+		Name:       "Ярусы",
+		Kind:       models.CakeOptionKindSingle,
+		IsRequired: true,
+		Values: []models.CakeOptionValue{
+			{ID: uuid.New(), Name: "Один", PriceModifier: 0},
+			{ID: uuid.New(), Name: "Два", PriceModifier: 500},
+		},
+	}
+	inscription := models.CakeOption{
+		ID:            uuid.New(),
+		Name:          "Надпись",
+		Kind:          models.CakeOptionKindText,
+		PriceModifier: 150,
+		MaxTextLength: null.IntFrom(10),
+	}
+
+	quote := func(t *testing.T, selected []models.SelectedOption) (*models.OrderQuote, error) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIOrderRepository(ctrl)
+		mockRepo.EXPECT().CakeInfo(gomock.Any(), cakeID).Return(cake, nil)
+		mockRepo.EXPECT().CakeOptions(gomock.Any(), cakeID).Return([]models.CakeOption{tiers, inscription}, nil)
+
+		return NewOrderUsecase(mockRepo).QuoteOrder(context.Background(), userID, models.QuoteOrderReq{
+			CakeID:          cakeID,
+			Mass:            1000,
+			SelectedOptions: selected,
+		})
+	}
+
+	t.Run("Options add to the price", func(t *testing.T) {
+		res, err := quote(t, []models.SelectedOption{
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{tiers.Values[1].ID}},
+			{OptionID: inscription.ID, Text: "С днём!"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 650.0, res.OptionsPrice)
+		assert.Equal(t, 1650.0, res.TotalPrice)
+		assert.Len(t, res.Options, 2)
+	})
+
+	invalid := []struct {
+		name     string
+		selected []models.SelectedOption
+	}{
+		{name: "Required option missing", selected: nil},
+		{name: "Unknown option", selected: []models.SelectedOption{
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{tiers.Values[0].ID}},
+			{OptionID: uuid.New(), ValueIDs: []uuid.UUID{uuid.New()}},
+		}},
+		{name: "Unknown value", selected: []models.SelectedOption{
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{uuid.New()}},
+		}},
+		{name: "Two values for a single option", selected: []models.SelectedOption{
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{tiers.Values[0].ID, tiers.Values[1].ID}},
+		}},
+		{name: "Same option twice", selected: []models.SelectedOption{
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{tiers.Values[0].ID}},
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{tiers.Values[1].ID}},
+		}},
+		{name: "Text too long", selected: []models.SelectedOption{
+			{OptionID: tiers.ID, ValueIDs: []uuid.UUID{tiers.Values[0].ID}},
+			{OptionID: inscription.ID, Text: strings.Repeat("я", 11)},
+		}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := quote(t, tt.selected)
+			assert.ErrorIs(t, err, errs.ErrInvalidInput)
+		})
+	}
+}
@@ -22,9 +22,12 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	ProfileService_GetUserInfo_FullMethodName         = "/profile.ProfileService/GetUserInfo"
 	ProfileService_GetUserInfoByID_FullMethodName     = "/profile.ProfileService/GetUserInfoByID"
+	ProfileService_GetUsersByIDs_FullMethodName       = "/profile.ProfileService/GetUsersByIDs"
 	ProfileService_GetUserAddresses_FullMethodName    = "/profile.ProfileService/GetUserAddresses"
 	ProfileService_UpdateUserAddresses_FullMethodName = "/profile.ProfileService/UpdateUserAddresses"
 	ProfileService_CreateAddress_FullMethodName       = "/profile.ProfileService/CreateAddress"
+	ProfileService_UpdateServiceArea_FullMethodName   = "/profile.ProfileService/UpdateServiceArea"
+	ProfileService_DeleteServiceArea_FullMethodName   = "/profile.ProfileService/DeleteServiceArea"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -35,9 +38,12 @@ const (
 type ProfileServiceClient interface {
 	GetUserInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserInfoRes, error)
 	GetUserInfoByID(ctx context.Context, in *GetUserInfoByIDReq, opts ...grpc.CallOption) (*GetUserInfoByIDRes, error)
+	GetUsersByIDs(ctx context.Context, in *GetUsersByIDsReq, opts ...grpc.CallOption) (*GetUsersByIDsRes, error)
 	GetUserAddresses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserAddressesRes, error)
 	UpdateUserAddresses(ctx context.Context, in *UpdateUserAddressesReq, opts ...grpc.CallOption) (*UpdateUserAddressesRes, error)
 	CreateAddress(ctx context.Context, in *CreateAddressReq, opts ...grpc.CallOption) (*CreateAddressRes, error)
+	UpdateServiceArea(ctx context.Context, in *UpdateServiceAreaReq, opts ...grpc.CallOption) (*UpdateServiceAreaRes, error)
+	DeleteServiceArea(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type profileServiceClient struct {
@@ -68,6 +74,16 @@ func (c *profileServiceClient) GetUserInfoByID(ctx context.Context, in *GetUserI
 	return out, nil
 }
 
+func (c *profileServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByIDsReq, opts ...grpc.CallOption) (*GetUsersByIDsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIDsRes)
+	err := c.cc.Invoke(ctx, ProfileService_GetUsersByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileServiceClient) GetUserAddresses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserAddressesRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserAddressesRes)
@@ -98,6 +114,26 @@ func (c *profileServiceClient) CreateAddress(ctx context.Context, in *CreateAddr
 	return out, nil
 }
 
+func (c *profileServiceClient) UpdateServiceArea(ctx context.Context, in *UpdateServiceAreaReq, opts ...grpc.CallOption) (*UpdateServiceAreaRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateServiceAreaRes)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateServiceArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteServiceArea(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteServiceArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
@@ -106,9 +142,12 @@ func (c *profileServiceClient) CreateAddress(ctx context.Context, in *CreateAddr
 type ProfileServiceServer interface {
 	GetUserInfo(context.Context, *emptypb.Empty) (*GetUserInfoRes, error)
 	GetUserInfoByID(context.Context, *GetUserInfoByIDReq) (*GetUserInfoByIDRes, error)
+	GetUsersByIDs(context.Context, *GetUsersByIDsReq) (*GetUsersByIDsRes, error)
 	GetUserAddresses(context.Context, *emptypb.Empty) (*GetUserAddressesRes, error)
 	UpdateUserAddresses(context.Context, *UpdateUserAddressesReq) (*UpdateUserAddressesRes, error)
 	CreateAddress(context.Context, *CreateAddressReq) (*CreateAddressRes, error)
+	UpdateServiceArea(context.Context, *UpdateServiceAreaReq) (*UpdateServiceAreaRes, error)
+	DeleteServiceArea(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -125,6 +164,9 @@ func (UnimplementedProfileServiceServer) GetUserInfo(context.Context, *emptypb.E
 func (UnimplementedProfileServiceServer) GetUserInfoByID(context.Context, *GetUserInfoByIDReq) (*GetUserInfoByIDRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfoByID not implemented")
 }
+func (UnimplementedProfileServiceServer) GetUsersByIDs(context.Context, *GetUsersByIDsReq) (*GetUsersByIDsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
+}
 func (UnimplementedProfileServiceServer) GetUserAddresses(context.Context, *emptypb.Empty) (*GetUserAddressesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserAddresses not implemented")
 }
@@ -134,6 +176,12 @@ func (UnimplementedProfileServiceServer) UpdateUserAddresses(context.Context, *U
 func (UnimplementedProfileServiceServer) CreateAddress(context.Context, *CreateAddressReq) (*CreateAddressRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAddress not implemented")
 }
+func (UnimplementedProfileServiceServer) UpdateServiceArea(context.Context, *UpdateServiceAreaReq) (*UpdateServiceAreaRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceArea not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteServiceArea(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceArea not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -191,6 +239,24 @@ func _ProfileService_GetUserInfoByID_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetUsersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetUsersByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetUsersByIDs(ctx, req.(*GetUsersByIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfileService_GetUserAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -245,6 +311,42 @@ func _ProfileService_CreateAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_UpdateServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceAreaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateServiceArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateServiceArea(ctx, req.(*UpdateServiceAreaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteServiceArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteServiceArea(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -260,6 +362,10 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInfoByID",
 			Handler:    _ProfileService_GetUserInfoByID_Handler,
 		},
+		{
+			MethodName: "GetUsersByIDs",
+			Handler:    _ProfileService_GetUsersByIDs_Handler,
+		},
 		{
 			MethodName: "GetUserAddresses",
 			Handler:    _ProfileService_GetUserAddresses_Handler,
@@ -272,6 +378,14 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAddress",
 			Handler:    _ProfileService_CreateAddress_Handler,
 		},
+		{
+			MethodName: "UpdateServiceArea",
+			Handler:    _ProfileService_UpdateServiceArea_Handler,
+		},
+		{
+			MethodName: "DeleteServiceArea",
+			Handler:    _ProfileService_DeleteServiceArea_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile.proto",
@@ -1,12 +1,11 @@
 package handler
 
 import (
-	"2025_CakeLand_API/internal/domains"
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/profile"
 	gen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
@@ -17,32 +16,29 @@ import (
 type GrpcProfileHandler struct {
 	gen.UnimplementedProfileServiceServer
 
-	log        *slog.Logger
-	usecase    profile.IProfileUsecase
-	mdProvider *md.MetadataProvider
+	log     *slog.Logger
+	usecase profile.IProfileUsecase
 }
 
 func NewProfileHandler(
 	logger *slog.Logger,
 	uc profile.IProfileUsecase,
-	mdProvider *md.MetadataProvider,
 ) *GrpcProfileHandler {
 	return &GrpcProfileHandler{
-		log:        logger,
-		usecase:    uc,
-		mdProvider: mdProvider,
+		log:     logger,
+		usecase: uc,
 	}
 }
 
 func (h *GrpcProfileHandler) UpdateUserAddresses(ctx context.Context, req *gen.UpdateUserAddressesReq) (*gen.UpdateUserAddressesRes, error) {
-	// Получаем токен из метаданных
-	accessToken, convertedErr := h.getAccessToken(ctx)
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
 	if convertedErr != nil {
 		return nil, convertedErr
 	}
 
 	// Бизнес-логика
-	address, err := h.usecase.UpdateUserAddresses(ctx, accessToken, req)
+	address, err := h.usecase.UpdateUserAddresses(ctx, userID, req)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update user address")
 	}
@@ -54,14 +50,14 @@ func (h *GrpcProfileHandler) UpdateUserAddresses(ctx context.Context, req *gen.U
 }
 
 func (h *GrpcProfileHandler) GetUserAddresses(ctx context.Context, _ *emptypb.Empty) (*gen.GetUserAddressesRes, error) {
-	// Получаем токен из метаданных
-	accessToken, convertedErr := h.getAccessToken(ctx)
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
 	if convertedErr != nil {
 		return nil, convertedErr
 	}
 
 	// Бизнес-логика
-	addresses, err := h.usecase.GetUserAddresses(ctx, accessToken)
+	addresses, err := h.usecase.GetUserAddresses(ctx, userID)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to get user addresses")
 	}
@@ -78,8 +74,8 @@ func (h *GrpcProfileHandler) GetUserAddresses(ctx context.Context, _ *emptypb.Em
 }
 
 func (h *GrpcProfileHandler) CreateAddress(ctx context.Context, in *gen.CreateAddressReq) (*gen.CreateAddressRes, error) {
-	// Получаем токен из метаданных
-	accessToken, convertedErr := h.getAccessToken(ctx)
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
 	if convertedErr != nil {
 		return nil, convertedErr
 	}
@@ -90,7 +86,7 @@ func (h *GrpcProfileHandler) CreateAddress(ctx context.Context, in *gen.CreateAd
 		Longitude:        in.Longitude,
 		FormattedAddress: in.FormattedAddress,
 	}
-	createdAddress, err := h.usecase.CreateAddress(ctx, accessToken, address)
+	createdAddress, err := h.usecase.CreateAddress(ctx, userID, address)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create address")
 	}
@@ -101,15 +97,56 @@ func (h *GrpcProfileHandler) CreateAddress(ctx context.Context, in *gen.CreateAd
 	}, nil
 }
 
+func (h *GrpcProfileHandler) UpdateServiceArea(ctx context.Context, in *gen.UpdateServiceAreaReq) (*gen.UpdateServiceAreaRes, error) {
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
+	if convertedErr != nil {
+		return nil, convertedErr
+	}
+
+	// Бизнес-логика
+	area := models.ServiceArea{
+		Center: models.GeoPoint{
+			Latitude:  in.Latitude,
+			Longitude: in.Longitude,
+		},
+		RadiusKm: in.RadiusKm,
+	}
+	if err := h.usecase.UpdateServiceArea(ctx, userID, area); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to update service area")
+	}
+
+	// Ответ
+	return &gen.UpdateServiceAreaRes{
+		ServiceArea: area.ConvertToGrpcModel(),
+	}, nil
+}
+
+func (h *GrpcProfileHandler) DeleteServiceArea(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
+	if convertedErr != nil {
+		return nil, convertedErr
+	}
+
+	// Бизнес-логика
+	if err := h.usecase.DeleteServiceArea(ctx, userID); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to delete service area")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
 func (h *GrpcProfileHandler) GetUserInfo(ctx context.Context, _ *emptypb.Empty) (*gen.GetUserInfoRes, error) {
-	// Получаем токен из метаданных
-	accessToken, convertedErr := h.getAccessToken(ctx)
+	// Получаем пользователя из контекста
+	userID, convertedErr := h.userID(ctx)
 	if convertedErr != nil {
 		return nil, convertedErr
 	}
 
 	// Бизнес-логика
-	userInfo, err := h.usecase.UserInfo(ctx, accessToken)
+	userInfo, err := h.usecase.UserInfo(ctx, userID)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch user info")
 	}
@@ -121,7 +158,7 @@ func (h *GrpcProfileHandler) GetUserInfo(ctx context.Context, _ *emptypb.Empty)
 }
 
 func (h *GrpcProfileHandler) GetUserInfoByID(ctx context.Context, req *gen.GetUserInfoByIDReq) (*gen.GetUserInfoByIDRes, error) {
-	// Получаем токен из метаданных
+	// Параметры
 	userID, err := uuid.Parse(req.UserID)
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err), "invalid user id format")
@@ -139,11 +176,46 @@ func (h *GrpcProfileHandler) GetUserInfoByID(ctx context.Context, req *gen.GetUs
 	}, nil
 }
 
-func (h *GrpcProfileHandler) getAccessToken(ctx context.Context) (string, error) {
-	accessToken, err := h.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+func (h *GrpcProfileHandler) GetUsersByIDs(ctx context.Context, req *gen.GetUsersByIDsReq) (*gen.GetUsersByIDsRes, error) {
+	// Параметры
+	if len(req.UserIDs) > models.MaxUsersByIDs {
+		return nil, errs.ConvertToGrpcError(ctx, h.log,
+			fmt.Errorf("%w: at most %d user ids per request", errs.ErrInvalidInput, models.MaxUsersByIDs),
+			"too many user ids",
+		)
+	}
+	userIDs := make([]uuid.UUID, len(req.UserIDs))
+	for i, rawID := range req.UserIDs {
+		userID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, errs.ConvertToGrpcError(ctx, h.log, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err), "invalid user id format")
+		}
+		userIDs[i] = userID
+	}
+
+	// Бизнес-логика
+	users, err := h.usecase.UsersInfoByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch users info")
+	}
+
+	// Ответ
+	grpcUsers := make([]*gen.Profile, len(users))
+	for i, user := range users {
+		grpcUsers[i] = user.ConvertToGRPCProfile()
+	}
+
+	return &gen.GetUsersByIDsRes{
+		Users: grpcUsers,
+	}, nil
+}
+
+// userID Возвращает пользователя, которого положил в контекст authz.AuthUnaryInterceptor
+func (h *GrpcProfileHandler) userID(ctx context.Context) (uuid.UUID, error) {
+	userID, err := authz.UserID(ctx)
 	if err != nil {
-		return "", errs.ConvertToGrpcError(ctx, h.log, err, fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization))
+		return uuid.Nil, errs.ConvertToGrpcError(ctx, h.log, err, "unauthenticated request")
 	}
 
-	return accessToken, nil
+	return userID, nil
 }
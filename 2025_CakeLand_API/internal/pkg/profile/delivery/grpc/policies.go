@@ -0,0 +1,13 @@
+package handler
+
+import (
+	gen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+// MethodPolicies Методы сервиса профиля, которым не нужен токен. Остальные требуют авторизации
+var MethodPolicies = authz.MethodPolicies{
+	// Публичные профили, в том числе для других сервисов
+	gen.ProfileService_GetUserInfoByID_FullMethodName: authz.PolicyPublic,
+	gen.ProfileService_GetUsersByIDs_FullMethodName:   authz.PolicyPublic,
+}
@@ -0,0 +1,16 @@
+package handler
+
+import (
+	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+var sellerRoles = models.Roles{models.RoleSeller}
+
+// MethodRoles Методы сервиса профиля, доступные только части ролей
+var MethodRoles = authz.MethodRoles{
+	// Зона доставки продавца
+	gen.ProfileService_UpdateServiceArea_FullMethodName: sellerRoles,
+	gen.ProfileService_DeleteServiceArea_FullMethodName: sellerRoles,
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/pkg/cake/dto"
 	"2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
 	"database/sql"
@@ -19,6 +20,8 @@ type Profile struct {
 	Mail           string
 	Phone          null.String
 	CardNumber     null.String
+	Stats          models.SellerStats
+	ServiceArea    *models.ServiceArea
 }
 
 func (p *Profile) ConvertToGrpcModel() *generated.Profile {
@@ -32,6 +35,8 @@ func (p *Profile) ConvertToGrpcModel() *generated.Profile {
 		Mail:           p.Mail,
 		Phone:          stringOrNil(p.Phone.NullString),
 		CardNumber:     stringOrNil(p.CardNumber.NullString),
+		Stats:          p.Stats.ConvertToGrpcModel(),
+		ServiceArea:    p.ServiceArea.ConvertToGrpcModel(),
 	}
 }
 
@@ -48,6 +53,21 @@ func (p *Profile) ConvertToOwner() dto.Owner {
 	}
 }
 
+func (p *Profile) ConvertToUserInfo() models.UserInfo {
+	return models.UserInfo{
+		ID:             p.ID.String(),
+		FIO:            p.FIO,
+		Address:        p.Address,
+		Nickname:       p.Nickname,
+		ImageURL:       p.ImageURL,
+		HeaderImageURL: p.HeaderImageURL,
+		Mail:           p.Mail,
+		Phone:          p.Phone,
+		Stats:          p.Stats,
+		ServiceArea:    p.ServiceArea,
+	}
+}
+
 // Вспомогательная функция для nullable строк
 func stringOrNil(s sql.NullString) *wrapperspb.StringValue {
 	if s.Valid {
@@ -10,17 +10,23 @@ import (
 )
 
 type IProfileUsecase interface {
-	UserInfo(context.Context, string) (*dto.UserInfo, error)
+	UserInfo(context.Context, uuid.UUID) (*dto.UserInfo, error)
 	UserInfoByID(context.Context, uuid.UUID) (*models.UserInfo, error)
-	CreateAddress(context.Context, string, *models.Address) (*models.Address, error)
-	GetUserAddresses(context.Context, string) ([]models.Address, error)
-	UpdateUserAddresses(context.Context, string, *gen.UpdateUserAddressesReq) (models.Address, error)
+	UsersInfoByIDs(context.Context, []uuid.UUID) ([]models.UserInfo, error)
+	CreateAddress(context.Context, uuid.UUID, *models.Address) (*models.Address, error)
+	GetUserAddresses(context.Context, uuid.UUID) ([]models.Address, error)
+	UpdateUserAddresses(context.Context, uuid.UUID, *gen.UpdateUserAddressesReq) (models.Address, error)
+	UpdateServiceArea(context.Context, uuid.UUID, models.ServiceArea) error
+	DeleteServiceArea(context.Context, uuid.UUID) error
 }
 
 type IProfileRepository interface {
 	UserInfo(context.Context, uuid.UUID) (*dto.Profile, error)
+	UsersByIDs(context.Context, []uuid.UUID) ([]dto.Profile, error)
 	CakesByUserID(ctx context.Context, userID uuid.UUID) ([]cakeDto.PreviewCakeDB, error)
 	CreateAddress(context.Context, *models.Address) error
 	GetUserAddresses(context.Context, uuid.UUID) ([]models.Address, error)
 	UpdateUserAddresses(context.Context, uuid.UUID, *gen.UpdateUserAddressesReq) (models.Address, error)
+	UpsertServiceArea(context.Context, uuid.UUID, models.ServiceArea) error
+	DeleteServiceArea(context.Context, uuid.UUID) error
 }
@@ -0,0 +1,105 @@
+package loader
+
+import (
+	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"context"
+	"google.golang.org/grpc"
+	"sync"
+)
+
+// UsersLoader Загружает пользователей батчами GetUsersByIDs и кэширует их на время жизни запроса.
+// Живёт в контексте входящего запроса (UnaryServerInterceptor), поэтому кэш не устаревает
+type UsersLoader struct {
+	client gen.ProfileServiceClient
+	mu     sync.Mutex
+	cache  map[string]*gen.Profile
+	absent map[string]struct{} // ID, которых нет в сервисе профиля: повторно не запрашиваются
+}
+
+func NewUsersLoader(client gen.ProfileServiceClient) *UsersLoader {
+	return &UsersLoader{
+		client: client,
+		cache:  make(map[string]*gen.Profile),
+		absent: make(map[string]struct{}),
+	}
+}
+
+type ctxKey struct{}
+
+// WithUsersLoader Кладёт в контекст новый загрузчик на время одного запроса
+func WithUsersLoader(ctx context.Context, client gen.ProfileServiceClient) context.Context {
+	return context.WithValue(ctx, ctxKey{}, NewUsersLoader(client))
+}
+
+// FromContext Загрузчик текущего запроса. Если в контексте его нет, создаёт новый на один вызов
+func FromContext(ctx context.Context, client gen.ProfileServiceClient) *UsersLoader {
+	if l, ok := ctx.Value(ctxKey{}).(*UsersLoader); ok {
+		return l
+	}
+	return NewUsersLoader(client)
+}
+
+// UnaryServerInterceptor Создаёт загрузчик на каждый входящий запрос
+func UnaryServerInterceptor(client gen.ProfileServiceClient) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(WithUsersLoader(ctx, client), req)
+	}
+}
+
+// Load Возвращает найденных пользователей по ID (key: userID), ID без пользователя в ответ не попадают.
+// Отсутствующие в кэше ID запрашиваются батчами не больше models.MaxUsersByIDs
+func (l *UsersLoader) Load(ctx context.Context, userIDs []string) (map[string]*gen.Profile, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Собираем уникальные ID, которых ещё нет в кэше
+	missing := make([]string, 0, len(userIDs))
+	seen := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := l.cache[id]; ok {
+			continue
+		}
+		if _, ok := l.absent[id]; ok {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		missing = append(missing, id)
+	}
+
+	for start := 0; start < len(missing); start += models.MaxUsersByIDs {
+		batch := missing[start:min(start+models.MaxUsersByIDs, len(missing))]
+		res, err := l.client.GetUsersByIDs(ctx, &gen.GetUsersByIDsReq{
+			UserIDs: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range res.Users {
+			l.cache[user.Id] = user
+		}
+		for _, id := range batch {
+			if _, ok := l.cache[id]; !ok {
+				l.absent[id] = struct{}{}
+			}
+		}
+	}
+
+	users := make(map[string]*gen.Profile, len(userIDs))
+	for _, id := range userIDs {
+		if user, ok := l.cache[id]; ok {
+			users[id] = user
+		}
+	}
+
+	return users, nil
+}
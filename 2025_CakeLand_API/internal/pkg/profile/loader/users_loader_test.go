@@ -0,0 +1,80 @@
+package loader
+
+import (
+	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeProfileClient Отвечает на GetUsersByIDs профилями из users и запоминает запрошенные ID
+type fakeProfileClient struct {
+	gen.ProfileServiceClient
+	users     map[string]*gen.Profile
+	requested [][]string
+}
+
+func (c *fakeProfileClient) GetUsersByIDs(_ context.Context, in *gen.GetUsersByIDsReq, _ ...grpc.CallOption) (*gen.GetUsersByIDsRes, error) {
+	c.requested = append(c.requested, in.UserIDs)
+
+	res := &gen.GetUsersByIDsRes{}
+	for _, id := range in.UserIDs {
+		if user, ok := c.users[id]; ok {
+			res.Users = append(res.Users, user)
+		}
+	}
+	return res, nil
+}
+
+func TestUsersLoader_Load(t *testing.T) {
+	client := &fakeProfileClient{users: map[string]*gen.Profile{
+		"1": {Id: "1", Nickname: "first"},
+		"2": {Id: "2", Nickname: "second"},
+	}}
+	ctx := WithUsersLoader(context.Background(), client)
+	l := FromContext(ctx, client)
+
+	users, err := l.Load(ctx, []string{"1", "2", "1", "3"})
+	require.NoError(t, err)
+	require.Equal(t, "first", users["1"].Nickname)
+	require.Equal(t, "second", users["2"].Nickname)
+	require.Equal(t, [][]string{{"1", "2", "3"}}, client.requested)
+
+	t.Run("Missing users are left out", func(t *testing.T) {
+		require.Len(t, users, 2)
+		require.NotContains(t, users, "3")
+	})
+
+	t.Run("Cached within the request", func(t *testing.T) {
+		client.requested = nil
+		_, err := FromContext(ctx, client).Load(ctx, []string{"2", "3"})
+		require.NoError(t, err)
+		require.Empty(t, client.requested)
+	})
+
+	t.Run("Not shared between requests", func(t *testing.T) {
+		client.requested = nil
+		other := WithUsersLoader(context.Background(), client)
+		_, err := FromContext(other, client).Load(other, []string{"2"})
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"2"}}, client.requested)
+	})
+}
+
+func TestUsersLoader_Batches(t *testing.T) {
+	client := &fakeProfileClient{}
+	ids := make([]string, models.MaxUsersByIDs+1)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+
+	_, err := NewUsersLoader(client).Load(context.Background(), ids)
+	require.NoError(t, err)
+	require.Len(t, client.requested, 2)
+	require.Len(t, client.requested[0], models.MaxUsersByIDs)
+	require.Equal(t, []string{strconv.Itoa(models.MaxUsersByIDs)}, client.requested[1])
+}
@@ -10,28 +10,46 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
 )
 
 const (
-	querySelectProfileByID = `
-		SELECT id, fio, address, nickname, header_image_url, image_url, mail, phone, card_number FROM "user" WHERE id = $1 LIMIT 1
+	querySelectProfile = `
+		SELECT u.id, u.fio, u.address, u.nickname, u.header_image_url, u.image_url, COALESCE(u.mail, ''), u.phone, u.card_number,
+			   COALESCE(s.stars_sum::float8 / NULLIF(s.reviews_count, 0), 0),
+			   COALESCE(s.reviews_count, 0),
+			   COALESCE(s.completed_orders_count, 0),
+			   ROUND(s.response_time_sum / NULLIF(s.responses_count, 0))::bigint,
+			   u.date_creation,
+			   sa.latitude,
+			   sa.longitude,
+			   sa.radius_km
+		FROM "user" u
+		LEFT JOIN seller_stats s ON s.seller_id = u.id
+		LEFT JOIN seller_service_area sa ON sa.seller_id = u.id
 	`
+	querySelectProfileByID   = querySelectProfile + `WHERE u.id = $1 LIMIT 1`
+	querySelectProfilesByIDs = querySelectProfile + `WHERE u.id = ANY($1)`
 	querySelectCakesByUserID = `
-		SELECT id,
-			   name,
-			   image_url,
-			   kg_price,
-			   reviews_count,
-			   stars_sum,
-			   description,
-			   mass,
-			   discount_kg_price,
-			   discount_end_time,
-			   date_creation,
-			   is_open_for_sale,
-			   owner_id
-		FROM cake
-		WHERE owner_id = $1;
+		SELECT c.id,
+			   c.name,
+			   c.image_url,
+			   c.kg_price,
+			   c.reviews_count,
+			   c.stars_sum,
+			   c.rating,
+			   c.description,
+			   c.mass,
+			   CASE WHEN ep.effective_kg_price < c.kg_price THEN ep.effective_kg_price END,
+			   ep.discount_ends_at,
+			   c.date_creation,
+			   c.is_open_for_sale,
+			   c.owner_id,
+			   c.favorites_count
+		FROM cake c
+		CROSS JOIN LATERAL cake_effective_price(c, now()) ep
+		WHERE c.owner_id = $1 AND c.deleted_at IS NULL;
     `
 	queryCreateAddress = `
 		INSERT INTO address (id, user_id, latitude, longitude, formatted_address) VALUES ($1, $2, $3, $4, $5)
@@ -60,6 +78,16 @@ const (
 		WHERE id = $5 AND user_id = $6
 		RETURNING id, user_id, latitude, longitude, formatted_address, entrance, floor, apartment, comment
 	`
+	queryUpsertServiceArea = `
+		INSERT INTO seller_service_area (seller_id, latitude, longitude, radius_km)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (seller_id) DO UPDATE
+			SET latitude   = EXCLUDED.latitude,
+				longitude  = EXCLUDED.longitude,
+				radius_km  = EXCLUDED.radius_km,
+				updated_at = now()
+	`
+	queryDeleteServiceArea = `DELETE FROM seller_service_area WHERE seller_id = $1`
 )
 
 type ProfileRepository struct {
@@ -157,7 +185,10 @@ func (r *ProfileRepository) CreateAddress(ctx context.Context, address *models.A
 func (r *ProfileRepository) UserInfo(ctx context.Context, userID uuid.UUID) (*dto.Profile, error) {
 	const methodName = "[ProfileRepository.UserInfo]"
 
-	var user dto.Profile
+	var (
+		user dto.Profile
+		area nullServiceArea
+	)
 	if err := r.db.QueryRowContext(ctx, querySelectProfileByID, userID).Scan(
 		&user.ID,
 		&user.FIO,
@@ -168,6 +199,14 @@ func (r *ProfileRepository) UserInfo(ctx context.Context, userID uuid.UUID) (*dt
 		&user.Mail,
 		&user.Phone,
 		&user.CardNumber,
+		&user.Stats.Rating,
+		&user.Stats.ReviewsCount,
+		&user.Stats.CompletedOrdersCount,
+		&user.Stats.AvgResponseTimeSeconds,
+		&user.Stats.RegistrationDate,
+		&area.Latitude,
+		&area.Longitude,
+		&area.RadiusKm,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrNotFound
@@ -175,9 +214,63 @@ func (r *ProfileRepository) UserInfo(ctx context.Context, userID uuid.UUID) (*dt
 		return nil, errs.WrapDBError(methodName, err)
 	}
 
+	user.ServiceArea = area.toModel()
 	return &user, nil
 }
 
+func (r *ProfileRepository) UsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]dto.Profile, error) {
+	const methodName = "[ProfileRepository.UsersByIDs]"
+
+	ids := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id.String()
+	}
+
+	rows, err := r.db.QueryContext(ctx, querySelectProfilesByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	defer rows.Close()
+	users := make([]dto.Profile, 0, len(userIDs))
+	for rows.Next() {
+		var (
+			user dto.Profile
+			area nullServiceArea
+		)
+		if err = rows.Scan(
+			&user.ID,
+			&user.FIO,
+			&user.Address,
+			&user.Nickname,
+			&user.HeaderImageURL,
+			&user.ImageURL,
+			&user.Mail,
+			&user.Phone,
+			&user.CardNumber,
+			&user.Stats.Rating,
+			&user.Stats.ReviewsCount,
+			&user.Stats.CompletedOrdersCount,
+			&user.Stats.AvgResponseTimeSeconds,
+			&user.Stats.RegistrationDate,
+			&area.Latitude,
+			&area.Longitude,
+			&area.RadiusKm,
+		); err != nil {
+			return nil, errs.WrapDBError(methodName, err)
+		}
+
+		user.ServiceArea = area.toModel()
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return users, nil
+}
+
 func (r *ProfileRepository) CakesByUserID(ctx context.Context, userID uuid.UUID) ([]cakeDto.PreviewCakeDB, error) {
 	const methodName = "[ProfileRepository.CakesByUserID]"
 
@@ -197,6 +290,7 @@ func (r *ProfileRepository) CakesByUserID(ctx context.Context, userID uuid.UUID)
 			&previewCake.KgPrice,
 			&previewCake.ReviewsCount,
 			&previewCake.StarsSum,
+			&previewCake.Rating,
 			&previewCake.Description,
 			&previewCake.Mass,
 			&previewCake.DiscountKgPrice,
@@ -204,6 +298,7 @@ func (r *ProfileRepository) CakesByUserID(ctx context.Context, userID uuid.UUID)
 			&previewCake.DateCreation,
 			&previewCake.IsOpenForSale,
 			&previewCake.OwnerID,
+			&previewCake.FavoritesCount,
 		); err != nil {
 			return nil, errs.WrapDBError(methodName, err)
 		}
@@ -217,3 +312,58 @@ func (r *ProfileRepository) CakesByUserID(ctx context.Context, userID uuid.UUID)
 
 	return cakes, nil
 }
+
+func (r *ProfileRepository) UpsertServiceArea(ctx context.Context, sellerID uuid.UUID, area models.ServiceArea) error {
+	const methodName = "[ProfileRepository.UpsertServiceArea]"
+
+	if _, err := r.db.ExecContext(ctx, queryUpsertServiceArea,
+		sellerID,
+		area.Center.Latitude,
+		area.Center.Longitude,
+		area.RadiusKm,
+	); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *ProfileRepository) DeleteServiceArea(ctx context.Context, sellerID uuid.UUID) error {
+	const methodName = "[ProfileRepository.DeleteServiceArea]"
+
+	res, err := r.db.ExecContext(ctx, queryDeleteServiceArea, sellerID)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+	if affected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// nullServiceArea Колонки зоны доставки из LEFT JOIN: все NULL, если продавец её не задал
+type nullServiceArea struct {
+	Latitude  null.Float
+	Longitude null.Float
+	RadiusKm  null.Float
+}
+
+func (a nullServiceArea) toModel() *models.ServiceArea {
+	if !a.RadiusKm.Valid {
+		return nil
+	}
+
+	return &models.ServiceArea{
+		Center: models.GeoPoint{
+			Latitude:  a.Latitude.Float64,
+			Longitude: a.Longitude.Float64,
+		},
+		RadiusKm: a.RadiusKm.Float64,
+	}
+}
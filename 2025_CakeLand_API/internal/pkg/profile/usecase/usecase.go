@@ -7,81 +7,66 @@ import (
 	"2025_CakeLand_API/internal/pkg/profile"
 	gen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
 	"2025_CakeLand_API/internal/pkg/profile/dto"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
 	"context"
 	"github.com/google/uuid"
 	"sync"
 )
 
 type ProfileUseсase struct {
-	tokenator     *jwt.Tokenator
 	repo          profile.IProfileRepository
 	imageProvider *minio.MinioProvider
 }
 
 func NewProfileUsecase(
-	tokenator *jwt.Tokenator,
 	repo profile.IProfileRepository,
 	imageProvider *minio.MinioProvider,
 ) *ProfileUseсase {
 	return &ProfileUseсase{
-		tokenator:     tokenator,
 		repo:          repo,
 		imageProvider: imageProvider,
 	}
 }
 
-func (u *ProfileUseсase) UpdateUserAddresses(ctx context.Context, accessToken string, req *gen.UpdateUserAddressesReq) (models.Address, error) {
-	// Достаём UserID
-	userID, err := u.getUserUUID(accessToken)
-	if err != nil {
-		return models.Address{}, err
-	}
-
+func (u *ProfileUseсase) UpdateUserAddresses(ctx context.Context, userID uuid.UUID, req *gen.UpdateUserAddressesReq) (models.Address, error) {
 	// Обновляем адрес в репозитории
 	return u.repo.UpdateUserAddresses(ctx, userID, req)
 }
 
-func (u *ProfileUseсase) GetUserAddresses(ctx context.Context, accessToken string) ([]models.Address, error) {
-	// Достаём UserID
-	userID, err := u.getUserUUID(accessToken)
-	if err != nil {
-		return nil, err
+func (u *ProfileUseсase) UpdateServiceArea(ctx context.Context, userID uuid.UUID, area models.ServiceArea) error {
+	// Валидация
+	if err := area.Validate(); err != nil {
+		return err
 	}
 
+	return u.repo.UpsertServiceArea(ctx, userID, area)
+}
+
+func (u *ProfileUseсase) DeleteServiceArea(ctx context.Context, userID uuid.UUID) error {
+	return u.repo.DeleteServiceArea(ctx, userID)
+}
+
+func (u *ProfileUseсase) GetUserAddresses(ctx context.Context, userID uuid.UUID) ([]models.Address, error) {
 	// Получаем адреса из БД
 	return u.repo.GetUserAddresses(ctx, userID)
 }
 
-func (u *ProfileUseсase) CreateAddress(ctx context.Context, accessToken string, address *models.Address) (*models.Address, error) {
-	// Достаём UserID
-	userID, err := u.getUserUUID(accessToken)
-	if err != nil {
-		return nil, err
-	}
-
+func (u *ProfileUseсase) CreateAddress(ctx context.Context, userID uuid.UUID, address *models.Address) (*models.Address, error) {
 	// Создаём адрес в БД
 	address.ID = uuid.New()
 	address.UserID = userID
 
-	if err = u.repo.CreateAddress(ctx, address); err != nil {
+	if err := u.repo.CreateAddress(ctx, address); err != nil {
 		return nil, err
 	}
 
 	// Ответ
-	return address, err
+	return address, nil
 }
 
-func (u *ProfileUseсase) UserInfo(ctx context.Context, accessToken string) (*dto.UserInfo, error) {
+func (u *ProfileUseсase) UserInfo(ctx context.Context, userID uuid.UUID) (*dto.UserInfo, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// Достаём UserID
-	userID, err := u.getUserUUID(accessToken)
-	if err != nil {
-		return nil, err
-	}
-
 	//var userInfo dto.UserInfo
 	var (
 		dbCakes []dto2.PreviewCakeDB
@@ -136,7 +121,7 @@ func (u *ProfileUseсase) UserInfo(ctx context.Context, accessToken string) (*dt
 		close(errChan)
 	}()
 
-	if err = <-errChan; err != nil {
+	if err := <-errChan; err != nil {
 		return nil, err
 	}
 
@@ -158,39 +143,33 @@ func (u *ProfileUseсase) UserInfoByID(ctx context.Context, userID uuid.UUID) (*
 		return nil, err
 	}
 
-	userInfo := models.UserInfo{
-		ID:             profileInfo.ID.String(),
-		FIO:            profileInfo.FIO,
-		Address:        profileInfo.Address,
-		Nickname:       profileInfo.Nickname,
-		ImageURL:       profileInfo.ImageURL,
-		HeaderImageURL: profileInfo.HeaderImageURL,
-		Mail:           profileInfo.Mail,
-		Phone:          profileInfo.Phone,
-	}
+	userInfo := profileInfo.ConvertToUserInfo()
 	return &userInfo, nil
 }
 
-func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
-	select {
-	case errCh <- err:
-		cancel()
-	default:
-		// Если ошибка уже есть - игнорируем (сохраняем первую)
+func (u *ProfileUseсase) UsersInfoByIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.UserInfo, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
 	}
-}
 
-func (u *ProfileUseсase) getUserUUID(accessToken string) (uuid.UUID, error) {
-	// Достаём UserID
-	userIDStr, err := u.tokenator.GetUserIDFromToken(accessToken, false)
+	profiles, err := u.repo.UsersByIDs(ctx, userIDs)
 	if err != nil {
-		return uuid.Nil, err
+		return nil, err
 	}
 
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		return uuid.Nil, err
+	users := make([]models.UserInfo, len(profiles))
+	for i, profileInfo := range profiles {
+		users[i] = profileInfo.ConvertToUserInfo()
 	}
 
-	return userID, nil
+	return users, nil
+}
+
+func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
+	select {
+	case errCh <- err:
+		cancel()
+	default:
+		// Если ошибка уже есть - игнорируем (сохраняем первую)
+	}
 }
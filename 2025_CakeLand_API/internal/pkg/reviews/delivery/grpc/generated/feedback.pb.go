@@ -10,6 +10,7 @@ import (
 	generated "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,6 +24,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FeedbackSort int32
+
+const (
+	FeedbackSort_NEWEST       FeedbackSort = 0 // Сначала новые
+	FeedbackSort_MOST_HELPFUL FeedbackSort = 1 // Сначала самые полезные
+)
+
+// Enum value maps for FeedbackSort.
+var (
+	FeedbackSort_name = map[int32]string{
+		0: "NEWEST",
+		1: "MOST_HELPFUL",
+	}
+	FeedbackSort_value = map[string]int32{
+		"NEWEST":       0,
+		"MOST_HELPFUL": 1,
+	}
+)
+
+func (x FeedbackSort) Enum() *FeedbackSort {
+	p := new(FeedbackSort)
+	*p = x
+	return p
+}
+
+func (x FeedbackSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedbackSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_feedback_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedbackSort) Type() protoreflect.EnumType {
+	return &file_feedback_proto_enumTypes[0]
+}
+
+func (x FeedbackSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedbackSort.Descriptor instead.
+func (FeedbackSort) EnumDescriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{0}
+}
+
 // ################# AddFeedback #################
 type AddFeedbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -132,6 +179,7 @@ func (x *AddFeedbackResponse) GetFeedback() *Feedback {
 type ProductFeedbacksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CakeID        string                 `protobuf:"bytes,1,opt,name=cakeID,proto3" json:"cakeID,omitempty"`
+	Sort          FeedbackSort           `protobuf:"varint,2,opt,name=sort,proto3,enum=feedback.FeedbackSort" json:"sort,omitempty"` // Сортировка отзывов
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,6 +221,13 @@ func (x *ProductFeedbacksRequest) GetCakeID() string {
 	return ""
 }
 
+func (x *ProductFeedbacksRequest) GetSort() FeedbackSort {
+	if x != nil {
+		return x.Sort
+	}
+	return FeedbackSort_NEWEST
+}
+
 type ProductFeedbacksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Feedbacks     []*Feedback            `protobuf:"bytes,1,rep,name=feedbacks,proto3" json:"feedbacks,omitempty"`
@@ -217,6 +272,246 @@ func (x *ProductFeedbacksResponse) GetFeedbacks() []*Feedback {
 	return nil
 }
 
+// ################# VoteFeedback #################
+type VoteFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackID    string                 `protobuf:"bytes,1,opt,name=feedbackID,proto3" json:"feedbackID,omitempty"`
+	Helpful       bool                   `protobuf:"varint,2,opt,name=helpful,proto3" json:"helpful,omitempty"` // true - отметить отзыв полезным, false - снять отметку
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteFeedbackRequest) Reset() {
+	*x = VoteFeedbackRequest{}
+	mi := &file_feedback_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteFeedbackRequest) ProtoMessage() {}
+
+func (x *VoteFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*VoteFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VoteFeedbackRequest) GetFeedbackID() string {
+	if x != nil {
+		return x.FeedbackID
+	}
+	return ""
+}
+
+func (x *VoteFeedbackRequest) GetHelpful() bool {
+	if x != nil {
+		return x.Helpful
+	}
+	return false
+}
+
+type VoteFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HelpfulCount  int32                  `protobuf:"varint,1,opt,name=helpfulCount,proto3" json:"helpfulCount,omitempty"` // Актуальное число отметок "полезно"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteFeedbackResponse) Reset() {
+	*x = VoteFeedbackResponse{}
+	mi := &file_feedback_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteFeedbackResponse) ProtoMessage() {}
+
+func (x *VoteFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*VoteFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VoteFeedbackResponse) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+// ################# ReportFeedback #################
+type ReportFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackID    string                 `protobuf:"bytes,1,opt,name=feedbackID,proto3" json:"feedbackID,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Причина жалобы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportFeedbackRequest) Reset() {
+	*x = ReportFeedbackRequest{}
+	mi := &file_feedback_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportFeedbackRequest) ProtoMessage() {}
+
+func (x *ReportFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ReportFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReportFeedbackRequest) GetFeedbackID() string {
+	if x != nil {
+		return x.FeedbackID
+	}
+	return ""
+}
+
+func (x *ReportFeedbackRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ################# ModerationQueue #################
+type ModerationQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedbacks     []*ReportedFeedback    `protobuf:"bytes,1,rep,name=feedbacks,proto3" json:"feedbacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationQueueResponse) Reset() {
+	*x = ModerationQueueResponse{}
+	mi := &file_feedback_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationQueueResponse) ProtoMessage() {}
+
+func (x *ModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ModerationQueueResponse) GetFeedbacks() []*ReportedFeedback {
+	if x != nil {
+		return x.Feedbacks
+	}
+	return nil
+}
+
+// ################# HideFeedback / RestoreFeedback #################
+type ModerateFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackID    string                 `protobuf:"bytes,1,opt,name=feedbackID,proto3" json:"feedbackID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateFeedbackRequest) Reset() {
+	*x = ModerateFeedbackRequest{}
+	mi := &file_feedback_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateFeedbackRequest) ProtoMessage() {}
+
+func (x *ModerateFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ModerateFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ModerateFeedbackRequest) GetFeedbackID() string {
+	if x != nil {
+		return x.FeedbackID
+	}
+	return ""
+}
+
 type Feedback struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -225,13 +520,14 @@ type Feedback struct {
 	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
 	CakeId        string                 `protobuf:"bytes,5,opt,name=cake_id,json=cakeId,proto3" json:"cake_id,omitempty"`
 	Author        *generated.Profile     `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	HelpfulCount  int32                  `protobuf:"varint,7,opt,name=helpful_count,json=helpfulCount,proto3" json:"helpful_count,omitempty"` // Число отметок "полезно"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Feedback) Reset() {
 	*x = Feedback{}
-	mi := &file_feedback_proto_msgTypes[4]
+	mi := &file_feedback_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +539,7 @@ func (x *Feedback) String() string {
 func (*Feedback) ProtoMessage() {}
 
 func (x *Feedback) ProtoReflect() protoreflect.Message {
-	mi := &file_feedback_proto_msgTypes[4]
+	mi := &file_feedback_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +552,7 @@ func (x *Feedback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
 func (*Feedback) Descriptor() ([]byte, []int) {
-	return file_feedback_proto_rawDescGZIP(), []int{4}
+	return file_feedback_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Feedback) GetId() string {
@@ -301,61 +597,216 @@ func (x *Feedback) GetAuthor() *generated.Profile {
 	return nil
 }
 
+func (x *Feedback) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+// Отзыв с жалобами, ожидающий модерации
+type ReportedFeedback struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Feedback       *Feedback              `protobuf:"bytes,1,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	ReportsCount   int32                  `protobuf:"varint,2,opt,name=reportsCount,proto3" json:"reportsCount,omitempty"`    // Число необработанных жалоб
+	Reasons        []string               `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`               // Причины жалоб
+	LastReportDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastReportDate,proto3" json:"lastReportDate,omitempty"` // Дата последней жалобы
+	IsHidden       bool                   `protobuf:"varint,5,opt,name=isHidden,proto3" json:"isHidden,omitempty"`            // Скрыт ли отзыв
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportedFeedback) Reset() {
+	*x = ReportedFeedback{}
+	mi := &file_feedback_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportedFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportedFeedback) ProtoMessage() {}
+
+func (x *ReportedFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportedFeedback.ProtoReflect.Descriptor instead.
+func (*ReportedFeedback) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReportedFeedback) GetFeedback() *Feedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *ReportedFeedback) GetReportsCount() int32 {
+	if x != nil {
+		return x.ReportsCount
+	}
+	return 0
+}
+
+func (x *ReportedFeedback) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ReportedFeedback) GetLastReportDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReportDate
+	}
+	return nil
+}
+
+func (x *ReportedFeedback) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
 var File_feedback_proto protoreflect.FileDescriptor
 
 var file_feedback_proto_rawDesc = string([]byte{
 	0x0a, 0x0e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x12, 0x41, 0x64,
-	0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61,
-	0x6b, 0x65, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66,
-	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x31, 0x0a, 0x17, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x22, 0x4c,
-	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x65,
-	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x09, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xca, 0x01, 0x0a,
-	0x08, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3f, 0x0a,
-	0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12,
-	0x28, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x32, 0xb6, 0x01, 0x0a, 0x0d, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x41,
-	0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62,
-	0x61, 0x63, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6b,
+	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49,
+	0x44, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x5d, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6b, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6f, 0x72,
+	0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x4c, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x09, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x4f, 0x0a, 0x13, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
+	0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x22, 0x3a, 0x0a, 0x14, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x17, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x09, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x39, 0x0a, 0x17, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x49, 0x44, 0x22, 0xef, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x2a, 0x2c, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x45, 0x57, 0x45,
+	0x53, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x53, 0x54, 0x5f, 0x48, 0x45, 0x4c,
+	0x50, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x32, 0xb7, 0x04, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x65,
 	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x65,
-	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x32, 0x30, 0x32, 0x35, 0x5f, 0x43, 0x61, 0x6b, 0x65, 0x4c,
-	0x61, 0x6e, 0x64, 0x5f, 0x41, 0x50, 0x49, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x1d, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x1f, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x0f, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x48, 0x69, 0x64, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x42, 0x41, 0x5a, 0x3f, 0x32, 0x30, 0x32, 0x35, 0x5f, 0x43, 0x61, 0x6b, 0x65, 0x4c, 0x61, 0x6e,
+	0x64, 0x5f, 0x41, 0x50, 0x49, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -370,30 +821,53 @@ func file_feedback_proto_rawDescGZIP() []byte {
 	return file_feedback_proto_rawDescData
 }
 
-var file_feedback_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_feedback_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_feedback_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_feedback_proto_goTypes = []any{
-	(*AddFeedbackRequest)(nil),       // 0: feedback.AddFeedbackRequest
-	(*AddFeedbackResponse)(nil),      // 1: feedback.AddFeedbackResponse
-	(*ProductFeedbacksRequest)(nil),  // 2: feedback.ProductFeedbacksRequest
-	(*ProductFeedbacksResponse)(nil), // 3: feedback.ProductFeedbacksResponse
-	(*Feedback)(nil),                 // 4: feedback.Feedback
-	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
-	(*generated.Profile)(nil),        // 6: profile.Profile
+	(FeedbackSort)(0),                // 0: feedback.FeedbackSort
+	(*AddFeedbackRequest)(nil),       // 1: feedback.AddFeedbackRequest
+	(*AddFeedbackResponse)(nil),      // 2: feedback.AddFeedbackResponse
+	(*ProductFeedbacksRequest)(nil),  // 3: feedback.ProductFeedbacksRequest
+	(*ProductFeedbacksResponse)(nil), // 4: feedback.ProductFeedbacksResponse
+	(*VoteFeedbackRequest)(nil),      // 5: feedback.VoteFeedbackRequest
+	(*VoteFeedbackResponse)(nil),     // 6: feedback.VoteFeedbackResponse
+	(*ReportFeedbackRequest)(nil),    // 7: feedback.ReportFeedbackRequest
+	(*ModerationQueueResponse)(nil),  // 8: feedback.ModerationQueueResponse
+	(*ModerateFeedbackRequest)(nil),  // 9: feedback.ModerateFeedbackRequest
+	(*Feedback)(nil),                 // 10: feedback.Feedback
+	(*ReportedFeedback)(nil),         // 11: feedback.ReportedFeedback
+	(*timestamppb.Timestamp)(nil),    // 12: google.protobuf.Timestamp
+	(*generated.Profile)(nil),        // 13: profile.Profile
+	(*emptypb.Empty)(nil),            // 14: google.protobuf.Empty
 }
 var file_feedback_proto_depIdxs = []int32{
-	4, // 0: feedback.AddFeedbackResponse.feedback:type_name -> feedback.Feedback
-	4, // 1: feedback.ProductFeedbacksResponse.feedbacks:type_name -> feedback.Feedback
-	5, // 2: feedback.Feedback.date_creation:type_name -> google.protobuf.Timestamp
-	6, // 3: feedback.Feedback.author:type_name -> profile.Profile
-	0, // 4: feedback.ReviewService.AddFeedback:input_type -> feedback.AddFeedbackRequest
-	2, // 5: feedback.ReviewService.ProductFeedbacks:input_type -> feedback.ProductFeedbacksRequest
-	1, // 6: feedback.ReviewService.AddFeedback:output_type -> feedback.AddFeedbackResponse
-	3, // 7: feedback.ReviewService.ProductFeedbacks:output_type -> feedback.ProductFeedbacksResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10, // 0: feedback.AddFeedbackResponse.feedback:type_name -> feedback.Feedback
+	0,  // 1: feedback.ProductFeedbacksRequest.sort:type_name -> feedback.FeedbackSort
+	10, // 2: feedback.ProductFeedbacksResponse.feedbacks:type_name -> feedback.Feedback
+	11, // 3: feedback.ModerationQueueResponse.feedbacks:type_name -> feedback.ReportedFeedback
+	12, // 4: feedback.Feedback.date_creation:type_name -> google.protobuf.Timestamp
+	13, // 5: feedback.Feedback.author:type_name -> profile.Profile
+	10, // 6: feedback.ReportedFeedback.feedback:type_name -> feedback.Feedback
+	12, // 7: feedback.ReportedFeedback.lastReportDate:type_name -> google.protobuf.Timestamp
+	1,  // 8: feedback.ReviewService.AddFeedback:input_type -> feedback.AddFeedbackRequest
+	3,  // 9: feedback.ReviewService.ProductFeedbacks:input_type -> feedback.ProductFeedbacksRequest
+	5,  // 10: feedback.ReviewService.VoteFeedback:input_type -> feedback.VoteFeedbackRequest
+	7,  // 11: feedback.ReviewService.ReportFeedback:input_type -> feedback.ReportFeedbackRequest
+	14, // 12: feedback.ReviewService.ModerationQueue:input_type -> google.protobuf.Empty
+	9,  // 13: feedback.ReviewService.HideFeedback:input_type -> feedback.ModerateFeedbackRequest
+	9,  // 14: feedback.ReviewService.RestoreFeedback:input_type -> feedback.ModerateFeedbackRequest
+	2,  // 15: feedback.ReviewService.AddFeedback:output_type -> feedback.AddFeedbackResponse
+	4,  // 16: feedback.ReviewService.ProductFeedbacks:output_type -> feedback.ProductFeedbacksResponse
+	6,  // 17: feedback.ReviewService.VoteFeedback:output_type -> feedback.VoteFeedbackResponse
+	14, // 18: feedback.ReviewService.ReportFeedback:output_type -> google.protobuf.Empty
+	8,  // 19: feedback.ReviewService.ModerationQueue:output_type -> feedback.ModerationQueueResponse
+	14, // 20: feedback.ReviewService.HideFeedback:output_type -> google.protobuf.Empty
+	14, // 21: feedback.ReviewService.RestoreFeedback:output_type -> google.protobuf.Empty
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_feedback_proto_init() }
@@ -406,13 +880,14 @@ func file_feedback_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_feedback_proto_rawDesc), len(file_feedback_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_feedback_proto_goTypes,
 		DependencyIndexes: file_feedback_proto_depIdxs,
+		EnumInfos:         file_feedback_proto_enumTypes,
 		MessageInfos:      file_feedback_proto_msgTypes,
 	}.Build()
 	File_feedback_proto = out.File
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -21,6 +22,11 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	ReviewService_AddFeedback_FullMethodName      = "/feedback.ReviewService/AddFeedback"
 	ReviewService_ProductFeedbacks_FullMethodName = "/feedback.ReviewService/ProductFeedbacks"
+	ReviewService_VoteFeedback_FullMethodName     = "/feedback.ReviewService/VoteFeedback"
+	ReviewService_ReportFeedback_FullMethodName   = "/feedback.ReviewService/ReportFeedback"
+	ReviewService_ModerationQueue_FullMethodName  = "/feedback.ReviewService/ModerationQueue"
+	ReviewService_HideFeedback_FullMethodName     = "/feedback.ReviewService/HideFeedback"
+	ReviewService_RestoreFeedback_FullMethodName  = "/feedback.ReviewService/RestoreFeedback"
 )
 
 // ReviewServiceClient is the client API for ReviewService service.
@@ -31,6 +37,12 @@ const (
 type ReviewServiceClient interface {
 	AddFeedback(ctx context.Context, in *AddFeedbackRequest, opts ...grpc.CallOption) (*AddFeedbackResponse, error)
 	ProductFeedbacks(ctx context.Context, in *ProductFeedbacksRequest, opts ...grpc.CallOption) (*ProductFeedbacksResponse, error)
+	VoteFeedback(ctx context.Context, in *VoteFeedbackRequest, opts ...grpc.CallOption) (*VoteFeedbackResponse, error)
+	ReportFeedback(ctx context.Context, in *ReportFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Модерация
+	ModerationQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ModerationQueueResponse, error)
+	HideFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type reviewServiceClient struct {
@@ -61,6 +73,56 @@ func (c *reviewServiceClient) ProductFeedbacks(ctx context.Context, in *ProductF
 	return out, nil
 }
 
+func (c *reviewServiceClient) VoteFeedback(ctx context.Context, in *VoteFeedbackRequest, opts ...grpc.CallOption) (*VoteFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteFeedbackResponse)
+	err := c.cc.Invoke(ctx, ReviewService_VoteFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ReportFeedback(ctx context.Context, in *ReportFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReviewService_ReportFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ModerationQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationQueueResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) HideFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReviewService_HideFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) RestoreFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReviewService_RestoreFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServiceServer is the server API for ReviewService service.
 // All implementations must embed UnimplementedReviewServiceServer
 // for forward compatibility.
@@ -69,6 +131,12 @@ func (c *reviewServiceClient) ProductFeedbacks(ctx context.Context, in *ProductF
 type ReviewServiceServer interface {
 	AddFeedback(context.Context, *AddFeedbackRequest) (*AddFeedbackResponse, error)
 	ProductFeedbacks(context.Context, *ProductFeedbacksRequest) (*ProductFeedbacksResponse, error)
+	VoteFeedback(context.Context, *VoteFeedbackRequest) (*VoteFeedbackResponse, error)
+	ReportFeedback(context.Context, *ReportFeedbackRequest) (*emptypb.Empty, error)
+	// Модерация
+	ModerationQueue(context.Context, *emptypb.Empty) (*ModerationQueueResponse, error)
+	HideFeedback(context.Context, *ModerateFeedbackRequest) (*emptypb.Empty, error)
+	RestoreFeedback(context.Context, *ModerateFeedbackRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedReviewServiceServer()
 }
 
@@ -85,6 +153,21 @@ func (UnimplementedReviewServiceServer) AddFeedback(context.Context, *AddFeedbac
 func (UnimplementedReviewServiceServer) ProductFeedbacks(context.Context, *ProductFeedbacksRequest) (*ProductFeedbacksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProductFeedbacks not implemented")
 }
+func (UnimplementedReviewServiceServer) VoteFeedback(context.Context, *VoteFeedbackRequest) (*VoteFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteFeedback not implemented")
+}
+func (UnimplementedReviewServiceServer) ReportFeedback(context.Context, *ReportFeedbackRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFeedback not implemented")
+}
+func (UnimplementedReviewServiceServer) ModerationQueue(context.Context, *emptypb.Empty) (*ModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerationQueue not implemented")
+}
+func (UnimplementedReviewServiceServer) HideFeedback(context.Context, *ModerateFeedbackRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideFeedback not implemented")
+}
+func (UnimplementedReviewServiceServer) RestoreFeedback(context.Context, *ModerateFeedbackRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFeedback not implemented")
+}
 func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
 func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
 
@@ -142,6 +225,96 @@ func _ReviewService_ProductFeedbacks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewService_VoteFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).VoteFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_VoteFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).VoteFeedback(ctx, req.(*VoteFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ReportFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ReportFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ReportFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ReportFeedback(ctx, req.(*ReportFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ModerationQueue(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_HideFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).HideFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_HideFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).HideFeedback(ctx, req.(*ModerateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_RestoreFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).RestoreFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_RestoreFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).RestoreFeedback(ctx, req.(*ModerateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReviewService_ServiceDesc is the grpc.ServiceDesc for ReviewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +330,26 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProductFeedbacks",
 			Handler:    _ReviewService_ProductFeedbacks_Handler,
 		},
+		{
+			MethodName: "VoteFeedback",
+			Handler:    _ReviewService_VoteFeedback_Handler,
+		},
+		{
+			MethodName: "ReportFeedback",
+			Handler:    _ReviewService_ReportFeedback_Handler,
+		},
+		{
+			MethodName: "ModerationQueue",
+			Handler:    _ReviewService_ModerationQueue_Handler,
+		},
+		{
+			MethodName: "HideFeedback",
+			Handler:    _ReviewService_HideFeedback_Handler,
+		},
+		{
+			MethodName: "RestoreFeedback",
+			Handler:    _ReviewService_RestoreFeedback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feedback.proto",
@@ -1,52 +1,40 @@
 package handler
 
 import (
-	"2025_CakeLand_API/internal/domains"
+	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
 	"2025_CakeLand_API/internal/pkg/reviews"
 	gen "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc/generated"
 	"2025_CakeLand_API/internal/pkg/reviews/entities"
-	"2025_CakeLand_API/internal/pkg/utils/jwt"
-	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
 	"context"
-	"fmt"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"log/slog"
 )
 
 type GrpcReviewsHandler struct {
 	gen.UnimplementedReviewServiceServer
 
-	log        *slog.Logger
-	usecase    reviews.IReviewsUsecase
-	mdProvider *md.MetadataProvider
-	tokenator  *jwt.Tokenator
+	log     *slog.Logger
+	usecase reviews.IReviewsUsecase
 }
 
 func NewReviewsHandler(
 	logger *slog.Logger,
 	uc reviews.IReviewsUsecase,
-	mdProvider *md.MetadataProvider,
-	tokenator *jwt.Tokenator,
 ) *GrpcReviewsHandler {
 	return &GrpcReviewsHandler{
-		log:        logger,
-		usecase:    uc,
-		mdProvider: mdProvider,
-		tokenator:  tokenator,
+		log:     logger,
+		usecase: uc,
 	}
 }
 
 func (h *GrpcReviewsHandler) AddFeedback(ctx context.Context, in *gen.AddFeedbackRequest) (*gen.AddFeedbackResponse, error) {
-	// Получаем токен из метаданных
-	accessToken, err := h.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
 	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, h.log, err, fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization))
-	}
-
-	userID, err := h.tokenator.GetUserIDFromToken(accessToken, false)
-	if err != nil {
-		return nil, errs.ConvertToGrpcError(ctx, h.log, err, fmt.Sprintf("missing required token: %s", domains.KeyAuthorization))
+		return nil, err
 	}
 
 	// Валидация
@@ -80,7 +68,7 @@ func (h *GrpcReviewsHandler) ProductFeedbacks(ctx context.Context, in *gen.Produ
 		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "invalid cake id")
 	}
 
-	feedbacks, err := h.usecase.ProductFeedbacks(ctx, cakeID)
+	feedbacks, err := h.usecase.ProductFeedbacks(ctx, cakeID, models.ConvertToFeedbackSortFromGrpc(in.Sort))
 	if err != nil {
 		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create request")
 	}
@@ -94,3 +82,117 @@ func (h *GrpcReviewsHandler) ProductFeedbacks(ctx context.Context, in *gen.Produ
 		Feedbacks: response,
 	}, nil
 }
+
+func (h *GrpcReviewsHandler) VoteFeedback(ctx context.Context, in *gen.VoteFeedbackRequest) (*gen.VoteFeedbackResponse, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Бизнес логика
+	request, err := entities.NewVoteFeedbackReq(in, userID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create request")
+	}
+
+	helpfulCount, err := h.usecase.VoteFeedback(ctx, *request)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to vote feedback")
+	}
+
+	// Ответ
+	return &gen.VoteFeedbackResponse{
+		HelpfulCount: int32(helpfulCount),
+	}, nil
+}
+
+func (h *GrpcReviewsHandler) ReportFeedback(ctx context.Context, in *gen.ReportFeedbackRequest) (*emptypb.Empty, error) {
+	// Получаем пользователя из контекста
+	userID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Бизнес логика
+	request, err := entities.NewReportFeedbackReq(in, userID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to create request")
+	}
+
+	// Валидация
+	if request.Reason == "" {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidInput, "reason is required")
+	}
+
+	if err = h.usecase.ReportFeedback(ctx, *request); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to report feedback")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GrpcReviewsHandler) ModerationQueue(ctx context.Context, _ *emptypb.Empty) (*gen.ModerationQueueResponse, error) {
+	// Получаем модератора из контекста
+	moderatorID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Бизнес логика
+	queue, err := h.usecase.ModerationQueue(ctx, moderatorID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to fetch moderation queue")
+	}
+
+	// Ответ
+	response := make([]*gen.ReportedFeedback, len(queue))
+	for i, item := range queue {
+		response[i] = item.ConvertToGRPC()
+	}
+
+	return &gen.ModerationQueueResponse{
+		Feedbacks: response,
+	}, nil
+}
+
+func (h *GrpcReviewsHandler) HideFeedback(ctx context.Context, in *gen.ModerateFeedbackRequest) (*emptypb.Empty, error) {
+	return h.setFeedbackHidden(ctx, in, true)
+}
+
+func (h *GrpcReviewsHandler) RestoreFeedback(ctx context.Context, in *gen.ModerateFeedbackRequest) (*emptypb.Empty, error) {
+	return h.setFeedbackHidden(ctx, in, false)
+}
+
+func (h *GrpcReviewsHandler) setFeedbackHidden(ctx context.Context, in *gen.ModerateFeedbackRequest, hidden bool) (*emptypb.Empty, error) {
+	// Получаем модератора из контекста
+	moderatorID, err := h.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Параметры
+	feedbackID, err := uuid.Parse(in.FeedbackID)
+	if err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, errs.ErrInvalidUUIDFormat, "invalid feedback id")
+	}
+
+	// Бизнес логика
+	if err = h.usecase.SetFeedbackHidden(ctx, moderatorID, feedbackID, hidden); err != nil {
+		return nil, errs.ConvertToGrpcError(ctx, h.log, err, "failed to moderate feedback")
+	}
+
+	// Ответ
+	return &emptypb.Empty{}, nil
+}
+
+// userID Возвращает пользователя, которого положил в контекст authz.AuthUnaryInterceptor
+func (h *GrpcReviewsHandler) userID(ctx context.Context) (uuid.UUID, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return uuid.Nil, errs.ConvertToGrpcError(ctx, h.log, err, "unauthenticated request")
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,11 @@
+package handler
+
+import (
+	gen "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+// MethodPolicies Методы сервиса отзывов, которым не нужен токен. Остальные требуют авторизации
+var MethodPolicies = authz.MethodPolicies{
+	gen.ReviewService_ProductFeedbacks_FullMethodName: authz.PolicyPublic,
+}
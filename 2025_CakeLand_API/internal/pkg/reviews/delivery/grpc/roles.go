@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"2025_CakeLand_API/internal/models"
+	gen "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/utils/authz"
+)
+
+var (
+	customerRoles  = models.Roles{models.RoleCustomer}
+	moderatorRoles = models.Roles{models.RoleModerator, models.RoleAdmin}
+)
+
+// MethodRoles Методы сервиса отзывов, доступные только части ролей
+var MethodRoles = authz.MethodRoles{
+	gen.ReviewService_AddFeedback_FullMethodName:    customerRoles,
+	gen.ReviewService_VoteFeedback_FullMethodName:   customerRoles,
+	gen.ReviewService_ReportFeedback_FullMethodName: customerRoles,
+
+	// Модерация отзывов
+	gen.ReviewService_ModerationQueue_FullMethodName: moderatorRoles,
+	gen.ReviewService_HideFeedback_FullMethodName:    moderatorRoles,
+	gen.ReviewService_RestoreFeedback_FullMethodName: moderatorRoles,
+}
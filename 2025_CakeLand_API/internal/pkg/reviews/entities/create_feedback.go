@@ -14,20 +14,16 @@ type CreateFeedbackReq struct {
 	AuthorID uuid.UUID
 }
 
-func NewCreateFeedbackReq(req *gen.AddFeedbackRequest, authorID string) (*CreateFeedbackReq, error) {
+func NewCreateFeedbackReq(req *gen.AddFeedbackRequest, authorID uuid.UUID) (*CreateFeedbackReq, error) {
 	cakeID, err := uuid.Parse(req.GetCakeID())
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
 	}
-	authorUID, err := uuid.Parse(authorID)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
-	}
 
 	return &CreateFeedbackReq{
 		Text:     req.GetText(),
 		Rating:   int(req.GetRating()),
 		CakeID:   cakeID,
-		AuthorID: authorUID,
+		AuthorID: authorID,
 	}, nil
 }
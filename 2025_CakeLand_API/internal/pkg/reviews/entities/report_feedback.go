@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc/generated"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type ReportFeedbackReq struct {
+	FeedbackID uuid.UUID
+	ReporterID uuid.UUID
+	Reason     string
+}
+
+func NewReportFeedbackReq(req *gen.ReportFeedbackRequest, reporterID uuid.UUID) (*ReportFeedbackReq, error) {
+	feedbackID, err := uuid.Parse(req.GetFeedbackID())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
+	}
+
+	return &ReportFeedbackReq{
+		FeedbackID: feedbackID,
+		ReporterID: reporterID,
+		Reason:     strings.TrimSpace(req.GetReason()),
+	}, nil
+}
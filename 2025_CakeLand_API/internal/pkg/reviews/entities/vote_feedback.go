@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	gen "2025_CakeLand_API/internal/pkg/reviews/delivery/grpc/generated"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+type VoteFeedbackReq struct {
+	FeedbackID uuid.UUID
+	UserID     uuid.UUID
+	Helpful    bool
+}
+
+func NewVoteFeedbackReq(req *gen.VoteFeedbackRequest, userID uuid.UUID) (*VoteFeedbackReq, error) {
+	feedbackID, err := uuid.Parse(req.GetFeedbackID())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidUUIDFormat, err)
+	}
+
+	return &VoteFeedbackReq{
+		FeedbackID: feedbackID,
+		UserID:     userID,
+		Helpful:    req.GetHelpful(),
+	}, nil
+}
@@ -9,10 +9,20 @@ import (
 
 type IReviewsUsecase interface {
 	CreateFeedback(context.Context, entities.CreateFeedbackReq) (*models.Feedback, error)
-	ProductFeedbacks(context.Context, uuid.UUID) ([]models.Feedback, error)
+	ProductFeedbacks(context.Context, uuid.UUID, models.FeedbackSort) ([]models.Feedback, error)
+	VoteFeedback(context.Context, entities.VoteFeedbackReq) (int, error)
+	ReportFeedback(context.Context, entities.ReportFeedbackReq) error
+	ModerationQueue(ctx context.Context, moderatorID uuid.UUID) ([]models.ReportedFeedback, error)
+	SetFeedbackHidden(ctx context.Context, moderatorID, feedbackID uuid.UUID, hidden bool) error
 }
 
 type IReviewsRepository interface {
 	AddFeedback(context.Context, *models.FeedbackDB) error
-	ProductFeedbacks(context.Context, uuid.UUID) ([]models.FeedbackDB, error)
+	ProductFeedbacks(context.Context, uuid.UUID, models.FeedbackSort) ([]models.FeedbackDB, error)
+	FeedbackByID(context.Context, uuid.UUID) (*models.FeedbackDB, error)
+	VoteFeedback(ctx context.Context, feedbackID, userID uuid.UUID, helpful bool) (int, error)
+	AddFeedbackReport(context.Context, *models.FeedbackReport) error
+	ModerationQueue(context.Context) ([]models.ReportedFeedbackDB, error)
+	SetFeedbackHidden(ctx context.Context, feedbackID uuid.UUID, hidden bool) error
+	IsModerator(context.Context, uuid.UUID) (bool, error)
 }
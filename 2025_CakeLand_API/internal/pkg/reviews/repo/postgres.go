@@ -7,11 +7,49 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const (
-	queryProductFeedbacks   = `SELECT id, text, date_creation, rating, cake_id, author_id FROM feedback WHERE cake_id = $1`
-	queryAddProductFeedback = `INSERT INTO feedback (id, text, rating, cake_id, author_id) VALUES ($1, $2, $3, $4, $5)`
+	queryProductFeedbacks = `
+		SELECT id, text, date_creation, rating, cake_id, author_id, helpful_count, is_hidden
+		FROM feedback
+		WHERE cake_id = $1 AND NOT is_hidden
+	`
+	orderFeedbacksNewest      = ` ORDER BY date_creation DESC, id`
+	orderFeedbacksMostHelpful = ` ORDER BY helpful_count DESC, date_creation DESC, id`
+	queryAddProductFeedback   = `INSERT INTO feedback (id, text, rating, cake_id, author_id) VALUES ($1, $2, $3, $4, $5)`
+	queryFeedbackByID         = `
+		SELECT id, text, date_creation, rating, cake_id, author_id, helpful_count, is_hidden
+		FROM feedback
+		WHERE id = $1
+	`
+	queryAddFeedbackVote = `
+		INSERT INTO feedback_vote (feedback_id, user_id) VALUES ($1, $2)
+		ON CONFLICT (feedback_id, user_id) DO NOTHING
+	`
+	queryRemoveFeedbackVote = `DELETE FROM feedback_vote WHERE feedback_id = $1 AND user_id = $2`
+	queryFeedbackHelpful    = `SELECT helpful_count FROM feedback WHERE id = $1`
+	queryAddFeedbackReport  = `
+		INSERT INTO feedback_report (id, feedback_id, reporter_id, reason) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (feedback_id, reporter_id) DO UPDATE
+			SET reason        = EXCLUDED.reason,
+				status        = 'pending',
+				date_creation = CURRENT_TIMESTAMP
+	`
+	queryModerationQueue = `
+		SELECT f.id, f.text, f.date_creation, f.rating, f.cake_id, f.author_id, f.helpful_count, f.is_hidden,
+			   COUNT(r.id)                                   AS reports_count,
+			   ARRAY_AGG(r.reason ORDER BY r.date_creation) AS reasons,
+			   MAX(r.date_creation)                          AS last_report_date
+		FROM feedback f
+				 JOIN feedback_report r ON r.feedback_id = f.id AND r.status = 'pending'
+		GROUP BY f.id
+		ORDER BY reports_count DESC, last_report_date
+	`
+	querySetFeedbackHidden = `UPDATE feedback SET is_hidden = $1 WHERE id = $2`
+	queryResolveReports    = `UPDATE feedback_report SET status = 'resolved' WHERE feedback_id = $1 AND status = 'pending'`
+	queryIsModerator       = `SELECT roles && '{moderator,admin}'::user_role[] FROM "user" WHERE id = $1`
 )
 
 type ReviewsRepository struct {
@@ -36,10 +74,15 @@ func (r *ReviewsRepository) AddFeedback(ctx context.Context, feedback *models.Fe
 	return nil
 }
 
-func (r *ReviewsRepository) ProductFeedbacks(ctx context.Context, id uuid.UUID) ([]models.FeedbackDB, error) {
+func (r *ReviewsRepository) ProductFeedbacks(ctx context.Context, id uuid.UUID, sort models.FeedbackSort) ([]models.FeedbackDB, error) {
 	const methodName = "[Repo.ProductFeedbacks]"
 
-	rows, err := r.db.QueryContext(ctx, queryProductFeedbacks, id)
+	query := queryProductFeedbacks + orderFeedbacksNewest
+	if sort == models.FeedbackSortMostHelpful {
+		query = queryProductFeedbacks + orderFeedbacksMostHelpful
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, id)
 	if err != nil {
 		return nil, errs.WrapDBError(methodName, err)
 	}
@@ -55,6 +98,8 @@ func (r *ReviewsRepository) ProductFeedbacks(ctx context.Context, id uuid.UUID)
 			&feedback.Rating,
 			&feedback.CakeID,
 			&feedback.AuthorID,
+			&feedback.HelpfulCount,
+			&feedback.IsHidden,
 		); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return nil, errs.ErrNotFound
@@ -71,3 +116,159 @@ func (r *ReviewsRepository) ProductFeedbacks(ctx context.Context, id uuid.UUID)
 
 	return feedbacks, nil
 }
+
+func (r *ReviewsRepository) FeedbackByID(ctx context.Context, id uuid.UUID) (*models.FeedbackDB, error) {
+	const methodName = "[Repo.FeedbackByID]"
+
+	var feedback models.FeedbackDB
+	if err := r.db.QueryRowContext(ctx, queryFeedbackByID, id).Scan(
+		&feedback.ID,
+		&feedback.Text,
+		&feedback.DateCreation,
+		&feedback.Rating,
+		&feedback.CakeID,
+		&feedback.AuthorID,
+		&feedback.HelpfulCount,
+		&feedback.IsHidden,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return &feedback, nil
+}
+
+func (r *ReviewsRepository) VoteFeedback(ctx context.Context, feedbackID, userID uuid.UUID, helpful bool) (int, error) {
+	const methodName = "[Repo.VoteFeedback]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	// Ставим или снимаем голос, счётчик обновляет триггер
+	query := queryRemoveFeedbackVote
+	if helpful {
+		query = queryAddFeedbackVote
+	}
+	if _, err = tx.ExecContext(ctx, query, feedbackID, userID); err != nil {
+		_ = tx.Rollback()
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	var helpfulCount int
+	if err = tx.QueryRowContext(ctx, queryFeedbackHelpful, feedbackID).Scan(&helpfulCount); err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errs.ErrNotFound
+		}
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, errs.WrapDBError(methodName, err)
+	}
+
+	return helpfulCount, nil
+}
+
+func (r *ReviewsRepository) AddFeedbackReport(ctx context.Context, report *models.FeedbackReport) error {
+	const methodName = "[Repo.AddFeedbackReport]"
+
+	if _, err := r.db.ExecContext(ctx, queryAddFeedbackReport,
+		report.ID, report.FeedbackID, report.ReporterID, report.Reason,
+	); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewsRepository) ModerationQueue(ctx context.Context) ([]models.ReportedFeedbackDB, error) {
+	const methodName = "[Repo.ModerationQueue]"
+
+	rows, err := r.db.QueryContext(ctx, queryModerationQueue)
+	if err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	defer rows.Close()
+	var queue []models.ReportedFeedbackDB
+	for rows.Next() {
+		var (
+			item    models.ReportedFeedbackDB
+			reasons pq.StringArray
+		)
+		if err = rows.Scan(
+			&item.Feedback.ID,
+			&item.Feedback.Text,
+			&item.Feedback.DateCreation,
+			&item.Feedback.Rating,
+			&item.Feedback.CakeID,
+			&item.Feedback.AuthorID,
+			&item.Feedback.HelpfulCount,
+			&item.Feedback.IsHidden,
+			&item.ReportsCount,
+			&reasons,
+			&item.LastReportDate,
+		); err != nil {
+			return nil, errs.WrapDBError(methodName, err)
+		}
+
+		item.Reasons = reasons
+		queue = append(queue, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errs.WrapDBError(methodName, err)
+	}
+
+	return queue, nil
+}
+
+func (r *ReviewsRepository) SetFeedbackHidden(ctx context.Context, feedbackID uuid.UUID, hidden bool) error {
+	const methodName = "[Repo.SetFeedbackHidden]"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	res, err := tx.ExecContext(ctx, querySetFeedbackHidden, hidden, feedbackID)
+	if err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		_ = tx.Rollback()
+		return errs.ErrNotFound
+	}
+
+	// Решение модератора закрывает все открытые жалобы на отзыв
+	if _, err = tx.ExecContext(ctx, queryResolveReports, feedbackID); err != nil {
+		_ = tx.Rollback()
+		return errs.WrapDBError(methodName, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.WrapDBError(methodName, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewsRepository) IsModerator(ctx context.Context, userID uuid.UUID) (bool, error) {
+	const methodName = "[Repo.IsModerator]"
+
+	var isModerator bool
+	if err := r.db.QueryRowContext(ctx, queryIsModerator, userID).Scan(&isModerator); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, errs.ErrNotFound
+		}
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return isModerator, nil
+}
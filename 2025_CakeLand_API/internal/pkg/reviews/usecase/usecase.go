@@ -2,12 +2,14 @@ package usecase
 
 import (
 	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
 	profileGen "2025_CakeLand_API/internal/pkg/profile/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/profile/loader"
 	"2025_CakeLand_API/internal/pkg/reviews"
 	"2025_CakeLand_API/internal/pkg/reviews/entities"
 	"context"
+	"fmt"
 	"github.com/google/uuid"
-	"sync"
 )
 
 type ReviewsUseсase struct {
@@ -54,43 +56,125 @@ func (u *ReviewsUseсase) CreateFeedback(ctx context.Context, req entities.Creat
 	return &feedback, err
 }
 
-func (u *ReviewsUseсase) ProductFeedbacks(ctx context.Context, productID uuid.UUID) ([]models.Feedback, error) {
-	dbFeedbacks, err := u.repo.ProductFeedbacks(ctx, productID)
+func (u *ReviewsUseсase) ProductFeedbacks(ctx context.Context, productID uuid.UUID, sort models.FeedbackSort) ([]models.Feedback, error) {
+	dbFeedbacks, err := u.repo.ProductFeedbacks(ctx, productID, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получаем данные по авторам одним запросом
+	authorIDs := make([]uuid.UUID, len(dbFeedbacks))
+	for i, feedback := range dbFeedbacks {
+		authorIDs[i] = feedback.AuthorID
+	}
+
+	authors, err := u.authorsByIDs(ctx, authorIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	// Получаем данные по авторам
 	feedbacks := make([]models.Feedback, len(dbFeedbacks))
-	mu := &sync.Mutex{}
-	wg := sync.WaitGroup{}
 	for i, feedback := range dbFeedbacks {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if ctx.Err() != nil {
-				return
-			}
+		feedbacks[i] = feedback.ConvertToFeedback(authors[feedback.AuthorID])
+	}
 
-			res, errDB := u.profileClient.GetUserInfoByID(ctx, &profileGen.GetUserInfoByIDReq{
-				UserID: feedback.AuthorID.String(),
-			})
-			if errDB != nil {
-				return
-			}
+	return feedbacks, nil
+}
 
-			user := models.NewUserInfo(res.User)
-			if user == nil {
-				return
-			}
+func (u *ReviewsUseсase) VoteFeedback(ctx context.Context, req entities.VoteFeedbackReq) (int, error) {
+	feedback, err := u.repo.FeedbackByID(ctx, req.FeedbackID)
+	if err != nil {
+		return 0, err
+	}
 
-			mu.Lock()
-			feedbacks[i] = feedback.ConvertToFeedback(*user)
-			mu.Unlock()
-		}()
+	// Голосовать за свой отзыв нельзя
+	if feedback.AuthorID == req.UserID {
+		return 0, fmt.Errorf("%w: can't vote for own feedback", errs.ErrInvalidInput)
 	}
 
-	wg.Wait()
+	return u.repo.VoteFeedback(ctx, req.FeedbackID, req.UserID, req.Helpful)
+}
 
-	return feedbacks, nil
+func (u *ReviewsUseсase) ReportFeedback(ctx context.Context, req entities.ReportFeedbackReq) error {
+	if _, err := u.repo.FeedbackByID(ctx, req.FeedbackID); err != nil {
+		return err
+	}
+
+	return u.repo.AddFeedbackReport(ctx, &models.FeedbackReport{
+		ID:         uuid.New(),
+		FeedbackID: req.FeedbackID,
+		ReporterID: req.ReporterID,
+		Reason:     req.Reason,
+	})
+}
+
+func (u *ReviewsUseсase) ModerationQueue(ctx context.Context, moderatorID uuid.UUID) ([]models.ReportedFeedback, error) {
+	if err := u.checkModerator(ctx, moderatorID); err != nil {
+		return nil, err
+	}
+
+	dbQueue, err := u.repo.ModerationQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получаем данные по авторам одним запросом
+	authorIDs := make([]uuid.UUID, len(dbQueue))
+	for i, item := range dbQueue {
+		authorIDs[i] = item.Feedback.AuthorID
+	}
+
+	authors, err := u.authorsByIDs(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]models.ReportedFeedback, len(dbQueue))
+	for i, item := range dbQueue {
+		queue[i] = item.ConvertToReportedFeedback(authors[item.Feedback.AuthorID])
+	}
+
+	return queue, nil
+}
+
+func (u *ReviewsUseсase) SetFeedbackHidden(ctx context.Context, moderatorID, feedbackID uuid.UUID, hidden bool) error {
+	if err := u.checkModerator(ctx, moderatorID); err != nil {
+		return err
+	}
+
+	return u.repo.SetFeedbackHidden(ctx, feedbackID, hidden)
+}
+
+func (u *ReviewsUseсase) checkModerator(ctx context.Context, userID uuid.UUID) error {
+	isModerator, err := u.repo.IsModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isModerator {
+		return errs.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// authorsByIDs Получает данные авторов отзывов одним запросом в сервис профиля
+func (u *ReviewsUseсase) authorsByIDs(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]models.UserInfo, error) {
+	ids := make([]string, len(authorIDs))
+	for i, id := range authorIDs {
+		ids[i] = id.String()
+	}
+
+	users, err := loader.FromContext(ctx, u.profileClient).Load(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := make(map[uuid.UUID]models.UserInfo, len(users))
+	for _, id := range authorIDs {
+		if user, ok := users[id.String()]; ok {
+			authors[id] = *models.NewUserInfo(user)
+		}
+	}
+
+	return authors, nil
 }
@@ -0,0 +1,66 @@
+package sms
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// LogSender Для локального запуска: сообщение вместе с кодом пишется в лог вместо отправки
+type LogSender struct {
+	log *slog.Logger
+}
+
+func NewLogSender(log *slog.Logger) *LogSender {
+	return &LogSender{
+		log: log,
+	}
+}
+
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	s.log.InfoContext(ctx, "SMS не отправлено: включена отправка в лог",
+		slog.String("to", msg.To),
+		slog.String("text", msg.Text),
+	)
+	return nil
+}
+
+// MemorySender Сообщения остаются в памяти: для тестов
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+func (s *MemorySender) Send(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// Messages Отправленные сообщения в порядке отправки
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+// Last Последнее сообщение на номер
+func (s *MemorySender) Last(to string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].To == to {
+			return s.messages[i], true
+		}
+	}
+
+	return Message{}, false
+}
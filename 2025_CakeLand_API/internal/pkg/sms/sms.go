@@ -0,0 +1,36 @@
+package sms
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// Message SMS на номер в формате 7XXXXXXXXXX
+type Message struct {
+	To   string
+	Text string
+}
+
+// Sender Отправка SMS. Реализация выбирается конфигом. Шлюз оператора подключается отдельной реализацией,
+// локально сообщения пишутся в лог или остаются в памяти
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+const (
+	KindLog    = "log"
+	KindMemory = "memory"
+)
+
+func NewSender(conf *config.SMSConfig, log *slog.Logger) (Sender, error) {
+	switch conf.Kind {
+	case KindLog:
+		return NewLogSender(log), nil
+	case KindMemory:
+		return NewMemorySender(), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный тип отправки SMS: %q", conf.Kind)
+}
@@ -0,0 +1,150 @@
+package authz
+
+import (
+	"2025_CakeLand_API/internal/domains"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	md "2025_CakeLand_API/internal/pkg/utils/metadata"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"log/slog"
+)
+
+// tokenDenylist Проверка отзыва access токена по jti
+type tokenDenylist interface {
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// authenticator Проверяет access токен из метаданных и кладёт пользователя в контекст
+type authenticator struct {
+	log        *slog.Logger
+	tokenator  *jwt.Tokenator
+	denylist   tokenDenylist
+	mdProvider *md.MetadataProvider
+	policies   MethodPolicies
+}
+
+func AuthUnaryInterceptor(log *slog.Logger, tokenator *jwt.Tokenator, denylist *AccessDenylist, policies MethodPolicies) grpc.UnaryServerInterceptor {
+	a := newAuthenticator(log, tokenator, denylist, policies)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func AuthStreamInterceptor(log *slog.Logger, tokenator *jwt.Tokenator, denylist *AccessDenylist, policies MethodPolicies) grpc.StreamServerInterceptor {
+	a := newAuthenticator(log, tokenator, denylist, policies)
+
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &principalStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func newAuthenticator(log *slog.Logger, tokenator *jwt.Tokenator, denylist tokenDenylist, policies MethodPolicies) *authenticator {
+	return &authenticator{
+		log:        log,
+		tokenator:  tokenator,
+		denylist:   denylist,
+		mdProvider: md.NewMetadataProvider(),
+		policies:   policies,
+	}
+}
+
+func (a *authenticator) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	policy := a.policies[fullMethod]
+	if policy == PolicyPublic {
+		return ctx, nil
+	}
+
+	// Получаем токен из метаданных
+	accessToken, err := a.mdProvider.GetValue(ctx, domains.KeyAuthorization)
+	if err != nil || accessToken == "" {
+		if policy == PolicyOptional {
+			return ctx, nil
+		}
+		return nil, errs.ConvertToGrpcError(ctx, a.log, errs.ErrNoToken,
+			fmt.Sprintf("missing required metadata: %s", domains.KeyAuthorization),
+		)
+	}
+
+	principal, msg, err := a.principal(ctx, accessToken)
+	if err != nil {
+		// Публичным методам сохранённый у клиента просроченный или отозванный токен не мешает: работаем как с гостем.
+		// Сбой самой проверки (например, недоступна бд денайлиста) гостем не прикрываем
+		if policy == PolicyOptional && isInvalidToken(err) {
+			return ctx, nil
+		}
+		return nil, errs.ConvertToGrpcError(ctx, a.log, err, msg)
+	}
+
+	return WithPrincipal(ctx, principal), nil
+}
+
+// principal Пользователь из access токена. При ошибке возвращает и сообщение для лога
+func (a *authenticator) principal(ctx context.Context, accessToken string) (Principal, string, error) {
+	claims, err := a.tokenator.ParseAccessToken(accessToken)
+	if err != nil {
+		return Principal{}, "failed to fetch user id from token", err
+	}
+
+	// Токен вышедшей сессии не принимается до своего истечения
+	revoked, err := a.denylist.IsRevoked(ctx, claims.TokenID)
+	if err != nil {
+		return Principal{}, "failed to check access token", err
+	}
+	if revoked {
+		return Principal{}, "access token is revoked", errs.ErrTokenRevoked
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return Principal{}, "invalid user id in token", errs.ErrInvalidUUIDFormat
+	}
+
+	return Principal{
+		UserID: userID,
+		Roles:  claims.Roles,
+	}, "", nil
+}
+
+// isInvalidToken Ошибка в самом токене: не разбирается, просрочен, отозван или с чужими claims
+func isInvalidToken(err error) bool {
+	return errors.Is(err, errs.ErrParsingToken) ||
+		errors.Is(err, errs.ErrTokenIsExpired) ||
+		errors.Is(err, errs.ErrInvalidTokenOrClaims) ||
+		errors.Is(err, errs.ErrTokenRevoked) ||
+		errors.Is(err, errs.ErrInvalidUUIDFormat)
+}
+
+// principalStream Поток с контекстом, в котором лежит пользователь
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,84 @@
+package authz
+
+import (
+	"2025_CakeLand_API/internal/domains"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthenticator_InvalidToken(t *testing.T) {
+	policies := MethodPolicies{
+		"/cake.CakeService/Cakes":   PolicyOptional,
+		"/cake.CakeService/AddCake": PolicyRequired,
+	}
+	a := newAuthenticator(slog.New(slog.NewTextHandler(io.Discard, nil)), jwt.NewTokenator(), nil, policies)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(string(domains.KeyAuthorization), "expired.or.garbage"))
+
+	t.Run("Optional policy treats it as anonymous", func(t *testing.T) {
+		got, err := a.authenticate(ctx, "/cake.CakeService/Cakes")
+		require.NoError(t, err)
+		_, ok := PrincipalFromContext(got)
+		require.False(t, ok)
+	})
+
+	t.Run("Required policy rejects it", func(t *testing.T) {
+		_, err := a.authenticate(ctx, "/cake.CakeService/AddCake")
+		require.Error(t, err)
+	})
+}
+
+// fakeDenylist Денайлист с заданным ответом
+type fakeDenylist struct {
+	revoked bool
+	err     error
+}
+
+func (d fakeDenylist) IsRevoked(context.Context, string) (bool, error) {
+	return d.revoked, d.err
+}
+
+func TestAuthenticator_Denylist(t *testing.T) {
+	t.Setenv("ACCESS_SIGN", "test-access-sign")
+	tokenator := jwt.NewTokenator()
+	token, err := tokenator.GenerateAccessToken(uuid.NewString(), nil)
+	require.NoError(t, err)
+
+	const method = "/cake.CakeService/Cakes"
+	policies := MethodPolicies{method: PolicyOptional}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(string(domains.KeyAuthorization), token.Token))
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("Revoked token is anonymous under optional policy", func(t *testing.T) {
+		a := newAuthenticator(logger, tokenator, fakeDenylist{revoked: true}, policies)
+
+		got, err := a.authenticate(ctx, method)
+		require.NoError(t, err)
+		_, ok := PrincipalFromContext(got)
+		require.False(t, ok)
+	})
+
+	t.Run("Denylist failure is not hidden under optional policy", func(t *testing.T) {
+		a := newAuthenticator(logger, tokenator, fakeDenylist{err: errs.WrapDBError("[test]", errors.New("connection refused"))}, policies)
+
+		_, err := a.authenticate(ctx, method)
+		require.Error(t, err)
+	})
+
+	t.Run("Valid token", func(t *testing.T) {
+		a := newAuthenticator(logger, tokenator, fakeDenylist{}, policies)
+
+		got, err := a.authenticate(ctx, method)
+		require.NoError(t, err)
+		_, ok := PrincipalFromContext(got)
+		require.True(t, ok)
+	})
+}
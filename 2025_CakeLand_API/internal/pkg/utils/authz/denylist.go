@@ -0,0 +1,33 @@
+package authz
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	"context"
+	"database/sql"
+)
+
+// Запись добавляет auth при удалении сессии (выход, отзыв сессии, смена пароля), истёкшие записи там же удаляются
+const isAccessTokenRevokedCommand = `SELECT EXISTS (SELECT 1 FROM access_token_denylist WHERE jti = $1 AND expires_at > now())`
+
+// AccessDenylist Access токены, отозванные до истечения срока. Проверяется всеми сервисами по jti
+type AccessDenylist struct {
+	db *sql.DB
+}
+
+func NewAccessDenylist(db *sql.DB) *AccessDenylist {
+	return &AccessDenylist{
+		db: db,
+	}
+}
+
+// IsRevoked Отозван ли access токен с этим jti
+func (d *AccessDenylist) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	const methodName = "[AccessDenylist.IsRevoked]"
+
+	var revoked bool
+	if err := d.db.QueryRowContext(ctx, isAccessTokenRevokedCommand, tokenID).Scan(&revoked); err != nil {
+		return false, errs.WrapDBError(methodName, err)
+	}
+
+	return revoked, nil
+}
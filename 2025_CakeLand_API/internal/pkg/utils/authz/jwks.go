@@ -0,0 +1,81 @@
+package authz
+
+import (
+	"2025_CakeLand_API/internal/models"
+	authGen "2025_CakeLand_API/internal/pkg/auth/delivery/grpc/generated"
+	"2025_CakeLand_API/internal/pkg/config"
+	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+	"time"
+)
+
+// jwksRetryDelay Пока JWKS не получен (auth ещё не поднялся), запрашиваем его чаще
+const jwksRetryDelay = 5 * time.Second
+
+// NewVerifyingTokenator Токенатор сервиса, который только проверяет access токены.
+// Для RS256/EdDSA ключи берутся из конфига и периодически перечитываются из JWKS сервиса auth.
+// Возвращаемая функция останавливает обновление
+func NewVerifyingTokenator(log *slog.Logger, conf *config.Config) (*jwt.Tokenator, func(), error) {
+	tokenator, err := jwt.NewVerifyingTokenator(&conf.JWT)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !tokenator.Asymmetric() || conf.JWT.JWKSRefresh <= 0 {
+		return tokenator, func() {}, nil
+	}
+
+	// Клиент сервиса авторизации
+	conn, err := grpc.Dial(
+		fmt.Sprintf("localhost:%d", conf.GRPC.AuthPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go refreshJWKS(ctx, log, tokenator, authGen.NewAuthClient(conn), conf.JWT.JWKSRefresh)
+
+	return tokenator, func() {
+		cancel()
+		_ = conn.Close()
+	}, nil
+}
+
+func refreshJWKS(ctx context.Context, log *slog.Logger, tokenator *jwt.Tokenator, client authGen.AuthClient, interval time.Duration) {
+	for {
+		delay := interval
+		if err := fetchJWKS(ctx, tokenator, client); err != nil {
+			log.Warn("failed to refresh JWKS", slog.String("error", err.Error()))
+			delay = min(interval, jwksRetryDelay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func fetchJWKS(ctx context.Context, tokenator *jwt.Tokenator, client authGen.AuthClient) error {
+	ctx, cancel := context.WithTimeout(ctx, jwksRetryDelay)
+	defer cancel()
+
+	res, err := client.GetJWKS(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]models.JWK, len(res.Keys))
+	for i, key := range res.Keys {
+		keys[i] = models.NewJWK(key)
+	}
+
+	return tokenator.SetRemoteJWKS(keys)
+}
@@ -0,0 +1,13 @@
+package authz
+
+// Policy Нужна ли методу авторизация
+type Policy int
+
+const (
+	PolicyRequired Policy = iota // Без валидного токена метод недоступен (по умолчанию)
+	PolicyOptional               // Токен необязателен. Невалидный, просроченный или отозванный токен — как его отсутствие
+	PolicyPublic                 // Токен не проверяется
+)
+
+// MethodPolicies Политики методов (ключ — полное имя gRPC метода). Методы без записи требуют авторизации
+type MethodPolicies map[string]Policy
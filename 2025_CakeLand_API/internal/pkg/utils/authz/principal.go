@@ -0,0 +1,48 @@
+package authz
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"context"
+	"github.com/google/uuid"
+)
+
+type principalKey struct{}
+
+// Principal Пользователь, от имени которого выполняется запрос
+type Principal struct {
+	UserID uuid.UUID
+	Roles  models.Roles
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext Возвращает пользователя, если запрос авторизован
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// UserID Возвращает ID авторизованного пользователя. ErrNoToken, если запрос без токена
+func UserID(ctx context.Context) (uuid.UUID, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errs.ErrNoToken
+	}
+
+	return principal.UserID, nil
+}
+
+// ViewerID Возвращает ID пользователя для методов с необязательной авторизацией
+func ViewerID(ctx context.Context) uuid.NullUUID {
+	principal, ok := PrincipalFromContext(ctx)
+	return uuid.NullUUID{UUID: principal.UserID, Valid: ok}
+}
+
+// HasAnyRole Есть ли у авторизованного пользователя хотя бы одна из ролей
+func HasAnyRole(ctx context.Context, roles ...models.Role) bool {
+	principal, ok := PrincipalFromContext(ctx)
+	return ok && principal.Roles.HasAny(roles...)
+}
@@ -0,0 +1,39 @@
+package authz
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"context"
+	"google.golang.org/grpc"
+	"log/slog"
+)
+
+// MethodRoles Роли, которым разрешён вызов метода (ключ — полное имя gRPC метода).
+// Методы без записи доступны всем
+type MethodRoles map[string]models.Roles
+
+// RolesUnaryInterceptor Пропускает вызов, только если у пользователя есть одна из ролей метода.
+// Ставится после AuthUnaryInterceptor: роли берутся из пользователя в контексте
+func RolesUnaryInterceptor(log *slog.Logger, rules MethodRoles) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		allowed, ok := rules[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok = PrincipalFromContext(ctx); !ok {
+			return nil, errs.ConvertToGrpcError(ctx, log, errs.ErrNoToken, info.FullMethod)
+		}
+
+		if !HasAnyRole(ctx, allowed...) {
+			return nil, errs.ConvertToGrpcError(ctx, log, errs.ErrPermissionDenied, info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
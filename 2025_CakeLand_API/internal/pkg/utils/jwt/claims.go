@@ -0,0 +1,22 @@
+package jwt
+
+import "github.com/golang-jwt/jwt/v4"
+
+// TokenType Тип токена в claim typ
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeChallenge Промежуточный токен входа с 2FA: пароль проверен, ждём второй фактор
+	TokenTypeChallenge TokenType = "2fa"
+)
+
+// Claims Поля access, refresh и challenge токенов. Пользователь лежит в sub, userID дублирует его для старых клиентов
+type Claims struct {
+	jwt.RegisteredClaims
+	UserID string    `json:"userID"`
+	Type   TokenType `json:"typ"`
+	Roles  []string  `json:"roles,omitempty"`  // Только в access токене
+	Family string    `json:"family,omitempty"` // Только в refresh токене
+}
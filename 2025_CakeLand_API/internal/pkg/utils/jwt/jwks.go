@@ -0,0 +1,141 @@
+package jwt
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+)
+
+// JWKS Публичные ключи проверки access токенов в формате JWK (RFC 7517). Пусто для HS256
+func (t *Tokenator) JWKS() []models.JWK {
+	if t.keys == nil {
+		return nil
+	}
+
+	keys := t.keys.localKeys()
+	jwks := make([]models.JWK, 0, len(keys))
+	for kid, key := range keys {
+		jwks = append(jwks, toJWK(kid, key))
+	}
+	sort.Slice(jwks, func(i, j int) bool {
+		return jwks[i].Kid < jwks[j].Kid
+	})
+
+	return jwks
+}
+
+// SetRemoteJWKS Заменяет ключи, полученные из JWKS сервиса auth. Нераспознанные ключи пропускаются
+func (t *Tokenator) SetRemoteJWKS(jwks []models.JWK) error {
+	if t.keys == nil {
+		return nil
+	}
+
+	keys := make(map[string]verificationKey, len(jwks))
+	for _, jwk := range jwks {
+		key, err := fromJWK(jwk)
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(jwks) > 0 && len(keys) == 0 {
+		return fmt.Errorf("в JWKS нет подходящих ключей")
+	}
+	t.keys.replaceRemote(keys)
+
+	return nil
+}
+
+// JWKSHandler HTTP /.well-known/jwks.json для клиентов, которые не ходят по gRPC
+func (t *Tokenator) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		keys := t.JWKS()
+		if keys == nil {
+			keys = []models.JWK{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_ = json.NewEncoder(w).Encode(struct {
+			Keys []models.JWK `json:"keys"`
+		}{Keys: keys})
+	})
+}
+
+// ParseJWK Публичный ключ RS256 или EdDSA и его алгоритм. Нужен и для проверки ID токенов внешних провайдеров
+func ParseJWK(jwk models.JWK) (crypto.PublicKey, string, error) {
+	key, err := fromJWK(jwk)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key.public, key.alg, nil
+}
+
+func toJWK(kid string, key verificationKey) models.JWK {
+	jwk := models.JWK{
+		Kid: kid,
+		Alg: key.alg,
+		Use: "sig",
+	}
+
+	switch public := key.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+
+	return jwk
+}
+
+func fromJWK(jwk models.JWK) (verificationKey, error) {
+	if jwk.Kid == "" || (jwk.Use != "" && jwk.Use != "sig") {
+		return verificationKey{}, fmt.Errorf("ключ %q не для подписи", jwk.Kid)
+	}
+
+	var key verificationKey
+	switch {
+	case jwk.Kty == "RSA" && jwk.Alg == AlgorithmRS256:
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return verificationKey{}, fmt.Errorf("некорректный RSA ключ %q", jwk.Kid)
+		}
+		key = verificationKey{
+			alg: AlgorithmRS256,
+			public: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+		}
+	case jwk.Kty == "OKP" && jwk.Crv == "Ed25519" && jwk.Alg == AlgorithmEdDSA:
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return verificationKey{}, fmt.Errorf("некорректный Ed25519 ключ %q", jwk.Kid)
+		}
+		key = verificationKey{
+			alg:    AlgorithmEdDSA,
+			public: ed25519.PublicKey(x),
+		}
+	default:
+		return verificationKey{}, fmt.Errorf("неподдерживаемый ключ %q: %s %s", jwk.Kid, jwk.Kty, jwk.Alg)
+	}
+
+	return key, nil
+}
@@ -1,141 +1,295 @@
 package jwt
 
 import (
-	"2025_CakeLand_API/internal/domains"
 	"2025_CakeLand_API/internal/models"
 	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/config"
+	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"os"
 	"time"
 )
 
 const (
-	refreshTokenLifeSpan = 7 * 24 * time.Hour // Срок действия 7 дней
-	accessTokenLifeSpan  = 15 * time.Minute   // Срок действия 15 минут
+	defaultIssuer          = "cakeland-auth"
+	defaultAudience        = "cakeland-api"
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 7 * 24 * time.Hour
+	defaultChallengeTTL    = 5 * time.Minute
 )
 
+// Tokenator Access токены подписываются HS256 (ACCESS_SIGN) или, если настроено, RS256/EdDSA с kid в заголовке.
+// Refresh и challenge токены проверяет только auth, поэтому они всегда HS256 с REFRESH_SIGN.
+// Тип токена (typ) и получатель (aud) у access и refresh токенов разные: один не примется вместо другого
 type Tokenator struct {
-	accessSign  []byte
-	refreshSign []byte
+	accessSign      []byte
+	refreshSign     []byte
+	signingKey      *signingKey // nil — access токены HS256 или токенатор только проверяет подпись
+	keys            *keySet     // nil — access токены HS256
+	issuer          string
+	audience        string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	challengeTTL    time.Duration
 }
 
+// NewTokenator HS256 с секретами из окружения и сроками жизни по умолчанию
 func NewTokenator() *Tokenator {
 	return &Tokenator{
-		accessSign:  []byte(os.Getenv("ACCESS_SIGN")),
-		refreshSign: []byte(os.Getenv("REFRESH_SIGN")),
+		accessSign:      []byte(os.Getenv("ACCESS_SIGN")),
+		refreshSign:     []byte(os.Getenv("REFRESH_SIGN")),
+		issuer:          defaultIssuer,
+		audience:        defaultAudience,
+		accessTokenTTL:  defaultAccessTokenTTL,
+		refreshTokenTTL: defaultRefreshTokenTTL,
+		challengeTTL:    defaultChallengeTTL,
 	}
 }
 
-// GenerateAccessToken генерирует access токен
-func (t *Tokenator) GenerateAccessToken(userUID string) (*models.JWTTokenPayload, error) {
-	return generateToken(userUID, accessTokenLifeSpan, t.accessSign)
-}
+// NewIssuingTokenator Токенатор auth: подписывает access токены приватным ключом из конфига
+func NewIssuingTokenator(conf *config.JWTConfig) (*Tokenator, error) {
+	t, err := NewVerifyingTokenator(conf)
+	if err != nil || t.keys == nil {
+		return t, err
+	}
 
-// GenerateRefreshToken генерирует refresh токен
-func (t *Tokenator) GenerateRefreshToken(userUID string) (*models.JWTTokenPayload, error) {
-	return generateToken(userUID, refreshTokenLifeSpan, t.refreshSign)
+	key, err := loadSigningKey(conf.SigningKey.ID, conf.SigningKey.Path, conf.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if existing, ok := t.keys.get(key.id); ok && !publicKeysEqual(existing.public, key.public) {
+		return nil, fmt.Errorf("kid %q ключа подписи занят другим ключом проверки", key.id)
+	}
+	t.signingKey = key
+	t.keys.addLocal(key.id, verificationKey{alg: key.method.Alg(), public: key.public})
+
+	return t, nil
 }
 
-// IsTokenExpired проверяет, истёк ли срок действия токена
-func (t *Tokenator) IsTokenExpired(tokenString string, isRefresh bool) (bool, error) {
-	var sign []byte
-	if isRefresh {
-		sign = t.refreshSign
-	} else {
-		sign = t.accessSign
+// NewVerifyingTokenator Токенатор остальных сервисов: только проверяет access токены.
+// Для RS256/EdDSA ключи берутся из VerificationKeys и из JWKS (SetRemoteJWKS)
+func NewVerifyingTokenator(conf *config.JWTConfig) (*Tokenator, error) {
+	t := NewTokenator()
+	if conf.Issuer != "" {
+		t.issuer = conf.Issuer
+	}
+	if conf.Audience != "" {
+		t.audience = conf.Audience
+	}
+	if conf.AccessTokenTTL > 0 {
+		t.accessTokenTTL = conf.AccessTokenTTL
+	}
+	if conf.RefreshTokenTTL > 0 {
+		t.refreshTokenTTL = conf.RefreshTokenTTL
+	}
+	if conf.ChallengeTTL > 0 {
+		t.challengeTTL = conf.ChallengeTTL
 	}
 
-	// Извлечение claims и валидация токена
-	claims, err := getTokenClaims(tokenString, sign)
-	if err != nil {
-		return false, err
+	switch conf.Algorithm {
+	case AlgorithmHS256:
+		return t, nil
+	case AlgorithmRS256, AlgorithmEdDSA:
+	default:
+		return nil, fmt.Errorf("неподдерживаемый алгоритм подписи токенов: %q", conf.Algorithm)
 	}
 
-	if exp, ok := claims[string(domains.KeyExpClaim)].(float64); ok {
-		expirationTime := time.Unix(int64(exp), 0)
-		// Проверяем, истёк ли токен
-		if time.Now().After(expirationTime) {
-			return true, nil
+	t.keys = newKeySet()
+	for _, keyConf := range conf.VerificationKeys {
+		if keyConf.ID == "" {
+			return nil, fmt.Errorf("у ключа проверки %s не задан kid", keyConf.Path)
+		}
+		key, err := loadVerificationKey(keyConf.Path)
+		if err != nil {
+			return nil, err
 		}
-		return false, nil
+		t.keys.addLocal(keyConf.ID, key)
 	}
 
-	return false, errs.ErrClaimIsMissing
+	return t, nil
 }
 
-// GetUserIDFromToken возвращает user_id если токен ещё не протух
-func (t *Tokenator) GetUserIDFromToken(tokenString string, isRefresh bool) (string, error) {
-	var sign []byte
-	if isRefresh {
-		sign = t.refreshSign
-	} else {
-		sign = t.accessSign
+// Asymmetric Подпись access токенов проверяется публичными ключами
+func (t *Tokenator) Asymmetric() bool {
+	return t.keys != nil
+}
+
+// GenerateAccessToken генерирует access токен с ролями пользователя
+func (t *Tokenator) GenerateAccessToken(userUID string, roles models.Roles) (*models.JWTTokenPayload, error) {
+	claims := t.newClaims(userUID, TokenTypeAccess, t.audience, t.accessTokenTTL)
+	claims.Roles = roles.Strings()
+
+	if t.keys == nil {
+		return signToken(claims, jwt.SigningMethodHS256, "", t.accessSign)
+	}
+	if t.signingKey == nil {
+		return nil, fmt.Errorf("токенатор без ключа подписи не выпускает access токены")
 	}
+	return signToken(claims, t.signingKey.method, t.signingKey.id, t.signingKey.private)
+}
+
+// GenerateRefreshToken генерирует refresh токен из семейства familyID. Роли в него не кладём:
+// при обновлении access токена они берутся из бд. jti делает уникальным каждый токен семейства
+func (t *Tokenator) GenerateRefreshToken(userUID, familyID string) (*models.JWTTokenPayload, error) {
+	claims := t.newClaims(userUID, TokenTypeRefresh, t.issuer, t.refreshTokenTTL)
+	claims.Family = familyID
+
+	return signToken(claims, jwt.SigningMethodHS256, "", t.refreshSign)
+}
 
-	// Извлечение claims и валидация токена
-	claims, err := getTokenClaims(tokenString, sign)
+// GenerateChallengeToken генерирует короткоживущий токен входа для пользователя с 2FA.
+// По нему LoginTwoFactor выдаёт пару токенов после проверки второго фактора
+func (t *Tokenator) GenerateChallengeToken(userUID string) (*models.JWTTokenPayload, error) {
+	claims := t.newClaims(userUID, TokenTypeChallenge, t.issuer, t.challengeTTL)
+
+	return signToken(claims, jwt.SigningMethodHS256, "", t.refreshSign)
+}
+
+// ParseChallengeToken возвращает user_id из challenge токена, если он ещё не протух
+func (t *Tokenator) ParseChallengeToken(tokenString string) (string, error) {
+	claims, err := t.parseToken(tokenString, hmacKey(t.refreshSign), TokenTypeChallenge, t.issuer)
 	if err != nil {
 		return "", err
 	}
 
-	// Получает exp
-	exp, ok := claims[domains.KeyExpClaim.String()].(float64)
-	if !ok {
-		return "", fmt.Errorf("%v: %s is missing in token", errs.ErrClaimIsMissing, domains.KeyExpClaim.String())
+	return claims.Subject, nil
+}
+
+// GetRolesFromToken возвращает роли из access токена. В токене без ролей их нет
+func (t *Tokenator) GetRolesFromToken(tokenString string) (models.Roles, error) {
+	claims, err := t.ParseAccessToken(tokenString)
+	if err != nil {
+		return nil, err
 	}
 
-	// Проверяем истёк ли токен
-	expirationTime := time.Unix(int64(exp), 0)
-	if time.Now().After(expirationTime) {
-		return "", errs.ErrTokenIsExpired
+	return claims.Roles, nil
+}
+
+// ParseAccessToken проверяет подпись, срок, издателя, получателя и тип access токена
+func (t *Tokenator) ParseAccessToken(tokenString string) (*models.AccessTokenClaims, error) {
+	claims, err := t.parseToken(tokenString, t.accessKey, TokenTypeAccess, t.audience)
+	if err != nil {
+		return nil, err
 	}
 
-	// Достаём userID если токен не протух
-	userID, ok := claims[domains.KeyUserIDClaim.String()].(string)
-	if !ok {
-		return "", fmt.Errorf("%v: %s is missing in token", errs.ErrClaimIsMissing, domains.KeyUserIDClaim.String())
+	return &models.AccessTokenClaims{
+		UserID:    claims.Subject,
+		Roles:     models.ParseRoles(claims.Roles),
+		TokenID:   claims.ID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// ParseRefreshToken возвращает user_id и семейство refresh токена, если он ещё не протух
+func (t *Tokenator) ParseRefreshToken(tokenString string) (string, string, error) {
+	claims, err := t.parseToken(tokenString, hmacKey(t.refreshSign), TokenTypeRefresh, t.issuer)
+	if err != nil {
+		return "", "", err
+	}
+	if claims.Family == "" {
+		return "", "", fmt.Errorf("%w: family is missing in token", errs.ErrClaimIsMissing)
 	}
 
-	return userID, nil
+	return claims.Subject, claims.Family, nil
 }
 
-func generateToken(userUID string, duration time.Duration, sign []byte) (*models.JWTTokenPayload, error) {
-	tokenExpiryTime := time.Now().Add(duration)
-	claims := jwt.MapClaims{
-		domains.KeyUserIDClaim.String(): userUID,
-		domains.KeyExpClaim.String():    tokenExpiryTime.Unix(),
+// newClaims Обязательные поля токена: jti у каждого токена свой
+func (t *Tokenator) newClaims(userUID string, tokenType TokenType, audience string, ttl time.Duration) *Claims {
+	now := time.Now()
+
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    t.issuer,
+			Subject:   userUID,
+			Audience:  jwt.ClaimStrings{audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
+		},
+		UserID: userUID,
+		Type:   tokenType,
+	}
+}
+
+func signToken(claims *Claims, method jwt.SigningMethod, kid string, sign interface{}) (*models.JWTTokenPayload, error) {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString(sign)
 	if err != nil {
 		return nil, err
 	}
 
 	return &models.JWTTokenPayload{
-		UserUID:   userUID,
+		UserUID:   claims.Subject,
 		Token:     tokenString,
-		ExpiresIn: tokenExpiryTime,
+		TokenID:   claims.ID,
+		ExpiresIn: claims.ExpiresAt.Time,
 	}, nil
 }
 
-func getTokenClaims(tokenString string, secret []byte) (jwt.MapClaims, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Проверка метода подписи
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errs.ErrUnexpectedSignInMethod
-		}
-		return secret, nil
-	})
+// parseToken Разбирает токен и проверяет exp/nbf/iat, iss, aud и typ
+func (t *Tokenator) parseToken(tokenString string, keyFunc jwt.Keyfunc, tokenType TokenType, audience string) (*Claims, error) {
+	var claims Claims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, keyFunc)
 	if err != nil {
+		var validationErr *jwt.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, errs.ErrTokenIsExpired
+		}
 		return nil, fmt.Errorf("%w: %v", errs.ErrParsingToken, err)
 	}
-
-	// Извлечение claims и валидация токена
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || !token.Valid {
+	if !token.Valid {
 		return nil, errs.ErrInvalidTokenOrClaims
 	}
 
-	return claims, nil
+	switch {
+	case claims.Type != tokenType:
+		return nil, fmt.Errorf("%w: unexpected token type %q", errs.ErrInvalidTokenOrClaims, claims.Type)
+	case !claims.VerifyIssuer(t.issuer, true):
+		return nil, fmt.Errorf("%w: unexpected issuer %q", errs.ErrInvalidTokenOrClaims, claims.Issuer)
+	case !claims.VerifyAudience(audience, true):
+		return nil, fmt.Errorf("%w: unexpected audience", errs.ErrInvalidTokenOrClaims)
+	case claims.ExpiresAt == nil:
+		return nil, fmt.Errorf("%w: exp is missing in token", errs.ErrInvalidTokenOrClaims)
+	case claims.Subject == "":
+		return nil, fmt.Errorf("%w: sub is missing in token", errs.ErrInvalidTokenOrClaims)
+	case claims.ID == "":
+		return nil, fmt.Errorf("%w: jti is missing in token", errs.ErrInvalidTokenOrClaims)
+	}
+
+	return &claims, nil
+}
+
+// accessKey Ключ проверки access токена. Алгоритм токена должен совпадать с алгоритмом ключа:
+// при RS256/EdDSA токен HS256 не принимается, даже если ACCESS_SIGN задан
+func (t *Tokenator) accessKey(token *jwt.Token) (interface{}, error) {
+	if t.keys == nil {
+		return hmacKey(t.accessSign)(token)
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := t.keys.get(kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown kid %q", errs.ErrInvalidTokenOrClaims, kid)
+	}
+	if token.Method.Alg() != key.alg {
+		return nil, errs.ErrUnexpectedSignInMethod
+	}
+
+	return key.public, nil
+}
+
+func hmacKey(secret []byte) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		// Проверка метода подписи
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errs.ErrUnexpectedSignInMethod
+		}
+		return secret, nil
+	}
 }
@@ -0,0 +1,212 @@
+package jwt
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/config"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwtlib "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// writeKeyPair Пишет пару ключей в PEM файлы и возвращает конфиг ключа подписи и ключа проверки
+func writeKeyPair(t *testing.T, kid string, private crypto.PrivateKey, public crypto.PublicKey) (config.JWTKeyConfig, config.JWTKeyConfig) {
+	t.Helper()
+	dir := t.TempDir()
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+
+	privatePath := filepath.Join(dir, kid+".pem")
+	publicPath := filepath.Join(dir, kid+".pub.pem")
+	require.NoError(t, os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600))
+	require.NoError(t, os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o600))
+
+	return config.JWTKeyConfig{ID: kid, Path: privatePath}, config.JWTKeyConfig{ID: kid, Path: publicPath}
+}
+
+func newEd25519Keys(t *testing.T, kid string) (config.JWTKeyConfig, config.JWTKeyConfig) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return writeKeyPair(t, kid, private, public)
+}
+
+func TestTokenator_EdDSA_VerifiesWithJWKS(t *testing.T) {
+	signing, _ := newEd25519Keys(t, "2025-01")
+	issuer, err := NewIssuingTokenator(&config.JWTConfig{Algorithm: AlgorithmEdDSA, SigningKey: signing})
+	require.NoError(t, err)
+
+	token, err := issuer.GenerateAccessToken("user-1", models.Roles{models.RoleAdmin})
+	require.NoError(t, err)
+
+	// Сервис без ключей в конфиге принимает токен только после получения JWKS
+	verifier, err := NewVerifyingTokenator(&config.JWTConfig{Algorithm: AlgorithmEdDSA})
+	require.NoError(t, err)
+	_, err = verifier.ParseAccessToken(token.Token)
+	require.Error(t, err)
+
+	jwks := issuer.JWKS()
+	require.Len(t, jwks, 1)
+	require.Equal(t, "OKP", jwks[0].Kty)
+	require.NoError(t, verifier.SetRemoteJWKS(jwks))
+
+	claims, err := verifier.ParseAccessToken(token.Token)
+	require.NoError(t, err)
+	require.Equal(t, "user-1", claims.UserID)
+	require.Equal(t, models.Roles{models.RoleAdmin}, claims.Roles)
+
+	// Ключ, убранный из JWKS, перестаёт приниматься
+	require.NoError(t, verifier.SetRemoteJWKS(nil))
+	_, err = verifier.ParseAccessToken(token.Token)
+	require.Error(t, err)
+}
+
+func TestTokenator_RS256_Rotation(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	oldSigning, oldPublic := writeKeyPair(t, "old", private, &private.PublicKey)
+
+	oldIssuer, err := NewIssuingTokenator(&config.JWTConfig{Algorithm: AlgorithmRS256, SigningKey: oldSigning})
+	require.NoError(t, err)
+	oldToken, err := oldIssuer.GenerateAccessToken("user-1", nil)
+	require.NoError(t, err)
+
+	// После ротации новый ключ подписывает, а старый остаётся для проверки выданных токенов
+	private, err = rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newSigning, _ := writeKeyPair(t, "new", private, &private.PublicKey)
+
+	issuer, err := NewIssuingTokenator(&config.JWTConfig{
+		Algorithm:        AlgorithmRS256,
+		SigningKey:       newSigning,
+		VerificationKeys: []config.JWTKeyConfig{oldPublic},
+	})
+	require.NoError(t, err)
+	require.Len(t, issuer.JWKS(), 2)
+
+	newToken, err := issuer.GenerateAccessToken("user-2", nil)
+	require.NoError(t, err)
+
+	claims, err := issuer.ParseAccessToken(oldToken.Token)
+	require.NoError(t, err)
+	require.Equal(t, "user-1", claims.UserID)
+
+	claims, err = issuer.ParseAccessToken(newToken.Token)
+	require.NoError(t, err)
+	require.Equal(t, "user-2", claims.UserID)
+}
+
+func TestTokenator_Asymmetric_RejectsHS256(t *testing.T) {
+	t.Setenv("ACCESS_SIGN", "secret")
+
+	hsToken, err := NewTokenator().GenerateAccessToken("user-1", nil)
+	require.NoError(t, err)
+
+	signing, _ := newEd25519Keys(t, "2025-01")
+	issuer, err := NewIssuingTokenator(&config.JWTConfig{Algorithm: AlgorithmEdDSA, SigningKey: signing})
+	require.NoError(t, err)
+
+	_, err = issuer.ParseAccessToken(hsToken.Token)
+	require.Error(t, err)
+}
+
+func TestNewIssuingTokenator_KeyDoesNotMatchAlgorithm(t *testing.T) {
+	signing, _ := newEd25519Keys(t, "2025-01")
+
+	_, err := NewIssuingTokenator(&config.JWTConfig{Algorithm: AlgorithmRS256, SigningKey: signing})
+	require.Error(t, err)
+}
+
+func TestTokenator_Claims(t *testing.T) {
+	t.Setenv("ACCESS_SIGN", "secret")
+	t.Setenv("REFRESH_SIGN", "secret") // Один секрет на оба типа: токены различаются только typ и aud
+
+	tokenator, err := NewVerifyingTokenator(&config.JWTConfig{
+		Algorithm:       AlgorithmHS256,
+		Issuer:          "auth",
+		Audience:        "api",
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+	require.NoError(t, err)
+
+	accessToken, err := tokenator.GenerateAccessToken("user-1", nil)
+	require.NoError(t, err)
+	refreshToken, err := tokenator.GenerateRefreshToken("user-1", "family-1")
+	require.NoError(t, err)
+
+	t.Run("Configured lifetimes", func(t *testing.T) {
+		require.WithinDuration(t, time.Now().Add(time.Minute), accessToken.ExpiresIn, 5*time.Second)
+		require.WithinDuration(t, time.Now().Add(time.Hour), refreshToken.ExpiresIn, 5*time.Second)
+	})
+
+	t.Run("Unique jti", func(t *testing.T) {
+		claims, err := tokenator.ParseAccessToken(accessToken.Token)
+		require.NoError(t, err)
+		require.Equal(t, accessToken.TokenID, claims.TokenID)
+		require.NotEqual(t, accessToken.TokenID, refreshToken.TokenID)
+	})
+
+	t.Run("Refresh token as access token", func(t *testing.T) {
+		_, err := tokenator.ParseAccessToken(refreshToken.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+	})
+
+	t.Run("Access token as refresh token", func(t *testing.T) {
+		_, _, err := tokenator.ParseRefreshToken(accessToken.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+	})
+
+	t.Run("Challenge token", func(t *testing.T) {
+		challenge, err := tokenator.GenerateChallengeToken("user-1")
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(defaultChallengeTTL), challenge.ExpiresIn, 5*time.Second)
+
+		userID, err := tokenator.ParseChallengeToken(challenge.Token)
+		require.NoError(t, err)
+		require.Equal(t, "user-1", userID)
+
+		// Challenge токен не заменяет пару токенов, и наоборот
+		_, err = tokenator.ParseAccessToken(challenge.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+		_, _, err = tokenator.ParseRefreshToken(challenge.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+		_, err = tokenator.ParseChallengeToken(refreshToken.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+	})
+
+	t.Run("Another issuer and audience", func(t *testing.T) {
+		other := NewTokenator()
+
+		_, err := other.ParseAccessToken(accessToken.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+
+		otherToken, err := other.GenerateAccessToken("user-1", nil)
+		require.NoError(t, err)
+		_, err = tokenator.ParseAccessToken(otherToken.Token)
+		require.ErrorIs(t, err, errs.ErrInvalidTokenOrClaims)
+	})
+
+	t.Run("Expired token", func(t *testing.T) {
+		claims := tokenator.newClaims("user-1", TokenTypeAccess, "api", -time.Minute)
+		token, err := signToken(claims, jwtlib.SigningMethodHS256, "", []byte("secret"))
+		require.NoError(t, err)
+
+		_, err = tokenator.ParseAccessToken(token.Token)
+		require.ErrorIs(t, err, errs.ErrTokenIsExpired)
+	})
+}
@@ -0,0 +1,182 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"os"
+	"sync"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// signingKey Приватный ключ, которым auth подписывает access токены
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+// verificationKey Публичный ключ проверки подписи. Алгоритм следует из типа ключа
+type verificationKey struct {
+	alg    string
+	public crypto.PublicKey
+}
+
+// keySet Ключи проверки по kid: свои (из конфига) и полученные из JWKS сервиса auth
+type keySet struct {
+	mu     sync.RWMutex
+	local  map[string]verificationKey
+	remote map[string]verificationKey
+}
+
+func newKeySet() *keySet {
+	return &keySet{
+		local:  make(map[string]verificationKey),
+		remote: make(map[string]verificationKey),
+	}
+}
+
+// get Ключ из конфига важнее ключа из JWKS с тем же kid
+func (s *keySet) get(kid string) (verificationKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if key, ok := s.local[kid]; ok {
+		return key, true
+	}
+	key, ok := s.remote[kid]
+	return key, ok
+}
+
+func (s *keySet) addLocal(kid string, key verificationKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.local[kid] = key
+}
+
+// replaceRemote Заменяет ключи из JWKS целиком: ключ, убранный из auth, перестаёт приниматься
+func (s *keySet) replaceRemote(keys map[string]verificationKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remote = keys
+}
+
+// localKeys Свои ключи для публикации в JWKS
+func (s *keySet) localKeys() map[string]verificationKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make(map[string]verificationKey, len(s.local))
+	for kid, key := range s.local {
+		keys[kid] = key
+	}
+
+	return keys
+}
+
+// loadSigningKey Читает приватный ключ RSA или Ed25519 из PEM (PKCS#8 или PKCS#1)
+func loadSigningKey(kid, path, algorithm string) (*signingKey, error) {
+	if kid == "" {
+		return nil, fmt.Errorf("у ключа подписи %s не задан kid", path)
+	}
+
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var private crypto.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка разбора ключа подписи %s: %w", path, err)
+	}
+
+	key := &signingKey{
+		id:      kid,
+		private: private,
+	}
+	switch private := private.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.public = jwt.SigningMethodRS256, &private.PublicKey
+	case ed25519.PrivateKey:
+		key.method, key.public = jwt.SigningMethodEdDSA, private.Public()
+	default:
+		return nil, fmt.Errorf("неподдерживаемый тип ключа подписи %s: %T", path, private)
+	}
+	if key.method.Alg() != algorithm {
+		return nil, fmt.Errorf("ключ подписи %s не подходит для %s", path, algorithm)
+	}
+
+	return key, nil
+}
+
+// loadVerificationKey Читает публичный ключ RSA или Ed25519 из PEM (PKIX или PKCS#1)
+func loadVerificationKey(path string) (verificationKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return verificationKey{}, err
+	}
+
+	var public crypto.PublicKey
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		public, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		public, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return verificationKey{}, fmt.Errorf("ошибка разбора ключа проверки %s: %w", path, err)
+	}
+
+	return newVerificationKey(public)
+}
+
+func newVerificationKey(public crypto.PublicKey) (verificationKey, error) {
+	switch public.(type) {
+	case *rsa.PublicKey:
+		return verificationKey{alg: AlgorithmRS256, public: public}, nil
+	case ed25519.PublicKey:
+		return verificationKey{alg: AlgorithmEdDSA, public: public}, nil
+	}
+
+	return verificationKey{}, fmt.Errorf("неподдерживаемый тип ключа проверки: %T", public)
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения ключа %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("в файле %s нет PEM блока", path)
+	}
+
+	return block, nil
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	type equaler interface {
+		Equal(crypto.PublicKey) bool
+	}
+	key, ok := a.(equaler)
+	return ok && key.Equal(b)
+}
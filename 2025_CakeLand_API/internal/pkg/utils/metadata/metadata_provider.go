@@ -4,17 +4,49 @@ import (
 	"2025_CakeLand_API/internal/domains"
 	"2025_CakeLand_API/internal/models/errs"
 	"context"
+	"fmt"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
 	"strings"
 )
 
 type MetadataProvider struct {
+	trustedProxies []*net.IPNet
 }
 
 func NewMetadataProvider() *MetadataProvider {
 	return &MetadataProvider{}
 }
 
+// NewMetadataProviderWithProxies Заголовкам x-forwarded-for и x-real-ip верим, только если соединение пришло
+// от одного из trustedProxies. Адреса задаются как IP или подсеть CIDR
+func NewMetadataProviderWithProxies(trustedProxies []string) (*MetadataProvider, error) {
+	nets := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("некорректный адрес доверенного прокси: %s", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("некорректная подсеть доверенного прокси %s: %w", proxy, err)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return &MetadataProvider{trustedProxies: nets}, nil
+}
+
 func (m *MetadataProvider) GetValue(ctx context.Context, key domains.MetadataKey) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -58,6 +90,70 @@ func (m *MetadataProvider) GetValues(ctx context.Context, keys ...domains.Metada
 	return values, nil
 }
 
+// ClientIP IP клиента. Без доверенного прокси перед сервисом — адрес соединения: заголовки присылает сам клиент.
+// За доверенным прокси берём самый правый адрес x-forwarded-for, который не принадлежит нашим прокси,
+// иначе x-real-ip. Пустая строка, если узнать не удалось
+func (m *MetadataProvider) ClientIP(ctx context.Context) string {
+	peerIP := peerAddress(ctx)
+	if peerIP == "" || !m.isTrustedProxy(peerIP) {
+		return peerIP
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return peerIP
+	}
+
+	// Каждый прокси дописывает справа адрес, от которого получил запрос. Левые адреса мог подставить клиент
+	var hops []string
+	for _, forwarded := range md.Get(domains.KeyForwardedFor.String()) {
+		hops = append(hops, strings.Split(forwarded, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		if !m.isTrustedProxy(hop) {
+			return hop
+		}
+	}
+
+	if realIP := md.Get(domains.KeyRealIP.String()); len(realIP) > 0 && net.ParseIP(strings.TrimSpace(realIP[0])) != nil {
+		return strings.TrimSpace(realIP[0])
+	}
+
+	return peerIP
+}
+
+func (m *MetadataProvider) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range m.trustedProxies {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerAddress Адрес соединения без порта
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
 // removeBearerPrefix Проверяем, начинается ли строка с "Bearer" и убираем этот префикс
 func removeBearerPrefix(token string) string {
 	if strings.HasPrefix(token, "Bearer ") {
@@ -0,0 +1,76 @@
+package metadata
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func clientContext(peerIP string, pairs ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 54321},
+	})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+}
+
+func TestMetadataProvider_ClientIP(t *testing.T) {
+	provider, err := NewMetadataProviderWithProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "Headers from untrusted peer are ignored",
+			ctx:  clientContext("203.0.113.7", "x-forwarded-for", "1.2.3.4", "x-real-ip", "5.6.7.8"),
+			want: "203.0.113.7",
+		},
+		{
+			name: "Trusted proxy without headers",
+			ctx:  clientContext("10.1.2.3"),
+			want: "10.1.2.3",
+		},
+		{
+			name: "Right-most untrusted hop",
+			ctx:  clientContext("10.1.2.3", "x-forwarded-for", "1.2.3.4, 198.51.100.2, 192.168.1.1"),
+			want: "198.51.100.2",
+		},
+		{
+			name: "Several forwarded headers",
+			ctx:  clientContext("10.1.2.3", "x-forwarded-for", "1.2.3.4", "x-forwarded-for", "198.51.100.2"),
+			want: "198.51.100.2",
+		},
+		{
+			name: "Garbage hop stops the walk",
+			ctx:  clientContext("10.1.2.3", "x-forwarded-for", "198.51.100.2, not-an-ip", "x-real-ip", "198.51.100.9"),
+			want: "198.51.100.9",
+		},
+		{
+			name: "Real IP from trusted proxy",
+			ctx:  clientContext("192.168.1.1", "x-real-ip", "198.51.100.9"),
+			want: "198.51.100.9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, provider.ClientIP(tt.ctx))
+		})
+	}
+
+	t.Run("No trusted proxies", func(t *testing.T) {
+		require.Equal(t, "203.0.113.7", NewMetadataProvider().ClientIP(clientContext("203.0.113.7", "x-real-ip", "5.6.7.8")))
+	})
+
+	t.Run("Invalid proxy", func(t *testing.T) {
+		_, err := NewMetadataProviderWithProxies([]string{"10.0.0.0/33"})
+		require.Error(t, err)
+		_, err = NewMetadataProviderWithProxies([]string{"proxy.local"})
+		require.Error(t, err)
+	})
+}
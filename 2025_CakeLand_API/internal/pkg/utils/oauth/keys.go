@@ -0,0 +1,113 @@
+package oauth
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/pkg/utils/jwt"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRefresh = time.Hour
+	// minRefetchInterval Неизвестный kid перечитывает ключи не чаще этого: токены с выдуманным kid не должны заваливать провайдера запросами
+	minRefetchInterval = time.Minute
+)
+
+type publicKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// remoteKeySet Ключи подписи ID токенов провайдера, загруженные по JWKS URL
+type remoteKeySet struct {
+	url     string
+	client  *http.Client
+	refresh time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]publicKey
+	fetchedAt time.Time
+}
+
+func newRemoteKeySet(url string, client *http.Client, refresh time.Duration) *remoteKeySet {
+	if refresh <= 0 {
+		refresh = defaultRefresh
+	}
+
+	return &remoteKeySet{
+		url:     url,
+		client:  client,
+		refresh: refresh,
+	}
+}
+
+// get Ключ по kid. Ключи перечитываются, если устарели или kid неизвестен (провайдер сменил ключ)
+func (s *remoteKeySet) get(ctx context.Context, kid string) (publicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[kid]
+	stale := s.keys == nil || time.Since(s.fetchedAt) >= s.refresh
+	if ok && !stale {
+		return key, nil
+	}
+	if !stale && time.Since(s.fetchedAt) < minRefetchInterval {
+		return publicKey{}, fmt.Errorf("неизвестный kid %q", kid)
+	}
+
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		// Провайдер недоступен: пока есть прежний ключ, пользуемся им
+		if ok {
+			return key, nil
+		}
+		return publicKey{}, err
+	}
+	s.keys, s.fetchedAt = keys, time.Now()
+
+	if key, ok = s.keys[kid]; !ok {
+		return publicKey{}, fmt.Errorf("неизвестный kid %q", kid)
+	}
+	return key, nil
+}
+
+func (s *remoteKeySet) fetch(ctx context.Context) (map[string]publicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка загрузки JWKS %s: %w", s.url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ошибка загрузки JWKS %s: статус %d", s.url, res.StatusCode)
+	}
+
+	var body struct {
+		Keys []models.JWK `json:"keys"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("ошибка разбора JWKS %s: %w", s.url, err)
+	}
+
+	// Ключи неподдерживаемых алгоритмов пропускаем: токены с ними не пройдут проверку
+	keys := make(map[string]publicKey, len(body.Keys))
+	for _, jwk := range body.Keys {
+		key, alg, err := jwt.ParseJWK(jwk)
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = publicKey{alg: alg, key: key}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,141 @@
+package oauth
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/config"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const httpTimeout = 10 * time.Second
+
+// provider Настройки проверки ID токенов одного провайдера
+type provider struct {
+	issuers   []string
+	clientIDs []string
+	skipNonce bool
+	keys      *remoteKeySet
+}
+
+// Verifier Проверяет ID токены (OpenID Connect) внешних провайдеров: подпись по JWKS провайдера, iss, aud, срок и nonce
+type Verifier struct {
+	providers map[models.OAuthProvider]*provider
+}
+
+// NewVerifier Провайдеры без ClientIDs или JWKSURL отключены
+func NewVerifier(conf *config.OAuthConfig) *Verifier {
+	return NewVerifierWithClient(conf, &http.Client{Timeout: httpTimeout})
+}
+
+func NewVerifierWithClient(conf *config.OAuthConfig, client *http.Client) *Verifier {
+	v := &Verifier{
+		providers: make(map[models.OAuthProvider]*provider),
+	}
+
+	for name, providerConf := range map[models.OAuthProvider]config.OAuthProviderConfig{
+		models.OAuthProviderGoogle: conf.Google,
+		models.OAuthProviderApple:  conf.Apple,
+		models.OAuthProviderVK:     conf.VK,
+	} {
+		if len(providerConf.ClientIDs) == 0 || providerConf.JWKSURL == "" {
+			continue
+		}
+		v.providers[name] = &provider{
+			issuers:   providerConf.Issuers,
+			clientIDs: providerConf.ClientIDs,
+			skipNonce: providerConf.SkipNonce,
+			keys:      newRemoteKeySet(providerConf.JWKSURL, client, providerConf.JWKSRefresh),
+		}
+	}
+
+	return v
+}
+
+// idTokenClaims Поля ID токена, которые нам нужны
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Nonce         string       `json:"nonce"`
+	Name          string       `json:"name"`
+}
+
+// Verify Проверяет ID токен провайдера. nonce — значение, отправленное клиентом провайдеру. Он обязателен,
+// кроме провайдеров со SkipNonce: без него перехваченный ID токен можно предъявить повторно
+func (v *Verifier) Verify(ctx context.Context, providerName models.OAuthProvider, idToken, nonce string) (*models.OAuthIdentity, error) {
+	p, ok := v.providers[providerName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errs.ErrProviderNotSupported, providerName)
+	}
+
+	var claims idTokenClaims
+	token, err := jwt.ParseWithClaims(idToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := p.keys.get(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.alg {
+			return nil, errs.ErrUnexpectedSignInMethod
+		}
+		return key.key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errs.ErrInvalidIDToken, err)
+	}
+	if !token.Valid {
+		return nil, errs.ErrInvalidIDToken
+	}
+
+	switch {
+	case !slices.Contains(p.issuers, claims.Issuer):
+		return nil, fmt.Errorf("%w: unexpected issuer %q", errs.ErrInvalidIDToken, claims.Issuer)
+	case !slices.ContainsFunc(p.clientIDs, func(clientID string) bool { return claims.VerifyAudience(clientID, true) }):
+		return nil, fmt.Errorf("%w: unexpected audience", errs.ErrInvalidIDToken)
+	case claims.ExpiresAt == nil:
+		return nil, fmt.Errorf("%w: exp is missing", errs.ErrInvalidIDToken)
+	case claims.Subject == "":
+		return nil, fmt.Errorf("%w: sub is missing", errs.ErrInvalidIDToken)
+	case nonce == "" && !p.skipNonce:
+		return nil, fmt.Errorf("%w: nonce is missing", errs.ErrInvalidIDToken)
+	case nonce != "" && subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1:
+		return nil, fmt.Errorf("%w: nonce mismatch", errs.ErrInvalidIDToken)
+	}
+
+	return &models.OAuthIdentity{
+		Provider:      providerName,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// flexibleBool Apple передаёт email_verified строкой "true"/"false", остальные — булевым значением
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case bool:
+		*b = flexibleBool(value)
+	case string:
+		*b = value == "true"
+	default:
+		*b = false
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package oauth
+
+import (
+	"2025_CakeLand_API/internal/models"
+	"2025_CakeLand_API/internal/models/errs"
+	"2025_CakeLand_API/internal/pkg/config"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://appleid.example.com"
+	testClientID = "land.cake.app"
+)
+
+// testProvider Провайдер с локально созданными ключами и JWKS на httptest сервере
+type testProvider struct {
+	mu       sync.Mutex
+	keys     map[string]*rsa.PrivateKey
+	requests int
+	server   *httptest.Server
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	p := &testProvider{keys: make(map[string]*rsa.PrivateKey)}
+	p.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.requests++
+		jwks := make([]models.JWK, 0, len(p.keys))
+		for kid, key := range p.keys {
+			jwks = append(jwks, models.JWK{
+				Kty: "RSA",
+				Kid: kid,
+				Alg: "RS256",
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": jwks})
+	}))
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *testProvider) addKey(t *testing.T, kid string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys[kid] = key
+}
+
+func (p *testProvider) sign(t *testing.T, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	p.mu.Lock()
+	key := p.keys[kid]
+	p.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func (p *testProvider) verifier() *Verifier {
+	return NewVerifierWithClient(&config.OAuthConfig{
+		Apple: config.OAuthProviderConfig{
+			Issuers:     []string{testIssuer},
+			ClientIDs:   []string{testClientID},
+			JWKSURL:     p.server.URL,
+			JWKSRefresh: time.Hour,
+		},
+	}, p.server.Client())
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            testIssuer,
+		"aud":            testClientID,
+		"sub":            "001234.abcdef",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"email":          "User@Example.com",
+		"email_verified": "true", // Apple передаёт строкой
+		"nonce":          "nonce-1",
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	provider := newTestProvider(t)
+	provider.addKey(t, "key-1")
+	verifier := provider.verifier()
+	ctx := context.Background()
+
+	t.Run("Valid token", func(t *testing.T) {
+		identity, err := verifier.Verify(ctx, models.OAuthProviderApple, provider.sign(t, "key-1", validClaims()), "nonce-1")
+		require.NoError(t, err)
+		require.Equal(t, &models.OAuthIdentity{
+			Provider:      models.OAuthProviderApple,
+			Subject:       "001234.abcdef",
+			Email:         "User@Example.com",
+			EmailVerified: true,
+		}, identity)
+	})
+
+	tests := []struct {
+		name   string
+		modify func(jwt.MapClaims)
+		nonce  string
+	}{
+		{name: "Another audience", modify: func(c jwt.MapClaims) { c["aud"] = "another.app" }, nonce: "nonce-1"},
+		{name: "Another issuer", modify: func(c jwt.MapClaims) { c["iss"] = "https://accounts.example.com" }, nonce: "nonce-1"},
+		{name: "Expired", modify: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }, nonce: "nonce-1"},
+		{name: "Without subject", modify: func(c jwt.MapClaims) { delete(c, "sub") }, nonce: "nonce-1"},
+		{name: "Nonce mismatch", modify: func(jwt.MapClaims) {}, nonce: "nonce-2"},
+		{name: "Without nonce", modify: func(jwt.MapClaims) {}, nonce: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			tt.modify(claims)
+
+			_, err := verifier.Verify(ctx, models.OAuthProviderApple, provider.sign(t, "key-1", claims), tt.nonce)
+			require.ErrorIs(t, err, errs.ErrInvalidIDToken)
+		})
+	}
+
+	t.Run("HS256 token", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims())
+		token.Header["kid"] = "key-1"
+		signed, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, models.OAuthProviderApple, signed, "nonce-1")
+		require.ErrorIs(t, err, errs.ErrInvalidIDToken)
+	})
+
+	t.Run("Provider without nonce", func(t *testing.T) {
+		verifier := NewVerifierWithClient(&config.OAuthConfig{
+			VK: config.OAuthProviderConfig{
+				Issuers:   []string{testIssuer},
+				ClientIDs: []string{testClientID},
+				JWKSURL:   provider.server.URL,
+				SkipNonce: true,
+			},
+		}, provider.server.Client())
+
+		claims := validClaims()
+		delete(claims, "nonce")
+		_, err := verifier.Verify(ctx, models.OAuthProviderVK, provider.sign(t, "key-1", claims), "")
+		require.NoError(t, err)
+	})
+
+	t.Run("Disabled provider", func(t *testing.T) {
+		_, err := verifier.Verify(ctx, models.OAuthProviderGoogle, provider.sign(t, "key-1", validClaims()), "")
+		require.ErrorIs(t, err, errs.ErrProviderNotSupported)
+	})
+}
+
+func TestVerifier_KeyRotation(t *testing.T) {
+	provider := newTestProvider(t)
+	provider.addKey(t, "key-1")
+	verifier := provider.verifier()
+	ctx := context.Background()
+
+	_, err := verifier.Verify(ctx, models.OAuthProviderApple, provider.sign(t, "key-1", validClaims()), "nonce-1")
+	require.NoError(t, err)
+	require.Equal(t, 1, provider.requests)
+
+	// Ключи закэшированы
+	_, err = verifier.Verify(ctx, models.OAuthProviderApple, provider.sign(t, "key-1", validClaims()), "nonce-1")
+	require.NoError(t, err)
+	require.Equal(t, 1, provider.requests)
+
+	// Неизвестный kid перечитывает ключи не чаще minRefetchInterval
+	provider.addKey(t, "key-2")
+	_, err = verifier.Verify(ctx, models.OAuthProviderApple, provider.sign(t, "key-2", validClaims()), "nonce-1")
+	require.ErrorIs(t, err, errs.ErrInvalidIDToken)
+	require.Equal(t, 1, provider.requests)
+
+	verifier.providers[models.OAuthProviderApple].keys.fetchedAt = time.Now().Add(-minRefetchInterval)
+	_, err = verifier.Verify(ctx, models.OAuthProviderApple, provider.sign(t, "key-2", validClaims()), "nonce-1")
+	require.NoError(t, err)
+	require.Equal(t, 2, provider.requests)
+}
@@ -0,0 +1,84 @@
+package pagination
+
+import (
+	"2025_CakeLand_API/internal/models/errs"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// Единое соглашение для списочных RPC: запрос содержит page_size и page_token,
+// ответ — next_page_token (пустой на последней странице). Токен непрозрачен для клиента.
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Cursor Позиция последнего элемента страницы для keyset пагинации
+type Cursor struct {
+	Key    string    `json:"k,omitempty"` // Контекст выдачи (сортировка, фильтр), курсор действителен только в нём
+	Number float64   `json:"n,omitempty"` // Числовой ключ сортировки
+	Text   string    `json:"t,omitempty"` // Строковый ключ сортировки
+	ID     uuid.UUID `json:"id"`          // ID элемента (разрешает равенство ключей)
+}
+
+// Encode Кодирует курсор в page token
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// NextPageToken Возвращает page token следующей страницы или пустую строку, если страниц больше нет
+func NextPageToken(next *Cursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return next.Encode()
+}
+
+// Page Параметры запрашиваемой страницы
+type Page struct {
+	Size  int     // Размер страницы
+	After *Cursor // Курсор предыдущей страницы (nil — первая страница)
+}
+
+// NewPage Разбирает page_size и page_token запроса. key — контекст выдачи, в котором должен быть выдан токен
+func NewPage(pageSize int32, pageToken string, key string) (Page, error) {
+	page := Page{
+		Size: normalizePageSize(pageSize),
+	}
+
+	if pageToken == "" {
+		return page, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return Page{}, fmt.Errorf("%w: invalid page token: %w", errs.ErrInvalidInput, err)
+	}
+
+	var cursor Cursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return Page{}, fmt.Errorf("%w: invalid page token: %w", errs.ErrInvalidInput, err)
+	}
+
+	if cursor.Key != key {
+		return Page{}, fmt.Errorf("%w: page token was issued for another request", errs.ErrInvalidInput)
+	}
+
+	page.After = &cursor
+	return page, nil
+}
+
+func normalizePageSize(pageSize int32) int {
+	switch {
+	case pageSize <= 0:
+		return DefaultPageSize
+	case pageSize > MaxPageSize:
+		return MaxPageSize
+	default:
+		return int(pageSize)
+	}
+}
@@ -0,0 +1,151 @@
+package password
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+)
+
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+var argon2Prefix = []byte("$argon2id$")
+
+// Hasher Хэширует пароли настроенным алгоритмом и проверяет хэши любого поддерживаемого формата
+type Hasher struct {
+	conf      config.PasswordHashConfig
+	dummyHash []byte // Хэш случайного пароля для VerifyDummy
+}
+
+func NewHasher(conf *config.PasswordHashConfig) (*Hasher, error) {
+	switch conf.Algorithm {
+	case AlgorithmArgon2id:
+		if conf.Argon2Memory == 0 || conf.Argon2Iterations == 0 || conf.Argon2Parallelism == 0 {
+			return nil, fmt.Errorf("некорректные параметры argon2id")
+		}
+	case AlgorithmBcrypt:
+		if conf.BcryptCost < bcrypt.MinCost || conf.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("некорректная стоимость bcrypt: %d", conf.BcryptCost)
+		}
+	default:
+		return nil, fmt.Errorf("неизвестный алгоритм хэширования паролей: %q", conf.Algorithm)
+	}
+
+	h := &Hasher{
+		conf: *conf,
+	}
+
+	dummy := make([]byte, 32)
+	if _, err := rand.Read(dummy); err != nil {
+		return nil, err
+	}
+	dummyHash, err := h.Hash(base64.RawStdEncoding.EncodeToString(dummy))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка хэширования пароля-заглушки: %w", err)
+	}
+	h.dummyHash = dummyHash
+
+	return h, nil
+}
+
+// Hash Хэш пароля в текстовом виде: bcrypt ($2a$...) или argon2id в формате PHC ($argon2id$v=19$...)
+func (h *Hasher) Hash(password string) ([]byte, error) {
+	if h.conf.Algorithm == AlgorithmBcrypt {
+		return bcrypt.GenerateFromPassword([]byte(password), h.conf.BcryptCost)
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.conf.Argon2Iterations, h.conf.Argon2Memory, h.conf.Argon2Parallelism, argon2KeyLength)
+
+	return []byte(fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.conf.Argon2Memory,
+		h.conf.Argon2Iterations,
+		h.conf.Argon2Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)), nil
+}
+
+// Verify Проверяет пароль. needsRehash — хэш сделан другим алгоритмом или с другими параметрами,
+// чем сейчас в конфиге: после успешного входа его стоит пересчитать
+func (h *Hasher) Verify(password string, hash []byte) (ok bool, needsRehash bool) {
+	if bytes.HasPrefix(hash, argon2Prefix) {
+		params, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false, false
+		}
+		actual := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(actual, key) != 1 {
+			return false, false
+		}
+
+		return true, h.conf.Algorithm != AlgorithmArgon2id ||
+			params.memory != h.conf.Argon2Memory ||
+			params.iterations != h.conf.Argon2Iterations ||
+			params.parallelism != h.conf.Argon2Parallelism ||
+			len(key) != argon2KeyLength
+	}
+
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+		return false, false
+	}
+	cost, err := bcrypt.Cost(hash)
+
+	return true, err != nil || h.conf.Algorithm != AlgorithmBcrypt || cost != h.conf.BcryptCost
+}
+
+// VerifyDummy Проверяет пароль против хэша-заглушки, результат всегда отрицательный. Вызывается, когда пользователя нет:
+// ответ для неизвестной почты занимает столько же, сколько для неверного пароля, и не выдаёт, зарегистрирована ли она
+func (h *Hasher) VerifyDummy(password string) {
+	_, _ = h.Verify(password, h.dummyHash)
+}
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// decodeArgon2 Разбирает $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func decodeArgon2(hash []byte) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return params, nil, nil, fmt.Errorf("некорректный хэш argon2id")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("неподдерживаемая версия argon2id")
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("некорректные параметры argon2id: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("некорректная соль argon2id: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("некорректный ключ argon2id")
+	}
+
+	return params, salt, key, nil
+}
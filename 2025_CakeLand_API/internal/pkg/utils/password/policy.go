@@ -0,0 +1,142 @@
+package password
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcryptMaxBytes bcrypt учитывает только первые 72 байта пароля, остальные молча отбрасывает
+const bcryptMaxBytes = 72
+
+var (
+	ErrEmpty    = errors.New("password is required")
+	ErrBreached = errors.New("password is too common, choose another one")
+)
+
+// Policy Требования к новым паролям. При входе не проверяется: старые пароли продолжают работать
+type Policy struct {
+	minLength     int
+	maxLength     int
+	maxBytes      int // 0 — без ограничения в байтах
+	requireLetter bool
+	requireUpper  bool
+	requireDigit  bool
+	requireSymbol bool
+	breached      map[string]struct{}
+}
+
+// DefaultPolicy Не короче 8 символов, хотя бы одна буква и одна цифра, без списка утёкших паролей
+func DefaultPolicy() *Policy {
+	return &Policy{
+		minLength:     8,
+		maxLength:     128,
+		requireLetter: true,
+		requireDigit:  true,
+	}
+}
+
+func NewPolicy(conf *config.PasswordConfig) (*Policy, error) {
+	if conf.MinLength <= 0 || conf.MaxLength < conf.MinLength {
+		return nil, fmt.Errorf("некорректная длина пароля: от %d до %d", conf.MinLength, conf.MaxLength)
+	}
+
+	policy := &Policy{
+		minLength:     conf.MinLength,
+		maxLength:     conf.MaxLength,
+		requireLetter: conf.RequireLetter,
+		requireUpper:  conf.RequireUpper,
+		requireDigit:  conf.RequireDigit,
+		requireSymbol: conf.RequireSymbol,
+	}
+	// Иначе пароли с общим началом в 72 байта (36 символов кириллицей) неотличимы
+	if conf.Hash.Algorithm == AlgorithmBcrypt {
+		policy.maxBytes = bcryptMaxBytes
+	}
+	if conf.BreachedList != "" {
+		breached, err := loadBreachedList(conf.BreachedList)
+		if err != nil {
+			return nil, err
+		}
+		policy.breached = breached
+	}
+
+	return policy, nil
+}
+
+// Validate Проверяет пароль на соответствие политике. Ошибка описывает первое нарушенное требование
+func (p *Policy) Validate(password string) error {
+	if password == "" {
+		return ErrEmpty
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength {
+		return fmt.Errorf("password must be at least %d characters", p.minLength)
+	} else if length > p.maxLength {
+		return fmt.Errorf("password must be at most %d characters", p.maxLength)
+	} else if p.maxBytes > 0 && len(password) > p.maxBytes {
+		return fmt.Errorf("password must be at most %d bytes", p.maxBytes)
+	}
+
+	var hasLetter, hasUpper, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+			hasUpper = hasUpper || unicode.IsUpper(r)
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsSpace(r), unicode.IsControl(r):
+			return errors.New("password must not contain spaces or control characters")
+		default:
+			hasSymbol = true
+		}
+	}
+
+	switch {
+	case p.requireLetter && !hasLetter:
+		return errors.New("password must contain at least one letter")
+	case p.requireUpper && !hasUpper:
+		return errors.New("password must contain at least one uppercase letter")
+	case p.requireDigit && !hasDigit:
+		return errors.New("password must contain at least one digit")
+	case p.requireSymbol && !hasSymbol:
+		return errors.New("password must contain at least one symbol")
+	}
+
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		return ErrBreached
+	}
+
+	return nil
+}
+
+// loadBreachedList Пароли по одному на строку, без учёта регистра. Пустые строки и строки с # пропускаются
+func loadBreachedList(path string) (map[string]struct{}, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка открытия списка утёкших паролей: %w", err)
+	}
+	defer file.Close()
+
+	breached := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		breached[strings.ToLower(line)] = struct{}{}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка чтения списка утёкших паролей: %w", err)
+	}
+
+	return breached, nil
+}
@@ -0,0 +1,54 @@
+package password
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_BcryptByteLimit(t *testing.T) {
+	conf := config.PasswordConfig{
+		MinLength:     8,
+		MaxLength:     128,
+		RequireLetter: true,
+		RequireDigit:  true,
+		Hash:          config.PasswordHashConfig{Algorithm: AlgorithmBcrypt},
+	}
+	// 37 символов кириллицей и цифра: 75 байт
+	long := strings.Repeat("я", 37) + "1"
+
+	policy, err := NewPolicy(&conf)
+	require.NoError(t, err)
+	require.Error(t, policy.Validate(long))
+	require.NoError(t, policy.Validate(strings.Repeat("я", 35)+"1"))
+
+	t.Run("Argon2id has no byte limit", func(t *testing.T) {
+		conf.Hash.Algorithm = AlgorithmArgon2id
+		policy, err := NewPolicy(&conf)
+		require.NoError(t, err)
+		require.NoError(t, policy.Validate(long))
+	})
+}
+
+func TestHasher_VerifyDummy(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmBcrypt, AlgorithmArgon2id} {
+		t.Run(algorithm, func(t *testing.T) {
+			hasher, err := NewHasher(&config.PasswordHashConfig{
+				Algorithm:         algorithm,
+				BcryptCost:        4,
+				Argon2Memory:      64,
+				Argon2Iterations:  1,
+				Argon2Parallelism: 1,
+			})
+			require.NoError(t, err)
+
+			// Заглушка посчитана тем же алгоритмом, что и настоящие хэши
+			require.Equal(t, algorithm == AlgorithmArgon2id, bytes.HasPrefix(hasher.dummyHash, argon2Prefix))
+			ok, _ := hasher.Verify("Password1", hasher.dummyHash)
+			require.False(t, ok)
+		})
+	}
+}
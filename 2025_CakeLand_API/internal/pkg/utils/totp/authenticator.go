@@ -0,0 +1,110 @@
+package totp
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const encryptionKeySize = 32 // AES-256
+
+// Authenticator Выпуск и проверка TOTP секретов. В бд секреты лежат зашифрованными AES-256-GCM:
+// утечка таблицы без ключа из окружения не даёт генерировать коды
+type Authenticator struct {
+	issuer string
+	aead   cipher.AEAD
+	now    func() time.Time
+}
+
+// Secret Новый секрет: открытый вид для пользователя и зашифрованный для бд
+type Secret struct {
+	Plain  string // base32 для ручного ввода
+	URI    string // otpauth:// для QR кода
+	Sealed []byte
+}
+
+func NewAuthenticator(conf *config.TwoFactorConfig) (*Authenticator, error) {
+	if conf.EncryptionKey == "" {
+		return nil, errors.New("не задан ключ шифрования TOTP секретов TOTP_ENCRYPTION_KEY")
+	}
+	key, err := base64.StdEncoding.DecodeString(conf.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка разбора TOTP_ENCRYPTION_KEY: %w", err)
+	} else if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY должен содержать %d байта в base64, получено %d", encryptionKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		issuer: conf.Issuer,
+		aead:   aead,
+		now:    time.Now,
+	}, nil
+}
+
+// NewSecret Генерирует секрет для пользователя account
+func (a *Authenticator) NewSecret(account string) (*Secret, error) {
+	secret := make([]byte, SecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	sealed, err := a.seal(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Secret{
+		Plain:  EncodeSecret(secret),
+		URI:    URI(a.issuer, account, secret),
+		Sealed: sealed,
+	}, nil
+}
+
+// Validate Проверяет код по зашифрованному секрету. Возвращает интервал кода, см. totp.Validate
+func (a *Authenticator) Validate(sealed []byte, code string, lastStep int64) (int64, bool, error) {
+	secret, err := a.open(sealed)
+	if err != nil {
+		return 0, false, err
+	}
+
+	step, ok := Validate(secret, code, a.now(), lastStep)
+	return step, ok, nil
+}
+
+// seal nonce записывается перед шифротекстом
+func (a *Authenticator) seal(secret []byte) ([]byte, error) {
+	nonce := make([]byte, a.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return a.aead.Seal(nonce, nonce, secret, nil), nil
+}
+
+func (a *Authenticator) open(sealed []byte) ([]byte, error) {
+	if len(sealed) < a.aead.NonceSize() {
+		return nil, errors.New("повреждён зашифрованный TOTP секрет")
+	}
+
+	nonce, ciphertext := sealed[:a.aead.NonceSize()], sealed[a.aead.NonceSize():]
+	secret, err := a.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка расшифровки TOTP секрета: %w", err)
+	}
+
+	return secret, nil
+}
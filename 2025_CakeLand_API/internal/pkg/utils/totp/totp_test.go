@@ -0,0 +1,120 @@
+package totp
+
+import (
+	"2025_CakeLand_API/internal/pkg/config"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Секрет и значения из приложения B RFC 6238 (SHA1). В RFC коды восьмизначные, у нас — последние шесть цифр
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCode_RFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.code, Code(rfcSecret, Step(time.Unix(tt.unix, 0))), "T=%d", tt.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	step := Step(now)
+
+	t.Run("Current step", func(t *testing.T) {
+		got, ok := Validate(rfcSecret, "005924", now, 0)
+		require.True(t, ok)
+		require.Equal(t, step, got)
+	})
+
+	t.Run("Clock skew", func(t *testing.T) {
+		got, ok := Validate(rfcSecret, Code(rfcSecret, step-1), now, 0)
+		require.True(t, ok)
+		require.Equal(t, step-1, got)
+
+		_, ok = Validate(rfcSecret, Code(rfcSecret, step+2), now, 0)
+		require.False(t, ok)
+	})
+
+	t.Run("Code is not accepted twice", func(t *testing.T) {
+		_, ok := Validate(rfcSecret, "005924", now, step)
+		require.False(t, ok)
+
+		// Код предыдущего интервала после принятого текущего тоже не проходит
+		_, ok = Validate(rfcSecret, Code(rfcSecret, step-1), now, step)
+		require.False(t, ok)
+	})
+
+	t.Run("Wrong format", func(t *testing.T) {
+		_, ok := Validate(rfcSecret, "5924", now, 0)
+		require.False(t, ok)
+	})
+}
+
+func TestURI(t *testing.T) {
+	uri, err := url.Parse(URI("CakeLand", "user@example.com", rfcSecret))
+	require.NoError(t, err)
+
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, "totp", uri.Host)
+	require.Equal(t, "/CakeLand:user@example.com", uri.Path)
+	require.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", uri.Query().Get("secret"))
+	require.Equal(t, "CakeLand", uri.Query().Get("issuer"))
+	require.Equal(t, "6", uri.Query().Get("digits"))
+	require.Equal(t, "30", uri.Query().Get("period"))
+}
+
+func TestAuthenticator(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", encryptionKeySize)))
+	authenticator, err := NewAuthenticator(&config.TwoFactorConfig{Issuer: "CakeLand", EncryptionKey: key})
+	require.NoError(t, err)
+
+	now := time.Unix(1234567890, 0)
+	authenticator.now = func() time.Time { return now }
+
+	secret, err := authenticator.NewSecret("user@example.com")
+	require.NoError(t, err)
+	require.NotContains(t, string(secret.Sealed), secret.Plain)
+
+	plain, err := secretEncoding.DecodeString(secret.Plain)
+	require.NoError(t, err)
+	require.Len(t, plain, SecretSize)
+
+	t.Run("Valid code", func(t *testing.T) {
+		step, ok, err := authenticator.Validate(secret.Sealed, Code(plain, Step(now)), 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, Step(now), step)
+	})
+
+	t.Run("Another key", func(t *testing.T) {
+		otherKey := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("o", encryptionKeySize)))
+		other, err := NewAuthenticator(&config.TwoFactorConfig{EncryptionKey: otherKey})
+		require.NoError(t, err)
+
+		_, _, err = other.Validate(secret.Sealed, Code(plain, Step(now)), 0)
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		_, err := NewAuthenticator(&config.TwoFactorConfig{})
+		require.Error(t, err)
+
+		_, err = NewAuthenticator(&config.TwoFactorConfig{EncryptionKey: base64.StdEncoding.EncodeToString([]byte("short"))})
+		require.Error(t, err)
+	})
+}
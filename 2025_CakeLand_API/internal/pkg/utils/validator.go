@@ -1,43 +1,91 @@
 package utils
 
 import (
+	"2025_CakeLand_API/internal/pkg/utils/password"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"net/mail"
 	"regexp"
+	"strings"
+)
+
+const (
+	maxEmailLength     = 254 // RFC 5321: адрес целиком
+	maxEmailLocalChars = 64  // RFC 5321: часть до @
 )
 
 var (
-	passwordRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{8,}$`)
-	emailRegexp    = regexp.MustCompile(`^[a-z0-9]+@[a-z0-9]+\.[a-z]{2,4}$`)
-	nameRegexp     = regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ\s-]+$`)
+	nameRegexp      = regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ\s-]+$`)
+	phoneRegexp     = regexp.MustCompile(`^[78][0-9]{10}$`)
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "")
 )
 
-type Validator struct{}
+type Validator struct {
+	passwordPolicy *password.Policy
+}
 
-func NewValidator() *Validator {
-	return &Validator{}
+func NewValidator(passwordPolicy *password.Policy) *Validator {
+	return &Validator{
+		passwordPolicy: passwordPolicy,
+	}
 }
 
-// ValidateEmail Функция валидации почты
-func (v *Validator) ValidateEmail(email string) error {
+// ParseEmail Разбирает адрес по RFC 5322 (net/mail). Имя, угловые скобки и комментарии не допускаются,
+// домен должен содержать точку. Возвращает адрес в нижнем регистре: так почта хранится и ищется в бд
+func (v *Validator) ParseEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
 	if email == "" {
-		return status.Error(codes.InvalidArgument, "email обязателен")
-	} else if !emailRegexp.MatchString(email) {
-		return status.Error(codes.InvalidArgument, "invalid email format")
+		return "", status.Error(codes.InvalidArgument, "email обязателен")
+	} else if len(email) > maxEmailLength {
+		return "", status.Error(codes.InvalidArgument, "email is too long")
 	}
 
-	return nil
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" || address.Address != email {
+		return "", status.Error(codes.InvalidArgument, "invalid email format")
+	}
+
+	at := strings.LastIndex(address.Address, "@")
+	local, domain := address.Address[:at], address.Address[at+1:]
+	if len(local) > maxEmailLocalChars {
+		return "", status.Error(codes.InvalidArgument, "invalid email format")
+	} else if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return "", status.Error(codes.InvalidArgument, "invalid email format")
+	}
+
+	return strings.ToLower(address.Address), nil
+}
+
+// ParsePhone Разбирает российский номер: +7, 7 или 8 и десять цифр, пробелы, скобки и дефисы допускаются.
+// Возвращает номер в формате 7XXXXXXXXXX: так он хранится в бд
+func (v *Validator) ParsePhone(phone string) (string, error) {
+	phone = strings.TrimSpace(phone)
+	if phone == "" {
+		return "", status.Error(codes.InvalidArgument, "phone обязателен")
+	}
+
+	digits := phoneSeparators.Replace(strings.TrimPrefix(phone, "+"))
+	if !phoneRegexp.MatchString(digits) || (strings.HasPrefix(phone, "+") && digits[0] != '7') {
+		return "", status.Error(codes.InvalidArgument, "invalid phone format")
+	}
+
+	return "7" + digits[1:], nil
+}
+
+// ValidateEmail Функция валидации почты
+func (v *Validator) ValidateEmail(email string) error {
+	_, err := v.ParseEmail(email)
+	return err
 }
 
-// ValidatePassword Функция для проверки валидности пароля
-func (v *Validator) ValidatePassword(password string) error {
-	if password == "" {
-		return status.Error(codes.InvalidArgument, "password обязателен")
-	} else if len(password) < 8 {
-		return status.Error(codes.InvalidArgument, "password must be at least 8 characters")
-	} else if !passwordRegexp.MatchString(password) {
-		return status.Error(codes.InvalidArgument, "password must contain at least one letter and one number")
+// ValidatePassword Проверяет новый пароль по политике паролей
+func (v *Validator) ValidatePassword(pass string) error {
+	if err := v.passwordPolicy.Validate(pass); err != nil {
+		if errors.Is(err, password.ErrEmpty) {
+			return status.Error(codes.InvalidArgument, "password обязателен")
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return nil
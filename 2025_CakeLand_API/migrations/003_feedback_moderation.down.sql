@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS trigger_update_feedback_helpful_count ON feedback_vote;
+
+DROP FUNCTION IF EXISTS update_feedback_helpful_count();
+
+DROP TABLE IF EXISTS feedback_report;
+
+DROP TYPE IF EXISTS feedback_report_status;
+
+DROP TABLE IF EXISTS feedback_vote;
+
+ALTER TABLE feedback
+    DROP COLUMN IF EXISTS helpful_count,
+    DROP COLUMN IF EXISTS is_hidden;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS is_moderator;
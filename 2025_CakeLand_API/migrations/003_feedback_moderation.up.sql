@@ -0,0 +1,62 @@
+-- Модератор отзывов
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS is_moderator BOOL NOT NULL DEFAULT false;
+
+-- Счётчик полезности и скрытие отзыва модератором
+ALTER TABLE feedback
+    ADD COLUMN IF NOT EXISTS helpful_count INT  NOT NULL DEFAULT 0 CHECK (helpful_count >= 0),
+    ADD COLUMN IF NOT EXISTS is_hidden     BOOL NOT NULL DEFAULT false;
+
+-- Голос "полезно" за отзыв (один голос от пользователя)
+CREATE TABLE IF NOT EXISTS feedback_vote
+(
+    feedback_id   UUID NOT NULL,
+    user_id       UUID NOT NULL,
+    date_creation TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (feedback_id, user_id),
+    FOREIGN KEY (feedback_id) REFERENCES feedback (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES "user" (id)
+);
+
+-- Статус жалобы
+CREATE TYPE feedback_report_status AS ENUM (
+    'pending', -- Ожидает модерации
+    'resolved' -- Рассмотрена
+    );
+
+-- Жалоба на отзыв (одна жалоба от пользователя)
+CREATE TABLE IF NOT EXISTS feedback_report
+(
+    id            UUID PRIMARY KEY,
+    feedback_id   UUID                   NOT NULL,
+    reporter_id   UUID                   NOT NULL,
+    reason        TEXT                   NOT NULL,
+    status        feedback_report_status NOT NULL DEFAULT 'pending',
+    date_creation TIMESTAMP                       DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (feedback_id) REFERENCES feedback (id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES "user" (id),
+    UNIQUE (feedback_id, reporter_id)
+);
+
+CREATE INDEX IF NOT EXISTS feedback_report_pending_idx ON feedback_report (feedback_id) WHERE status = 'pending';
+
+-- Функция к триггеру голосования за отзыв
+CREATE OR REPLACE FUNCTION update_feedback_helpful_count()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE feedback SET helpful_count = helpful_count + 1 WHERE id = NEW.feedback_id;
+        RETURN NEW;
+    END IF;
+
+    UPDATE feedback SET helpful_count = helpful_count - 1 WHERE id = OLD.feedback_id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_feedback_helpful_count
+    AFTER INSERT OR DELETE
+    ON feedback_vote
+    FOR EACH ROW
+EXECUTE FUNCTION update_feedback_helpful_count();
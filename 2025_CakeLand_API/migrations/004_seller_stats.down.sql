@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS trigger_update_seller_response_stats ON message;
+DROP TRIGGER IF EXISTS trigger_update_seller_order_stats ON "order";
+DROP TRIGGER IF EXISTS trigger_update_seller_review_stats ON feedback;
+
+DROP FUNCTION IF EXISTS update_seller_response_stats();
+DROP FUNCTION IF EXISTS update_seller_order_stats();
+DROP FUNCTION IF EXISTS update_seller_review_stats();
+
+DROP INDEX IF EXISTS message_dialog_idx;
+
+DROP TABLE IF EXISTS seller_stats;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS date_creation;
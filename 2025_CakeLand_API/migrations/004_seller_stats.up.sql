@@ -0,0 +1,143 @@
+-- Дата регистрации пользователя
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS date_creation TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- Агрегированная статистика продавца (обновляется триггерами инкрементально)
+CREATE TABLE IF NOT EXISTS seller_stats
+(
+    seller_id              UUID PRIMARY KEY,
+    stars_sum              INT              NOT NULL DEFAULT 0 CHECK (stars_sum >= 0),
+    reviews_count          INT              NOT NULL DEFAULT 0 CHECK (reviews_count >= 0),
+    completed_orders_count INT              NOT NULL DEFAULT 0 CHECK (completed_orders_count >= 0),
+    response_time_sum      DOUBLE PRECISION NOT NULL DEFAULT 0, -- Суммарное время ответа в чате (секунды)
+    responses_count        INT              NOT NULL DEFAULT 0 CHECK (responses_count >= 0),
+    updated_at             TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (seller_id) REFERENCES "user" (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS message_dialog_idx ON message (owner_id, receiver_id, date_creation);
+
+-- Отзывы: рейтинг продавца = рейтинг всех его тортов
+CREATE OR REPLACE FUNCTION update_seller_review_stats()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO seller_stats (seller_id, stars_sum, reviews_count)
+    SELECT c.owner_id, NEW.rating, 1
+    FROM cake c
+    WHERE c.id = NEW.cake_id
+    ON CONFLICT (seller_id) DO UPDATE
+        SET stars_sum     = seller_stats.stars_sum + EXCLUDED.stars_sum,
+            reviews_count = seller_stats.reviews_count + 1,
+            updated_at    = CURRENT_TIMESTAMP;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_seller_review_stats
+    AFTER INSERT
+    ON feedback
+    FOR EACH ROW
+EXECUTE FUNCTION update_seller_review_stats();
+
+-- Заказы: число доставленных заказов продавца
+CREATE OR REPLACE FUNCTION update_seller_order_stats()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    delta INT := 0;
+BEGIN
+    IF NEW.status = 'delivered' AND (TG_OP = 'INSERT' OR OLD.status <> 'delivered') THEN
+        delta := 1;
+    ELSIF TG_OP = 'UPDATE' AND OLD.status = 'delivered' AND NEW.status <> 'delivered' THEN
+        delta := -1;
+    END IF;
+
+    IF delta <> 0 THEN
+        INSERT INTO seller_stats (seller_id, completed_orders_count)
+        VALUES (NEW.seller_id, GREATEST(delta, 0))
+        ON CONFLICT (seller_id) DO UPDATE
+            SET completed_orders_count = GREATEST(seller_stats.completed_orders_count + delta, 0),
+                updated_at             = CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_seller_order_stats
+    AFTER INSERT OR UPDATE OF status
+    ON "order"
+    FOR EACH ROW
+EXECUTE FUNCTION update_seller_order_stats();
+
+-- Чат: время ответа = время между последним сообщением собеседника и ответом пользователя
+CREATE OR REPLACE FUNCTION update_seller_response_stats()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    prev_owner_id UUID;
+    prev_date     TIMESTAMP;
+BEGIN
+    SELECT owner_id, date_creation
+    INTO prev_owner_id, prev_date
+    FROM message
+    WHERE ((owner_id = NEW.owner_id AND receiver_id = NEW.receiver_id)
+        OR (owner_id = NEW.receiver_id AND receiver_id = NEW.owner_id))
+      AND id <> NEW.id
+      AND date_creation <= NEW.date_creation
+    ORDER BY date_creation DESC
+    LIMIT 1;
+
+    IF FOUND AND prev_owner_id = NEW.receiver_id AND NEW.owner_id <> NEW.receiver_id THEN
+        INSERT INTO seller_stats (seller_id, response_time_sum, responses_count)
+        VALUES (NEW.owner_id, EXTRACT(EPOCH FROM NEW.date_creation - prev_date), 1)
+        ON CONFLICT (seller_id) DO UPDATE
+            SET response_time_sum = seller_stats.response_time_sum + EXCLUDED.response_time_sum,
+                responses_count   = seller_stats.responses_count + 1,
+                updated_at        = CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_seller_response_stats
+    AFTER INSERT
+    ON message
+    FOR EACH ROW
+EXECUTE FUNCTION update_seller_response_stats();
+
+-- Заполняем статистику по уже существующим данным
+INSERT INTO seller_stats (seller_id, stars_sum, reviews_count)
+SELECT owner_id, COALESCE(SUM(stars_sum), 0), COALESCE(SUM(reviews_count), 0)
+FROM cake
+GROUP BY owner_id
+ON CONFLICT (seller_id) DO NOTHING;
+
+INSERT INTO seller_stats (seller_id, completed_orders_count)
+SELECT seller_id, COUNT(*)
+FROM "order"
+WHERE status = 'delivered'
+GROUP BY seller_id
+ON CONFLICT (seller_id) DO UPDATE
+    SET completed_orders_count = EXCLUDED.completed_orders_count;
+
+INSERT INTO seller_stats (seller_id, response_time_sum, responses_count)
+SELECT owner_id, SUM(EXTRACT(EPOCH FROM date_creation - prev_date)), COUNT(*)
+FROM (SELECT owner_id,
+             date_creation,
+             LAG(owner_id) OVER dialog      AS prev_owner_id,
+             LAG(date_creation) OVER dialog AS prev_date
+      FROM message
+      WHERE owner_id <> receiver_id
+        AND date_creation IS NOT NULL
+      WINDOW dialog AS (PARTITION BY LEAST(owner_id, receiver_id), GREATEST(owner_id, receiver_id)
+              ORDER BY date_creation)) m
+WHERE prev_owner_id IS NOT NULL
+  AND prev_owner_id <> owner_id
+GROUP BY owner_id
+ON CONFLICT (seller_id) DO UPDATE
+    SET response_time_sum = EXCLUDED.response_time_sum,
+        responses_count   = EXCLUDED.responses_count;
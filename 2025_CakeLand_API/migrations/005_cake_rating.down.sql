@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS cake_rating_score_idx;
+
+DROP TRIGGER IF EXISTS trigger_recalc_cake_rating_scores ON cake_rating_prior;
+DROP FUNCTION IF EXISTS refresh_cake_rating_prior();
+DROP FUNCTION IF EXISTS recalc_cake_rating_scores();
+
+DROP TRIGGER IF EXISTS trigger_update_cake_rating ON cake;
+DROP FUNCTION IF EXISTS update_cake_rating();
+DROP FUNCTION IF EXISTS cake_rating_score(INT, INT);
+
+DROP TABLE IF EXISTS cake_rating_prior;
+
+ALTER TABLE cake
+    DROP COLUMN IF EXISTS rating_score,
+    DROP COLUMN IF EXISTS rating;
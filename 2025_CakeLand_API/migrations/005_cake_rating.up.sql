@@ -0,0 +1,83 @@
+-- Средний рейтинг торта (stars_sum / reviews_count), хранится для дешёвой сортировки
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS rating DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (rating >= 0 AND rating <= 5);
+
+-- Байесовский рейтинг для сортировки: (stars_sum + weight * mean) / (reviews_count + weight).
+-- Торт с одним отзывом на 5 не обгоняет торт с сотней отзывов на 4.8
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS rating_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- Априорная оценка байесовского рейтинга, всегда одна строка.
+-- mean — средняя оценка по каталогу: берётся из отзывов при миграции и обновляется refresh_cake_rating_prior().
+-- 4.0 используется только для пустого каталога: это типичная средняя оценка на маркетплейсах, при ней торт
+-- без отзывов встаёт ниже хорошо оценённых и выше плохо оценённых.
+-- weight — сколько отзывов с оценкой mean добавляется каждому торту: чем больше, тем больше отзывов нужно,
+-- чтобы рейтинг торта отошёл от средней
+CREATE TABLE IF NOT EXISTS cake_rating_prior
+(
+    id     BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    mean   DOUBLE PRECISION NOT NULL DEFAULT 4.0 CHECK (mean >= 0 AND mean <= 5),
+    weight DOUBLE PRECISION NOT NULL DEFAULT 5 CHECK (weight > 0)
+);
+
+INSERT INTO cake_rating_prior (mean)
+SELECT COALESCE(SUM(stars_sum)::float8 / NULLIF(SUM(reviews_count), 0), 4.0)
+FROM cake
+ON CONFLICT (id) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION cake_rating_score(stars_sum INT, reviews_count INT)
+    RETURNS DOUBLE PRECISION AS
+$$
+SELECT (COALESCE(stars_sum, 0) + p.weight * p.mean) / (COALESCE(reviews_count, 0) + p.weight)
+FROM cake_rating_prior p
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION update_cake_rating()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.rating := COALESCE(NEW.stars_sum::float8 / NULLIF(NEW.reviews_count, 0), 0);
+    NEW.rating_score := cake_rating_score(NEW.stars_sum, NEW.reviews_count);
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_cake_rating
+    BEFORE INSERT OR UPDATE OF stars_sum, reviews_count
+    ON cake
+    FOR EACH ROW
+EXECUTE FUNCTION update_cake_rating();
+
+-- Смена априорной оценки пересчитывает рейтинг всех тортов
+CREATE OR REPLACE FUNCTION recalc_cake_rating_scores()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    UPDATE cake
+    SET rating_score = cake_rating_score(stars_sum, reviews_count);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_recalc_cake_rating_scores
+    AFTER UPDATE
+    ON cake_rating_prior
+    FOR EACH STATEMENT
+EXECUTE FUNCTION recalc_cake_rating_scores();
+
+-- Подтягивает mean к текущей средней оценке каталога. Средняя меняется медленно,
+-- поэтому достаточно вызывать по расписанию (pg_cron) или вручную, например раз в сутки
+CREATE OR REPLACE FUNCTION refresh_cake_rating_prior()
+    RETURNS VOID AS
+$$
+UPDATE cake_rating_prior
+SET mean = COALESCE((SELECT SUM(stars_sum)::float8 / NULLIF(SUM(reviews_count), 0) FROM cake), mean)
+$$ LANGUAGE sql;
+
+UPDATE cake
+SET rating       = COALESCE(stars_sum::float8 / NULLIF(reviews_count, 0), 0),
+    rating_score = cake_rating_score(stars_sum, reviews_count);
+
+CREATE INDEX IF NOT EXISTS cake_rating_score_idx ON cake (rating_score DESC, id DESC);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS cake_images_cake_position_idx;
+
+ALTER TABLE cake_images
+    DROP COLUMN IF EXISTS position;
+
+DROP INDEX IF EXISTS cake_not_deleted_idx;
+
+ALTER TABLE cake
+    DROP COLUMN IF EXISTS deleted_at;
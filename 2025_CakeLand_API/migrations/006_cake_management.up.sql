@@ -0,0 +1,17 @@
+-- Мягкое удаление торта: запись остаётся для истории заказов и отзывов
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS cake_not_deleted_idx ON cake (owner_id) WHERE deleted_at IS NULL;
+
+-- Порядок фотографий торта
+ALTER TABLE cake_images
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+
+UPDATE cake_images ci
+SET position = o.position
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY cake_id ORDER BY id) - 1 AS position
+      FROM cake_images) o
+WHERE ci.id = o.id;
+
+CREATE INDEX IF NOT EXISTS cake_images_cake_position_idx ON cake_images (cake_id, position);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS cake_color_cake_id_idx;
+DROP INDEX IF EXISTS cake_orders_count_idx;
+DROP INDEX IF EXISTS cake_date_creation_idx;
+
+DROP TRIGGER IF EXISTS trigger_update_cake_orders_count ON "order";
+DROP FUNCTION IF EXISTS update_cake_orders_count();
+
+ALTER TABLE cake
+    DROP COLUMN IF EXISTS orders_count;
+
+DROP INDEX IF EXISTS cake_search_vector_idx;
+
+ALTER TABLE cake
+    DROP COLUMN IF EXISTS search_vector;
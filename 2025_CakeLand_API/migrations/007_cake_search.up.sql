@@ -0,0 +1,58 @@
+-- Полнотекстовый поиск по названию и описанию (русская морфология)
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+        GENERATED ALWAYS AS (
+            setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+            setweight(to_tsvector('russian', COALESCE(description, '')), 'B')
+            ) STORED;
+
+CREATE INDEX IF NOT EXISTS cake_search_vector_idx ON cake USING GIN (search_vector);
+
+-- Популярность торта: число неотменённых заказов
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS orders_count INT NOT NULL DEFAULT 0 CHECK (orders_count >= 0);
+
+CREATE OR REPLACE FUNCTION update_cake_orders_count()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    delta INT := 0;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        IF NEW.status <> 'cancelled' THEN
+            delta := 1;
+        END IF;
+    ELSIF OLD.status <> 'cancelled' AND NEW.status = 'cancelled' THEN
+        delta := -1;
+    ELSIF OLD.status = 'cancelled' AND NEW.status <> 'cancelled' THEN
+        delta := 1;
+    END IF;
+
+    IF delta <> 0 THEN
+        UPDATE cake
+        SET orders_count = GREATEST(orders_count + delta, 0)
+        WHERE id = NEW.cake_id;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_cake_orders_count
+    AFTER INSERT OR UPDATE OF status
+    ON "order"
+    FOR EACH ROW
+EXECUTE FUNCTION update_cake_orders_count();
+
+UPDATE cake c
+SET orders_count = o.cnt
+FROM (SELECT cake_id, COUNT(*) AS cnt
+      FROM "order"
+      WHERE status <> 'cancelled'
+      GROUP BY cake_id) o
+WHERE c.id = o.cake_id;
+
+-- Индексы под сортировки каталога
+CREATE INDEX IF NOT EXISTS cake_date_creation_idx ON cake (date_creation DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS cake_orders_count_idx ON cake (orders_count DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS cake_color_cake_id_idx ON cake_color (cake_id, hex_color);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS category_name_id_idx;
+DROP INDEX IF EXISTS filling_name_id_idx;
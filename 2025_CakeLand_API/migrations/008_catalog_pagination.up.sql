@@ -0,0 +1,3 @@
+-- Keyset пагинация списков по (name, id)
+CREATE INDEX IF NOT EXISTS filling_name_id_idx ON filling (name, id);
+CREATE INDEX IF NOT EXISTS category_name_id_idx ON category (name, id);
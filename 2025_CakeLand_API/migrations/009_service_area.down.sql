@@ -0,0 +1,3 @@
+DROP FUNCTION IF EXISTS haversine_km(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
+
+DROP TABLE IF EXISTS seller_service_area;
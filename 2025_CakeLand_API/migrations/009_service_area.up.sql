@@ -0,0 +1,29 @@
+-- Зона доставки продавца: центр и радиус в километрах
+CREATE TABLE IF NOT EXISTS seller_service_area
+(
+    seller_id  UUID PRIMARY KEY REFERENCES "user" (id) ON DELETE CASCADE,
+    latitude   DOUBLE PRECISION         NOT NULL CHECK (latitude BETWEEN -90 AND 90),     -- Широта центра
+    longitude  DOUBLE PRECISION         NOT NULL CHECK (longitude BETWEEN -180 AND 180),  -- Долгота центра
+    radius_km  DOUBLE PRECISION         NOT NULL CHECK (radius_km > 0),                   -- Радиус доставки
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- Грубый отсев по широте перед точным расчётом расстояния
+CREATE INDEX IF NOT EXISTS seller_service_area_latitude_idx ON seller_service_area (latitude);
+
+-- Расстояние по дуге большого круга (формула гаверсинусов) в километрах.
+-- Чистый SQL без earthdistance/PostGIS: функция инлайнится планировщиком
+CREATE OR REPLACE FUNCTION haversine_km(lat1 DOUBLE PRECISION, lon1 DOUBLE PRECISION,
+                                        lat2 DOUBLE PRECISION, lon2 DOUBLE PRECISION)
+    RETURNS DOUBLE PRECISION
+    LANGUAGE sql
+    IMMUTABLE
+    STRICT
+    PARALLEL SAFE
+AS
+$$
+SELECT 2 * 6371.0088 * asin(LEAST(1, sqrt(
+            power(sin(radians(lat2 - lat1) / 2), 2) +
+            cos(radians(lat1)) * cos(radians(lat2)) * power(sin(radians(lon2 - lon1) / 2), 2)
+    )))
+$$;
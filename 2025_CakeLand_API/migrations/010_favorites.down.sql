@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS trigger_update_cake_favorites_count ON favorite_cake;
+
+DROP FUNCTION IF EXISTS update_cake_favorites_count();
+
+ALTER TABLE cake
+    DROP COLUMN IF EXISTS favorites_count;
+
+DROP TABLE IF EXISTS favorite_seller;
+DROP TABLE IF EXISTS favorite_cake;
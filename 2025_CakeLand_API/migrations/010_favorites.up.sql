@@ -0,0 +1,51 @@
+-- Избранные торты пользователя
+CREATE TABLE IF NOT EXISTS favorite_cake
+(
+    user_id    UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    cake_id    UUID                     NOT NULL REFERENCES cake (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, cake_id)
+);
+
+-- Избранные продавцы пользователя
+CREATE TABLE IF NOT EXISTS favorite_seller
+(
+    user_id    UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    seller_id  UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, seller_id)
+);
+
+-- Списки избранного отдаются от новых к старым
+CREATE INDEX IF NOT EXISTS favorite_cake_user_created_idx ON favorite_cake (user_id, created_at DESC, cake_id DESC);
+CREATE INDEX IF NOT EXISTS favorite_seller_user_created_idx ON favorite_seller (user_id, created_at DESC, seller_id DESC);
+
+-- Сколько пользователей добавили торт в избранное
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS favorites_count INT NOT NULL DEFAULT 0 CHECK (favorites_count >= 0);
+
+CREATE OR REPLACE FUNCTION update_cake_favorites_count()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE cake
+        SET favorites_count = favorites_count + 1
+        WHERE id = NEW.cake_id;
+        RETURN NEW;
+    END IF;
+
+    UPDATE cake
+    SET favorites_count = GREATEST(favorites_count - 1, 0)
+    WHERE id = OLD.cake_id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_cake_favorites_count
+    AFTER INSERT OR DELETE
+    ON favorite_cake
+    FOR EACH ROW
+EXECUTE FUNCTION update_cake_favorites_count();
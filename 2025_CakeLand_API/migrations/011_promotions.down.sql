@@ -0,0 +1,7 @@
+DROP FUNCTION IF EXISTS cake_effective_price(cake, TIMESTAMP WITH TIME ZONE);
+
+DROP TABLE IF EXISTS promo_code_usage;
+DROP TABLE IF EXISTS promo_code;
+DROP TABLE IF EXISTS promotion;
+
+DROP TYPE IF EXISTS discount_kind;
@@ -0,0 +1,107 @@
+-- Тип скидки: процент или фиксированная сумма
+CREATE TYPE discount_kind AS ENUM (
+    'percent', -- Процент от цены
+    'fixed'    -- Фиксированная сумма (для акций — с цены за кг, для промокодов — с суммы заказа)
+    );
+
+-- Акции продавца: на торт, на его торты из категории или на весь ассортимент
+CREATE TABLE IF NOT EXISTS promotion
+(
+    id           UUID PRIMARY KEY,
+    seller_id    UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    cake_id      UUID REFERENCES cake (id) ON DELETE CASCADE,         -- Торт (NULL — не ограничено тортом)
+    category_id  UUID REFERENCES category (id) ON DELETE CASCADE,     -- Категория (NULL — не ограничено категорией)
+    kind         discount_kind            NOT NULL,
+    value        DOUBLE PRECISION         NOT NULL CHECK (value > 0),
+    starts_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    cancelled_at TIMESTAMP WITH TIME ZONE,                            -- Отменённые акции остаются в истории
+
+    CHECK (ends_at > starts_at),
+    CHECK (kind <> 'percent' OR value < 100),
+    CHECK (cake_id IS NULL OR category_id IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS promotion_seller_active_idx ON promotion (seller_id, ends_at) WHERE cancelled_at IS NULL;
+CREATE INDEX IF NOT EXISTS promotion_seller_created_idx ON promotion (seller_id, created_at DESC, id DESC);
+
+-- Промокоды продавца на заказ
+CREATE TABLE IF NOT EXISTS promo_code
+(
+    id                UUID PRIMARY KEY,
+    code              VARCHAR(32)              NOT NULL UNIQUE CHECK (code = UPPER(code)),
+    seller_id         UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    kind              discount_kind            NOT NULL,
+    value             DOUBLE PRECISION         NOT NULL CHECK (value > 0),
+    starts_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    max_uses          INT CHECK (max_uses > 0),                                    -- NULL — без ограничения
+    max_uses_per_user INT                      NOT NULL DEFAULT 1 CHECK (max_uses_per_user > 0),
+    uses_count        INT                      NOT NULL DEFAULT 0 CHECK (uses_count >= 0),
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    cancelled_at      TIMESTAMP WITH TIME ZONE,
+
+    CHECK (expires_at > starts_at),
+    CHECK (kind <> 'percent' OR value < 100)
+);
+
+CREATE INDEX IF NOT EXISTS promo_code_seller_created_idx ON promo_code (seller_id, created_at DESC, id DESC);
+
+-- Применения промокодов
+CREATE TABLE IF NOT EXISTS promo_code_usage
+(
+    promo_code_id   UUID                     NOT NULL REFERENCES promo_code (id) ON DELETE CASCADE,
+    user_id         UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    order_id        UUID                     NOT NULL UNIQUE REFERENCES "order" (id) ON DELETE CASCADE,
+    discount_amount DOUBLE PRECISION         NOT NULL,
+    used_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS promo_code_usage_code_user_idx ON promo_code_usage (promo_code_id, user_id);
+
+-- Действующая цена торта за кг на момент p_at: минимум из базовой цены, скидки на самом торте
+-- и лучшей активной акции продавца. Единственное место расчёта цены — им пользуются и каталог, и заказы
+CREATE OR REPLACE FUNCTION cake_effective_price(p_cake cake, p_at TIMESTAMP WITH TIME ZONE)
+    RETURNS TABLE
+            (
+                effective_kg_price DOUBLE PRECISION, -- Цена за кг с учётом скидок
+                discount_ends_at   TIMESTAMP WITH TIME ZONE, -- Окончание применённой скидки (NULL — скидки нет)
+                promotion_id       UUID -- Применённая акция (NULL — базовая цена или скидка торта)
+            )
+    LANGUAGE sql
+    STABLE
+AS
+$$
+SELECT candidate.price, candidate.ends_at, candidate.promotion_id
+FROM (
+         -- Базовая цена
+         SELECT p_cake.kg_price AS price, NULL::TIMESTAMPTZ AS ends_at, NULL::UUID AS promotion_id
+         UNION ALL
+         -- Скидка, заданная на самом торте
+         SELECT p_cake.discount_kg_price, p_cake.discount_end_time, NULL::UUID
+         WHERE p_cake.discount_kg_price IS NOT NULL
+           AND p_cake.discount_end_time > p_at
+         UNION ALL
+         -- Акции продавца
+         SELECT CASE p.kind
+                    WHEN 'percent' THEN p_cake.kg_price * (1 - p.value / 100)
+                    ELSE GREATEST(p_cake.kg_price - p.value, 0)
+                    END,
+                p.ends_at,
+                p.id
+         FROM promotion p
+         WHERE p.seller_id = p_cake.owner_id
+           AND p.cancelled_at IS NULL
+           AND p.starts_at <= p_at
+           AND p.ends_at > p_at
+           AND (p.cake_id = p_cake.id
+             OR (p.cake_id IS NULL AND p.category_id IS NULL)
+             OR EXISTS (SELECT 1
+                        FROM cake_category cc
+                        WHERE cc.cake_id = p_cake.id
+                          AND cc.category_id = p.category_id))
+     ) candidate
+ORDER BY candidate.price, candidate.ends_at NULLS FIRST
+LIMIT 1
+$$;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS order_option;
+DROP TABLE IF EXISTS cake_option_value;
+DROP TABLE IF EXISTS cake_option;
+
+DROP TYPE IF EXISTS cake_option_kind;
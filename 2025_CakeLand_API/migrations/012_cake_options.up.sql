@@ -0,0 +1,51 @@
+-- Тип группы опций торта
+CREATE TYPE cake_option_kind AS ENUM (
+    'single',   -- Ровно одно значение (например, число ярусов)
+    'multiple', -- Одно или несколько значений (например, фигурки)
+    'text'      -- Текст покупателя (например, надпись)
+    );
+
+-- Группы опций торта. Наценки — фиксированные суммы к стоимости заказа
+CREATE TABLE IF NOT EXISTS cake_option
+(
+    id              UUID PRIMARY KEY,
+    cake_id         UUID                     NOT NULL REFERENCES cake (id) ON DELETE CASCADE,
+    name            VARCHAR(100)             NOT NULL,
+    kind            cake_option_kind         NOT NULL,
+    is_required     BOOLEAN                  NOT NULL DEFAULT FALSE,
+    price_modifier  DOUBLE PRECISION         NOT NULL DEFAULT 0 CHECK (price_modifier >= 0), -- Наценка за текст (только для 'text')
+    max_text_length INT CHECK (max_text_length > 0),                                        -- Только для 'text'
+    position        INT                      NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    CHECK (kind = 'text' OR (price_modifier = 0 AND max_text_length IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS cake_option_cake_idx ON cake_option (cake_id, position);
+
+-- Значения группы опций ('single' и 'multiple')
+CREATE TABLE IF NOT EXISTS cake_option_value
+(
+    id             UUID PRIMARY KEY,
+    option_id      UUID             NOT NULL REFERENCES cake_option (id) ON DELETE CASCADE,
+    name           VARCHAR(100)     NOT NULL,
+    price_modifier DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (price_modifier >= 0),
+    position       INT              NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS cake_option_value_option_idx ON cake_option_value (option_id, position);
+
+-- Выбранные в заказе опции. Название и наценка копируются, чтобы заказ не менялся при редактировании опций
+CREATE TABLE IF NOT EXISTS order_option
+(
+    id             UUID PRIMARY KEY,
+    order_id       UUID             NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    option_id      UUID REFERENCES cake_option (id) ON DELETE SET NULL,
+    value_id       UUID REFERENCES cake_option_value (id) ON DELETE SET NULL,
+    option_name    VARCHAR(100)     NOT NULL,
+    value_name     VARCHAR(100),                                                   -- NULL для текстовых опций
+    text           TEXT,                                                           -- Только для текстовых опций
+    price_modifier DOUBLE PRECISION NOT NULL CHECK (price_modifier >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS order_option_order_idx ON order_option (order_id);
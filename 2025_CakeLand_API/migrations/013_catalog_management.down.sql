@@ -0,0 +1,13 @@
+ALTER TABLE cake_category
+    DROP CONSTRAINT IF EXISTS cake_category_category_id_fkey,
+    ADD CONSTRAINT cake_category_category_id_fkey
+        FOREIGN KEY (category_id) REFERENCES category (id);
+
+DROP INDEX IF EXISTS filling_owner_idx;
+
+ALTER TABLE filling
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS owner_id;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS is_admin;
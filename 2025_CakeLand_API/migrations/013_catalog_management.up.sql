@@ -0,0 +1,16 @@
+-- Администраторы платформы: управляют категориями и общими начинками
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS is_admin BOOL NOT NULL DEFAULT false;
+
+-- Владелец начинки (NULL — общая начинка платформы) и мягкое удаление: на начинку ссылаются заказы
+ALTER TABLE filling
+    ADD COLUMN IF NOT EXISTS owner_id   UUID REFERENCES "user" (id),
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS filling_owner_idx ON filling (owner_id) WHERE deleted_at IS NULL;
+
+-- При удалении категории торты просто теряют её
+ALTER TABLE cake_category
+    DROP CONSTRAINT IF EXISTS cake_category_category_id_fkey,
+    ADD CONSTRAINT cake_category_category_id_fkey
+        FOREIGN KEY (category_id) REFERENCES category (id) ON DELETE CASCADE;
@@ -0,0 +1,12 @@
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS is_moderator BOOL NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS is_admin     BOOL NOT NULL DEFAULT false;
+
+UPDATE "user"
+SET is_moderator = 'moderator' = ANY (roles),
+    is_admin     = 'admin' = ANY (roles);
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS roles;
+
+DROP TYPE IF EXISTS user_role;
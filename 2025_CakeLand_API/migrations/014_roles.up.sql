@@ -0,0 +1,24 @@
+-- Роли пользователей: покупатель, продавец, модератор отзывов, администратор платформы
+CREATE TYPE user_role AS ENUM (
+    'customer',
+    'seller',
+    'moderator',
+    'admin'
+    );
+
+-- По умолчанию пользователь и покупает, и продаёт торты
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS roles user_role[] NOT NULL DEFAULT '{customer,seller}';
+
+-- Переносим флаги модератора и администратора в роли
+UPDATE "user"
+SET roles = roles || 'moderator'::user_role
+WHERE is_moderator;
+
+UPDATE "user"
+SET roles = roles || 'admin'::user_role
+WHERE is_admin;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS is_moderator,
+    DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,5 @@
+-- Токены по хэшам не восстановить: после отката пользователям нужно войти заново
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS refresh_tokens_map JSONB NOT NULL DEFAULT '{}';
+
+DROP TABLE IF EXISTS user_session;
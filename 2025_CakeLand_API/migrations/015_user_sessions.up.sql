@@ -0,0 +1,53 @@
+-- Сессии пользователя: одна строка на устройство (fingerprint). Храним только хэш refresh токена
+CREATE TABLE IF NOT EXISTS user_session
+(
+    id                 UUID PRIMARY KEY,
+    user_id            UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    fingerprint        TEXT                     NOT NULL,
+    family_id          UUID,
+    refresh_token_hash TEXT                     NOT NULL,
+    device_name        TEXT                     NOT NULL DEFAULT '',
+    ip_address         TEXT                     NOT NULL DEFAULT '',
+    created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    last_used_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at         TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (user_id, fingerprint)
+);
+
+-- Срок действия из claim exp выданного refresh токена. NULL, если токен не разбирается
+CREATE FUNCTION pg_temp.refresh_token_expires_at(token TEXT)
+    RETURNS TIMESTAMP WITH TIME ZONE AS
+$$
+DECLARE
+    payload TEXT := translate(split_part(token, '.', 2), '-_', '+/');
+BEGIN
+    payload := payload || repeat('=', (4 - length(payload) % 4) % 4);
+    RETURN to_timestamp((convert_from(decode(payload, 'base64'), 'UTF8')::jsonb ->> 'exp')::float8);
+EXCEPTION
+    WHEN OTHERS THEN
+        RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Переносим выданные токены (postgres 12: без gen_random_uuid, id собираем из md5).
+-- Срок сессии — срок её токена, создана она за 7 дней (срок жизни refresh токена) до него.
+-- Истёкшие и неразборчивые токены не переносим. Семейство неизвестно: оно заполнится при первом обновлении токена
+INSERT INTO user_session (id, user_id, fingerprint, refresh_token_hash, created_at, last_used_at, expires_at)
+SELECT md5(tokens.user_id::text || tokens.fingerprint)::uuid,
+       tokens.user_id,
+       tokens.fingerprint,
+       encode(sha256(convert_to(tokens.token, 'UTF8')), 'hex'),
+       tokens.expires_at - INTERVAL '7 days',
+       tokens.expires_at - INTERVAL '7 days',
+       tokens.expires_at
+FROM (SELECT u.id                                      AS user_id,
+             t.key                                     AS fingerprint,
+             t.value                                   AS token,
+             pg_temp.refresh_token_expires_at(t.value) AS expires_at
+      FROM "user" u,
+           jsonb_each_text(COALESCE(u.refresh_tokens_map, '{}'::jsonb)) AS t) tokens
+WHERE tokens.expires_at > now()
+ON CONFLICT (user_id, fingerprint) DO NOTHING;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS refresh_tokens_map;
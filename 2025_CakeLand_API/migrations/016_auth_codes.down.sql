@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS auth_code;
+
+DROP TYPE IF EXISTS auth_code_purpose;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS email_verified;
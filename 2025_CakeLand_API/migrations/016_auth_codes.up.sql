@@ -0,0 +1,21 @@
+-- Подтверждение почты: уже зарегистрированные пользователи считаются подтверждёнными
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE "user"
+SET email_verified = TRUE;
+
+CREATE TYPE auth_code_purpose AS ENUM ('email_verification', 'password_reset');
+
+-- Одноразовые коды из писем. Храним только хэш, на каждую цель у пользователя один действующий код
+CREATE TABLE IF NOT EXISTS auth_code
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    purpose    auth_code_purpose        NOT NULL,
+    code_hash  TEXT                     NOT NULL,
+    attempts   INTEGER                  NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (user_id, purpose)
+);
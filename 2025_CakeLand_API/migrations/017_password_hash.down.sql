@@ -0,0 +1,3 @@
+-- Откат возможен, только пока в бд нет хэшей длиннее 100 символов
+ALTER TABLE "user"
+    ALTER COLUMN password_hash TYPE VARCHAR(100);
@@ -0,0 +1,3 @@
+-- Хэши argon2id в формате PHC длиннее bcrypt
+ALTER TABLE "user"
+    ALTER COLUMN password_hash TYPE VARCHAR(255);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS login_audit;
+
+DROP TABLE IF EXISTS login_attempt;
@@ -0,0 +1,25 @@
+-- Счётчики неудачных входов: ключ — почта аккаунта или IP адрес
+CREATE TABLE IF NOT EXISTS login_attempt
+(
+    key             TEXT PRIMARY KEY,
+    failures        INTEGER                  NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    blocked_until   TIMESTAMP WITH TIME ZONE
+);
+
+-- Журнал входов: и удачных, и нет
+CREATE TABLE IF NOT EXISTS login_audit
+(
+    id             UUID PRIMARY KEY,
+    user_id        UUID                     REFERENCES "user" (id) ON DELETE SET NULL,
+    email          TEXT                     NOT NULL,
+    ip_address     TEXT                     NOT NULL DEFAULT '',
+    fingerprint    TEXT                     NOT NULL DEFAULT '',
+    device_name    TEXT                     NOT NULL DEFAULT '',
+    success        BOOLEAN                  NOT NULL,
+    failure_reason TEXT                     NOT NULL DEFAULT '',
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS login_audit_user_id_idx ON login_audit (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS login_audit_email_idx ON login_audit (email, created_at DESC);
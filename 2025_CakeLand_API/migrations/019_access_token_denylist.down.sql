@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS access_token_denylist;
+
+ALTER TABLE user_session
+    DROP COLUMN IF EXISTS access_expires_at,
+    DROP COLUMN IF EXISTS access_token_id;
@@ -0,0 +1,13 @@
+-- Последний выданный сессии access токен: при удалении сессии он попадает в denylist
+ALTER TABLE user_session
+    ADD COLUMN IF NOT EXISTS access_token_id   UUID,
+    ADD COLUMN IF NOT EXISTS access_expires_at TIMESTAMP WITH TIME ZONE;
+
+-- Access токены, отозванные до истечения. Запись нужна только до expires_at
+CREATE TABLE IF NOT EXISTS access_token_denylist
+(
+    jti        UUID PRIMARY KEY,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS access_token_denylist_expires_at_idx ON access_token_denylist (expires_at);
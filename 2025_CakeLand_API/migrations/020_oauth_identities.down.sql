@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS user_oauth_identity;
+
+-- Пользователям без пароля ставим заведомо неверный хэш: войти они смогут после сброса пароля
+UPDATE "user"
+SET password_hash = '!' || id::text
+WHERE password_hash IS NULL;
+
+ALTER TABLE "user"
+    ALTER COLUMN password_hash SET NOT NULL;
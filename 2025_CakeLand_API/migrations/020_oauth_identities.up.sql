@@ -0,0 +1,16 @@
+-- Пользователи, вошедшие через провайдера, могут не иметь пароля
+ALTER TABLE "user"
+    ALTER COLUMN password_hash DROP NOT NULL;
+
+-- Аккаунты внешних провайдеров (google, apple, vk). subject — неизменный id пользователя у провайдера
+CREATE TABLE IF NOT EXISTS user_oauth_identity
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID                     NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    provider   TEXT                     NOT NULL,
+    subject    TEXT                     NOT NULL,
+    email      TEXT                     NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
@@ -0,0 +1,17 @@
+ALTER TABLE login_audit
+    DROP COLUMN IF EXISTS phone;
+
+DROP TABLE IF EXISTS phone_code;
+
+DROP INDEX IF EXISTS user_verified_phone_idx;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS phone_verified;
+
+-- Пользователям без почты ставим заглушку: адрес не существует, войти по нему нельзя
+UPDATE "user"
+SET mail = id::text || '@phone.invalid'
+WHERE mail IS NULL;
+
+ALTER TABLE "user"
+    ALTER COLUMN mail SET NOT NULL;
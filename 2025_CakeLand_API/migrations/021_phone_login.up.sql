@@ -0,0 +1,23 @@
+-- Пользователи, вошедшие по номеру телефона, могут не иметь почты
+ALTER TABLE "user"
+    ALTER COLUMN mail DROP NOT NULL;
+
+-- Номер подтверждён кодом из SMS. По подтверждённому номеру пользователь входит, поэтому он уникален
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS phone_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_verified_phone_idx ON "user" (phone) WHERE phone_verified;
+
+-- Коды входа по SMS. Один действующий код на номер, новый заменяет прежний
+CREATE TABLE IF NOT EXISTS phone_code
+(
+    phone      VARCHAR(11) PRIMARY KEY,
+    code_hash  TEXT                     NOT NULL,
+    attempts   INTEGER                  NOT NULL DEFAULT 0,
+    sent_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Вход по телефону пишется в журнал входов с номером вместо почты
+ALTER TABLE login_audit
+    ADD COLUMN IF NOT EXISTS phone TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,19 @@
+-- TOTP секрет пользователя, зашифрованный ключом сервиса. enabled = FALSE — подключение начато, но не подтверждено кодом.
+-- last_used_step — интервал последнего принятого кода: один код нельзя предъявить дважды
+CREATE TABLE IF NOT EXISTS user_totp
+(
+    user_id        UUID PRIMARY KEY REFERENCES "user" (id) ON DELETE CASCADE,
+    secret         BYTEA                    NOT NULL,
+    enabled        BOOLEAN                  NOT NULL DEFAULT FALSE,
+    last_used_step BIGINT                   NOT NULL DEFAULT 0,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    enabled_at     TIMESTAMP WITH TIME ZONE
+);
+
+-- Одноразовые коды восстановления на случай потери телефона. Использованный код удаляется
+CREATE TABLE IF NOT EXISTS user_recovery_code
+(
+    user_id   UUID NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    PRIMARY KEY (user_id, code_hash)
+);
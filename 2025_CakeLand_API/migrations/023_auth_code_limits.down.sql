@@ -0,0 +1,3 @@
+ALTER TABLE auth_code
+    DROP COLUMN IF EXISTS sent_count,
+    DROP COLUMN IF EXISTS window_started_at;
@@ -0,0 +1,4 @@
+-- Лимиты на коды из писем: сколько кодов отправлено с начала текущих суток окна. created_at — время отправки
+ALTER TABLE auth_code
+    ADD COLUMN IF NOT EXISTS sent_count        INTEGER                  NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS window_started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
@@ -0,0 +1,5 @@
+-- Лимиты на коды из SMS: сколько кодов отправлено на номер с начала текущих суток окна.
+-- Неверные попытки копятся за всё окно и не обнуляются новым кодом
+ALTER TABLE phone_code
+    ADD COLUMN IF NOT EXISTS sent_count        INTEGER                  NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS window_started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS login_audit_created_at_idx;
+DROP INDEX IF EXISTS login_attempt_last_failure_at_idx;
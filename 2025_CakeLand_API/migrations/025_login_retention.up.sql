@@ -0,0 +1,3 @@
+-- Индексы для фоновой очистки: счётчики входа с истёкшим окном и журнал входов старше срока хранения
+CREATE INDEX IF NOT EXISTS login_attempt_last_failure_at_idx ON login_attempt (last_failure_at);
+CREATE INDEX IF NOT EXISTS login_audit_created_at_idx ON login_audit (created_at);
@@ -0,0 +1,95 @@
+-- Скрытые модератором отзывы не учитываются в рейтинге торта и статистике продавца
+
+-- Добавление отзыва: скрытый отзыв в агрегаты не попадает
+CREATE OR REPLACE FUNCTION update_cake_review_stats()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF NEW.is_hidden THEN
+        RETURN NEW;
+    END IF;
+
+    UPDATE cake
+    SET reviews_count = reviews_count + 1,
+        stars_sum     = stars_sum + NEW.rating
+    WHERE id = NEW.cake_id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_seller_review_stats()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF NEW.is_hidden THEN
+        RETURN NEW;
+    END IF;
+
+    INSERT INTO seller_stats (seller_id, stars_sum, reviews_count)
+    SELECT c.owner_id, NEW.rating, 1
+    FROM cake c
+    WHERE c.id = NEW.cake_id
+    ON CONFLICT (seller_id) DO UPDATE
+        SET stars_sum     = seller_stats.stars_sum + EXCLUDED.stars_sum,
+            reviews_count = seller_stats.reviews_count + 1,
+            updated_at    = CURRENT_TIMESTAMP;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Скрытие и возврат отзыва: вычитаем его оценку из агрегатов торта и продавца или возвращаем обратно
+CREATE OR REPLACE FUNCTION update_feedback_hidden_stats()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    delta INT := CASE WHEN NEW.is_hidden THEN -1 ELSE 1 END;
+BEGIN
+    UPDATE cake
+    SET reviews_count = reviews_count + delta,
+        stars_sum     = stars_sum + delta * NEW.rating
+    WHERE id = NEW.cake_id;
+
+    INSERT INTO seller_stats (seller_id, stars_sum, reviews_count)
+    SELECT c.owner_id, GREATEST(delta, 0) * NEW.rating, GREATEST(delta, 0)
+    FROM cake c
+    WHERE c.id = NEW.cake_id
+    ON CONFLICT (seller_id) DO UPDATE
+        SET stars_sum     = seller_stats.stars_sum + delta * NEW.rating,
+            reviews_count = seller_stats.reviews_count + delta,
+            updated_at    = CURRENT_TIMESTAMP;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_feedback_hidden_stats
+    AFTER UPDATE OF is_hidden
+    ON feedback
+    FOR EACH ROW
+    WHEN (OLD.is_hidden IS DISTINCT FROM NEW.is_hidden)
+EXECUTE FUNCTION update_feedback_hidden_stats();
+
+-- Уже скрытые отзывы убираем из агрегатов
+WITH hidden AS (SELECT cake_id, SUM(rating) AS stars, COUNT(*) AS cnt
+                FROM feedback
+                WHERE is_hidden
+                GROUP BY cake_id)
+UPDATE cake c
+SET stars_sum     = c.stars_sum - h.stars,
+    reviews_count = c.reviews_count - h.cnt
+FROM hidden h
+WHERE c.id = h.cake_id;
+
+WITH hidden AS (SELECT c.owner_id, SUM(f.rating) AS stars, COUNT(*) AS cnt
+                FROM feedback f
+                         JOIN cake c ON c.id = f.cake_id
+                WHERE f.is_hidden
+                GROUP BY c.owner_id)
+UPDATE seller_stats s
+SET stars_sum     = s.stars_sum - h.stars,
+    reviews_count = s.reviews_count - h.cnt,
+    updated_at    = CURRENT_TIMESTAMP
+FROM hidden h
+WHERE s.seller_id = h.owner_id;
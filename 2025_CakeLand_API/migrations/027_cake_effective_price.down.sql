@@ -0,0 +1,18 @@
+DROP TRIGGER IF EXISTS trigger_update_promotion_effective_price ON promotion;
+DROP TRIGGER IF EXISTS trigger_update_cake_category_effective_price ON cake_category;
+DROP TRIGGER IF EXISTS trigger_update_cake_effective_price ON cake;
+
+DROP FUNCTION IF EXISTS update_promotion_effective_price();
+DROP FUNCTION IF EXISTS update_cake_category_effective_price();
+DROP FUNCTION IF EXISTS update_cake_effective_price();
+DROP FUNCTION IF EXISTS refresh_cake_effective_prices();
+DROP FUNCTION IF EXISTS recalc_cake_effective_prices(UUID, UUID);
+DROP FUNCTION IF EXISTS cake_price_valid_until(cake, TIMESTAMP WITH TIME ZONE);
+
+DROP INDEX IF EXISTS cake_effective_price_valid_until_idx;
+DROP INDEX IF EXISTS cake_effective_kg_price_idx;
+
+ALTER TABLE cake
+    DROP COLUMN IF EXISTS effective_price_valid_until,
+    DROP COLUMN IF EXISTS effective_discount_ends_at,
+    DROP COLUMN IF EXISTS effective_kg_price;
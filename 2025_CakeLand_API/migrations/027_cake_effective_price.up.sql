@@ -0,0 +1,125 @@
+-- Действующая цена за кг хранится в торте, чтобы фильтр и сортировка по цене шли по индексу.
+-- Считается той же cake_effective_price: триггерами при изменении торта, его категорий и акций продавца,
+-- а по наступлении effective_price_valid_until (начало или конец скидки) — refresh_cake_effective_prices()
+ALTER TABLE cake
+    ADD COLUMN IF NOT EXISTS effective_kg_price          DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS effective_discount_ends_at  TIMESTAMP WITH TIME ZONE, -- Окончание применённой скидки
+    ADD COLUMN IF NOT EXISTS effective_price_valid_until TIMESTAMP WITH TIME ZONE; -- Когда цену нужно пересчитать (NULL — не нужно)
+
+-- Ближайший после p_at момент, когда у торта может смениться действующая цена:
+-- конец скидки торта, начало или конец акции продавца
+CREATE OR REPLACE FUNCTION cake_price_valid_until(p_cake cake, p_at TIMESTAMP WITH TIME ZONE)
+    RETURNS TIMESTAMP WITH TIME ZONE
+    LANGUAGE sql
+    STABLE
+AS
+$$
+SELECT MIN(moment)
+FROM (
+         SELECT p_cake.discount_end_time::TIMESTAMPTZ AS moment
+         WHERE p_cake.discount_kg_price IS NOT NULL
+           AND p_cake.discount_end_time > p_at
+         UNION ALL
+         SELECT CASE WHEN p.starts_at > p_at THEN p.starts_at ELSE p.ends_at END
+         FROM promotion p
+         WHERE p.seller_id = p_cake.owner_id
+           AND p.cancelled_at IS NULL
+           AND p.ends_at > p_at
+     ) moments
+$$;
+
+-- Пересчитывает цену тортов: одного (p_cake_id) или всех тортов продавца (p_owner_id)
+CREATE OR REPLACE FUNCTION recalc_cake_effective_prices(p_owner_id UUID, p_cake_id UUID)
+    RETURNS VOID AS
+$$
+UPDATE cake c
+SET (effective_kg_price, effective_discount_ends_at, effective_price_valid_until) =
+        (SELECT ep.effective_kg_price, ep.discount_ends_at, cake_price_valid_until(c, now())
+         FROM cake_effective_price(c, now()) ep)
+WHERE (p_owner_id IS NULL OR c.owner_id = p_owner_id)
+  AND (p_cake_id IS NULL OR c.id = p_cake_id)
+$$ LANGUAGE sql;
+
+-- Цены, у которых началась или закончилась скидка. Вызывается сервисом cake по расписанию
+CREATE OR REPLACE FUNCTION refresh_cake_effective_prices()
+    RETURNS VOID AS
+$$
+UPDATE cake c
+SET (effective_kg_price, effective_discount_ends_at, effective_price_valid_until) =
+        (SELECT ep.effective_kg_price, ep.discount_ends_at, cake_price_valid_until(c, now())
+         FROM cake_effective_price(c, now()) ep)
+WHERE c.effective_price_valid_until <= now()
+$$ LANGUAGE sql;
+
+-- Новый торт и изменение цены, скидки или владельца
+CREATE OR REPLACE FUNCTION update_cake_effective_price()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    SELECT ep.effective_kg_price, ep.discount_ends_at
+    INTO NEW.effective_kg_price, NEW.effective_discount_ends_at
+    FROM cake_effective_price(NEW, now()) ep;
+    NEW.effective_price_valid_until := cake_price_valid_until(NEW, now());
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_cake_effective_price
+    BEFORE INSERT OR UPDATE OF kg_price, discount_kg_price, discount_end_time, owner_id
+    ON cake
+    FOR EACH ROW
+EXECUTE FUNCTION update_cake_effective_price();
+
+-- Категории торта: от них зависят акции на категорию
+CREATE OR REPLACE FUNCTION update_cake_category_effective_price()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM recalc_cake_effective_prices(NULL, NEW.cake_id);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM recalc_cake_effective_prices(NULL, OLD.cake_id);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_cake_category_effective_price
+    AFTER INSERT OR UPDATE OR DELETE
+    ON cake_category
+    FOR EACH ROW
+EXECUTE FUNCTION update_cake_category_effective_price();
+
+-- Создание и отмена акции: пересчитываем торт акции или весь ассортимент продавца
+CREATE OR REPLACE FUNCTION update_promotion_effective_price()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM recalc_cake_effective_prices(NEW.seller_id, NEW.cake_id);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM recalc_cake_effective_prices(OLD.seller_id, OLD.cake_id);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_promotion_effective_price
+    AFTER INSERT OR UPDATE OR DELETE
+    ON promotion
+    FOR EACH ROW
+EXECUTE FUNCTION update_promotion_effective_price();
+
+SELECT recalc_cake_effective_prices(NULL, NULL);
+
+ALTER TABLE cake
+    ALTER COLUMN effective_kg_price SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS cake_effective_kg_price_idx ON cake (effective_kg_price, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS cake_effective_price_valid_until_idx ON cake (effective_price_valid_until)
+    WHERE effective_price_valid_until IS NOT NULL;